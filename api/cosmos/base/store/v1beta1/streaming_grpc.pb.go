@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/store/v1beta1/streaming.proto
+
+package storev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamingSinkClient is the client API for StreamingSink service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamingSinkClient interface {
+	// Push delivers a message to the sink. The node considers the message
+	// delivered once the call returns successfully, and pushes the messages
+	// in order, one at a time.
+	Push(ctx context.Context, in *StreamMessage, opts ...grpc.CallOption) (*PushResponse, error)
+}
+
+type streamingSinkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamingSinkClient(cc grpc.ClientConnInterface) StreamingSinkClient {
+	return &streamingSinkClient{cc}
+}
+
+func (c *streamingSinkClient) Push(ctx context.Context, in *StreamMessage, opts ...grpc.CallOption) (*PushResponse, error) {
+	out := new(PushResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.store.v1beta1.StreamingSink/Push", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreamingSinkServer is the server API for StreamingSink service.
+// All implementations must embed UnimplementedStreamingSinkServer
+// for forward compatibility
+type StreamingSinkServer interface {
+	// Push delivers a message to the sink. The node considers the message
+	// delivered once the call returns successfully, and pushes the messages
+	// in order, one at a time.
+	Push(context.Context, *StreamMessage) (*PushResponse, error)
+	mustEmbedUnimplementedStreamingSinkServer()
+}
+
+// UnimplementedStreamingSinkServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamingSinkServer struct {
+}
+
+func (UnimplementedStreamingSinkServer) Push(context.Context, *StreamMessage) (*PushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
+}
+func (UnimplementedStreamingSinkServer) mustEmbedUnimplementedStreamingSinkServer() {}
+
+// UnsafeStreamingSinkServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingSinkServer will
+// result in compilation errors.
+type UnsafeStreamingSinkServer interface {
+	mustEmbedUnimplementedStreamingSinkServer()
+}
+
+func RegisterStreamingSinkServer(s grpc.ServiceRegistrar, srv StreamingSinkServer) {
+	s.RegisterService(&StreamingSink_ServiceDesc, srv)
+}
+
+func _StreamingSink_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingSinkServer).Push(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.store.v1beta1.StreamingSink/Push",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingSinkServer).Push(ctx, req.(*StreamMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StreamingSink_ServiceDesc is the grpc.ServiceDesc for StreamingSink service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreamingSink_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.StreamingSink",
+	HandlerType: (*StreamingSinkServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Push",
+			Handler:    _StreamingSink_Push_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/store/v1beta1/streaming.proto",
+}
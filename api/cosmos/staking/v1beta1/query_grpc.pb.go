@@ -48,6 +48,9 @@ type QueryClient interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
+	// DelegatorPendingEpochMsgs queries the staking messages of a delegator
+	// queued for execution at the end of the current epoch.
+	DelegatorPendingEpochMsgs(ctx context.Context, in *QueryDelegatorPendingEpochMsgsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEpochMsgsResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
@@ -163,6 +166,15 @@ func (c *queryClient) DelegatorValidator(ctx context.Context, in *QueryDelegator
 	return out, nil
 }
 
+func (c *queryClient) DelegatorPendingEpochMsgs(ctx context.Context, in *QueryDelegatorPendingEpochMsgsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEpochMsgsResponse, error) {
+	out := new(QueryDelegatorPendingEpochMsgsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/DelegatorPendingEpochMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
 	out := new(QueryHistoricalInfoResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/HistoricalInfo", in, out, opts...)
@@ -220,6 +232,9 @@ type QueryServer interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
+	// DelegatorPendingEpochMsgs queries the staking messages of a delegator
+	// queued for execution at the end of the current epoch.
+	DelegatorPendingEpochMsgs(context.Context, *QueryDelegatorPendingEpochMsgsRequest) (*QueryDelegatorPendingEpochMsgsResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
@@ -266,6 +281,9 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidator not implemented")
 }
+func (UnimplementedQueryServer) DelegatorPendingEpochMsgs(context.Context, *QueryDelegatorPendingEpochMsgsRequest) (*QueryDelegatorPendingEpochMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPendingEpochMsgs not implemented")
+}
 func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
@@ -486,6 +504,24 @@ func _Query_DelegatorValidator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorPendingEpochMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingEpochMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPendingEpochMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/DelegatorPendingEpochMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPendingEpochMsgs(ctx, req.(*QueryDelegatorPendingEpochMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoricalInfoRequest)
 	if err := dec(in); err != nil {
@@ -591,6 +627,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidator",
 			Handler:    _Query_DelegatorValidator_Handler,
 		},
+		{
+			MethodName: "DelegatorPendingEpochMsgs",
+			Handler:    _Query_DelegatorPendingEpochMsgs_Handler,
+		},
 		{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
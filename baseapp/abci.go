@@ -37,6 +37,7 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 	// On a new chain, we consider the init chain block height as 0, even though
 	// req.InitialHeight is 1 by default.
 	initHeader := tmproto.Header{ChainID: req.ChainId, Time: req.Time}
+	app.setChainID(req.ChainId)
 
 	// If req.InitialHeight is > 1, then we set the initial version in the
 	// stores.
@@ -154,6 +155,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		panic(err)
 	}
 
+	if app.chainID == "" {
+		app.setChainID(req.Header.ChainID)
+	}
+
 	// Initialize the DeliverTx state. If this is the first block, it should
 	// already be initialized in InitChain. Otherwise app.deliverState will be
 	// nil, since it is reset on Commit.
@@ -197,10 +202,12 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 
+	app.startParallelBlock(req.Hash)
+
 	// call the hooks with the BeginBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+			app.streamingError("BeginBlock", req.Header.Height, err)
 		}
 	}
 
@@ -225,23 +232,177 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	// call the streaming service hooks with the EndBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+			app.streamingError("EndBlock", req.Height, err)
 		}
 	}
 
 	return res
 }
 
-// ProcessProposal implements the ability for the application to verify and/or modify transactions in a block proposal.
+// PrepareProposal implements the ability for the application to verify and/or
+// modify transactions in a block proposal.
+//
+// When an app-side mempool is set, the proposal is built from the mempool: its
+// transactions are selected in order until adding the next one would exceed
+// either MaxTxBytes or the maximum block gas defined in the consensus params.
+// Otherwise, the transactions provided by Tendermint are returned untouched.
 func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
-	// treated as a noop until app side mempool is implemented
-	return abci.ResponsePrepareProposal{Txs: req.Txs}
+	if app.mempool == nil {
+		app.trackProposal(nil, req.Txs)
+		return abci.ResponsePrepareProposal{Txs: req.Txs}
+	}
+
+	var (
+		ctx        = app.getContextForTx(runTxModeCheck, nil)
+		maxGas     = app.getMaximumBlockGas(ctx)
+		txs        [][]byte
+		totalBytes int64
+		totalGas   uint64
+	)
+
+	for iterator := app.mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+		memTx := iterator.Tx()
+
+		bz, err := app.txEncoder(memTx)
+		if err != nil {
+			app.logger.Error("failed to encode mempool tx", "err", err)
+			continue
+		}
+
+		txSize := int64(len(bz))
+		if totalBytes+txSize > req.MaxTxBytes {
+			break
+		}
+
+		if maxGas > 0 {
+			var txGas uint64
+			if feeTx, ok := memTx.(sdk.FeeTx); ok {
+				txGas = feeTx.GetGas()
+			}
+
+			if totalGas+txGas > maxGas {
+				break
+			}
+
+			totalGas += txGas
+		}
+
+		totalBytes += txSize
+		txs = append(txs, bz)
+	}
+
+	app.trackProposal(nil, txs)
+
+	return abci.ResponsePrepareProposal{Txs: txs}
 }
 
-// ProcessProposal implements the ability for the application to verify transactions in a block proposal, and decide if they should accept the block or not.
+// ProcessProposal implements the ability for the application to verify
+// transactions in a block proposal, and decide if they should accept the block
+// or not. The proposal is validated by the ProcessProposalHandler set with
+// SetProcessProposal, against a branch of the latest committed state.
 func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
-	// accept all proposed blocks until app side mempool is implemented
-	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	if app.processProposal == nil {
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+
+	header := tmproto.Header{
+		ChainID:            app.chainID,
+		Height:             req.Height,
+		Time:               req.Time,
+		NextValidatorsHash: req.NextValidatorsHash,
+		ProposerAddress:    req.ProposerAddress,
+		AppHash:            app.LastCommitID().Hash,
+	}
+
+	ctx := sdk.NewContext(app.cms.CacheMultiStore(), header, false, app.logger).
+		WithVoteInfos(req.ProposedLastCommit.Votes).
+		WithHeaderHash(req.Hash)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	res := app.processProposal(ctx, req)
+	if res.IsAccepted() {
+		app.trackProposal(req.Hash, req.Txs)
+	}
+
+	return res
+}
+
+// DefaultProcessProposal returns the default ProcessProposalHandler. Every
+// transaction of the proposal is decoded with the app's TxDecoder, statelessly
+// validated and run through the AnteHandler, in order, on the provided branched
+// state. The proposal is rejected if any of these steps fails or if the sum of
+// the gas wanted by its transactions exceeds the maximum block gas defined in
+// the consensus params.
+//
+// Note, messages are not executed: a transaction depending on the execution
+// of the messages of a previous transaction of the same proposal in its
+// AnteHandler (e.g. to pay fees) leads to the proposal being rejected.
+//
+// The proposals are validated against the chain ID of the app, which is only
+// known from InitChain or the first BeginBlock after a node restart: it panics
+// if the chain ID isn't set beforehand with the SetChainID option.
+func (app *BaseApp) DefaultProcessProposal() sdk.ProcessProposalHandler {
+	if app.chainID == "" {
+		panic("the default ProcessProposal handler requires the chain ID to be set with SetChainID")
+	}
+
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) (res abci.ResponseProcessProposal) {
+		reject := func(msg string, keyvals ...interface{}) abci.ResponseProcessProposal {
+			app.logger.Info("rejecting block proposal: "+msg, append([]interface{}{"height", req.Height}, keyvals...)...)
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				res = reject("panic while validating proposal", "panic", r)
+			}
+		}()
+
+		var (
+			maxGas   = app.getMaximumBlockGas(ctx)
+			totalGas uint64
+		)
+
+		for i, txBytes := range req.Txs {
+			tx, err := app.txDecoder(txBytes)
+			if err != nil {
+				return reject("failed to decode tx", "index", i, "err", err)
+			}
+
+			if err := validateBasicTxMsgs(tx.GetMsgs()); err != nil {
+				return reject("invalid tx", "index", i, "err", err)
+			}
+
+			if app.anteHandler != nil {
+				anteCtx, msCache := app.cacheTxContext(ctx.WithTxBytes(txBytes), txBytes)
+				anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+
+				if _, err := app.anteHandler(anteCtx, tx, false); err != nil {
+					return reject("tx failed AnteHandler", "index", i, "err", err)
+				}
+
+				msCache.Write()
+			}
+
+			if feeTx, ok := tx.(sdk.FeeTx); ok {
+				totalGas += feeTx.GetGas()
+			}
+
+			if maxGas > 0 && totalGas > maxGas {
+				return reject("block gas exceeds maximum", "max_gas", maxGas, "gas", totalGas)
+			}
+		}
+
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+}
+
+// NoOpProcessProposal returns a ProcessProposalHandler accepting every block
+// proposal.
+func NoOpProcessProposal() sdk.ProcessProposalHandler {
+	return func(_ sdk.Context, _ abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
 }
 
 // CheckTx implements the ABCI interface and executes a tx in CheckTx mode. In
@@ -266,6 +427,13 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx)
 	if err != nil {
+		// a tx failing recheck is no longer valid and must leave the mempool
+		if mode == runTxModeReCheck && app.mempool != nil {
+			if tx, decodeErr := app.txDecoder(req.Tx); decodeErr == nil {
+				app.removeFromMempool(tx)
+			}
+		}
+
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
 	}
 
@@ -284,7 +452,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
@@ -295,7 +463,24 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	// call the streaming service hooks with the DeliverTx messages
+	defer func() {
+		for _, streamingListener := range app.abciListeners {
+			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.streamingError("DeliverTx", app.deliverState.ctx.BlockHeight(), err)
+			}
+		}
+	}()
+
+	// A tx included in a block leaves the app-side mempool regardless of its
+	// execution outcome.
+	if app.mempool != nil {
+		if tx, err := app.txDecoder(req.Tx); err == nil {
+			app.removeFromMempool(tx)
+		}
+	}
+
+	gInfo, result, anteEvents, err := app.deliverTx(req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -328,6 +513,20 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+
+	// call the streaming service hooks with the Commit message and the change
+	// set of the block, now that the block is final
+	for _, streamingListener := range app.abciListeners {
+		changeSet := streamingListener.changeSet.PopStateCache()
+		if err := streamingListener.ListenCommit(app.deliverState.ctx, res, changeSet); err != nil {
+			app.streamingError("Commit", header.Height, err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -336,6 +535,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	// empty/reset the deliver state
 	app.deliverState = nil
+	app.parallelBlock = nil
 
 	var halt bool
 
@@ -357,10 +557,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	go app.snapshotManager.SnapshotIfApplicable(header.Height)
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
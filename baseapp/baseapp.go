@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 const (
@@ -51,16 +53,19 @@ type BaseApp struct { //nolint: maligned
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry codectypes.InterfaceRegistry
-	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
-
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	postHandler    sdk.AnteHandler  // post handler, optional, e.g. for tips
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	txDecoder         sdk.TxDecoder   // unmarshal []byte into sdk.Tx
+	txEncoder         sdk.TxEncoder   // marshal sdk.Tx into []byte
+	mempool           mempool.Mempool // application side mempool, optional
+
+	anteHandler     sdk.AnteHandler            // ante handler for fee and auth
+	postHandler     sdk.AnteHandler            // post handler, optional, e.g. for tips
+	initChainer     sdk.InitChainer            // initialize state with validators and state blob
+	beginBlocker    sdk.BeginBlocker           // logic to run before any txs
+	endBlocker      sdk.EndBlocker             // logic to run after all txs, and to determine valset changes
+	processProposal sdk.ProcessProposalHandler // logic to validate block proposals
+	addrPeerFilter  sdk.PeerFilter             // filter peers by address and port
+	idPeerFilter    sdk.PeerFilter             // filter peers by node ID
+	fauxMerkleMode  bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
@@ -110,6 +115,10 @@ type BaseApp struct { //nolint: maligned
 	// ResponseCommit.RetainHeight.
 	minRetainBlocks uint64
 
+	// chainID is the chain identifier, set on InitChain, on the first BeginBlock
+	// or explicitly with SetChainID when the node restarts.
+	chainID string
+
 	// application's version string
 	version string
 
@@ -129,7 +138,22 @@ type BaseApp struct { //nolint: maligned
 
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
-	abciListeners []ABCIListener
+	abciListeners []abciListener
+
+	// stopNodeOnStreamingErr halts the node when an ABCIListener hook fails,
+	// instead of logging the error and letting the consumer miss data.
+	stopNodeOnStreamingErr bool
+
+	// parallelWorkers is the number of workers speculatively executing the
+	// transactions of a block, 0 if parallel execution is disabled.
+	parallelWorkers int
+
+	// proposals holds the transactions of the block proposals prepared or
+	// accepted since the last block, by block hash.
+	proposals map[string][][]byte
+
+	// parallelBlock holds the speculative execution of the current block, if any.
+	parallelBlock *parallelBlock
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -323,6 +347,10 @@ func (app *BaseApp) Init() error {
 	app.setCheckState(tmproto.Header{})
 	app.Seal()
 
+	if app.mempool != nil && app.txEncoder == nil {
+		return fmt.Errorf("a TxEncoder must be set when using an app-side mempool")
+	}
+
 	rms, ok := app.cms.(*rootmulti.Store)
 	if !ok {
 		return fmt.Errorf("invalid commit multi-store; expected %T, got: %T", &rootmulti.Store{}, app.cms)
@@ -350,6 +378,10 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setChainID(chainID string) {
+	app.chainID = chainID
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -527,7 +559,13 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
-	ctx := app.getState(mode).ctx.
+	return app.prepareContextForTx(app.getState(mode).ctx, mode, txBytes)
+}
+
+// prepareContextForTx returns the Context a transaction is run with from the
+// Context of the state it runs against.
+func (app *BaseApp) prepareContextForTx(ctx sdk.Context, mode runTxMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
@@ -571,12 +609,17 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, against the state of
+// the provided Context.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -665,6 +708,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if mode == runTxModeCheck && app.mempool != nil {
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -703,6 +752,20 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	return gInfo, result, anteEvents, priority, err
 }
 
+// removeFromMempool removes tx from the app-side mempool, if any. A tx not being
+// present in the mempool is not considered as an error, any other failure is
+// logged: the mempool content is node local and must never affect the
+// deterministic outcome of DeliverTx.
+func (app *BaseApp) removeFromMempool(tx sdk.Tx) {
+	if app.mempool == nil {
+		return
+	}
+
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		app.logger.Error("failed to remove tx from mempool", "err", err)
+	}
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 	"unsafe"
@@ -22,6 +23,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -32,6 +35,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -1924,6 +1929,307 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestPrepareProposalWithMempool(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	cryptocodec.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	mp := mempool.NewPriorityMempool()
+	mempoolOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetMempool(mp)
+		bapp.SetTxEncoder(txConfig.TxEncoder())
+	}
+
+	// the counter found in the memo is used as the tx priority
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			counter, _ := parseTxMemo(tx)
+			return ctx.WithPriority(counter), nil
+		})
+	}
+
+	app := setupBaseApp(t, mempoolOpt, anteOpt)
+	app.SetTxDecoder(txConfig.TxDecoder())
+	app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+	baseapptestutil.RegisterKeyValueServer(app.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: 25},
+		},
+	})
+
+	privA, _, addrA := testdata.KeyTestPubAddr()
+	privB, _, addrB := testdata.KeyTestPubAddr()
+
+	newTx := func(priv cryptotypes.PrivKey, signer sdk.AccAddress, sequence uint64, priority int64) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+			Key:    []byte("key"),
+			Value:  []byte("value"),
+			Signer: signer.String(),
+		}))
+		builder.SetMemo("counter=" + strconv.FormatInt(priority, 10))
+		builder.SetGasLimit(10)
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}))
+
+		txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	txA0 := newTx(privA, addrA, 0, 1)
+	txA1 := newTx(privA, addrA, 1, 10)
+	txB0 := newTx(privB, addrB, 0, 5)
+
+	for _, txBytes := range [][]byte{txA0, txA1, txB0} {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), "%v", res)
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// MaxGas only allows for two txs, txA1 must wait for txA0 despite its priority
+	res := app.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.Equal(t, [][]byte{txB0, txA0}, res.Txs)
+
+	// MaxTxBytes only allows for a single tx
+	res = app.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: int64(len(txB0))})
+	require.Equal(t, [][]byte{txB0}, res.Txs)
+
+	// delivered txs are removed from the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.DeliverTx(abci.RequestDeliverTx{Tx: txB0})
+	require.Equal(t, 2, mp.CountTx())
+	app.DeliverTx(abci.RequestDeliverTx{Tx: txA0})
+	require.Equal(t, 1, mp.CountTx())
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	res = app.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.Equal(t, [][]byte{txA1}, res.Txs)
+}
+
+func TestProcessProposal(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	app := setupBaseApp(t, anteOpt, baseapp.SetChainID("test-chain"), baseapp.SetDefaultProcessProposal())
+	app.InitChain(abci.RequestInitChain{
+		ChainId: "test-chain",
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: 100},
+		},
+	})
+
+	encodeTx := func(tx sdk.Tx) []byte {
+		txBytes, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	withGas := func(tx signing.Tx, gas uint64) sdk.Tx {
+		builder, err := txConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		builder.SetGasLimit(gas)
+		return builder.GetTx()
+	}
+
+	testCases := map[string]struct {
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"empty proposal": {
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"valid txs": {
+			// the ante handler expects the counters to increase within the proposal
+			txs: [][]byte{
+				encodeTx(withGas(newTxCounter(txConfig, 0, 0), 50)),
+				encodeTx(withGas(newTxCounter(txConfig, 1, 1), 50)),
+			},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"undecodable tx": {
+			txs:    [][]byte{encodeTx(newTxCounter(txConfig, 0, 0)), []byte("garbage")},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"tx failing validate basic": {
+			txs:    [][]byte{encodeTx(newTxCounter(txConfig, 0))},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"tx failing ante handler": {
+			txs:    [][]byte{encodeTx(setFailOnAnte(txConfig, newTxCounter(txConfig, 0, 0), true))},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"block gas exceeded": {
+			txs: [][]byte{
+				encodeTx(withGas(newTxCounter(txConfig, 0, 0), 50)),
+				encodeTx(withGas(newTxCounter(txConfig, 1, 1), 51)),
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res := app.ProcessProposal(abci.RequestProcessProposal{Txs: tc.txs, Height: 1})
+			require.Equal(t, tc.status, res.Status)
+
+			// proposal validation never writes to state
+			require.Nil(t, getCheckStateCtx(app).KVStore(capKey1).Get(anteKey))
+			require.Nil(t, app.CommitMultiStore().GetKVStore(capKey1).Get(anteKey))
+		})
+	}
+}
+
+func TestDefaultProcessProposalRequiresChainID(t *testing.T) {
+	require.PanicsWithValue(t, "the default ProcessProposal handler requires the chain ID to be set with SetChainID", func() {
+		setupBaseApp(t, baseapp.SetDefaultProcessProposal())
+	})
+
+	// without a handler, every proposal is accepted
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	res := app.ProcessProposal(abci.RequestProcessProposal{Txs: [][]byte{[]byte("garbage")}, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestNoOpProcessProposal(t *testing.T) {
+	app := setupBaseApp(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetProcessProposal(baseapp.NoOpProcessProposal())
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	res := app.ProcessProposal(abci.RequestProcessProposal{Txs: [][]byte{[]byte("garbage")}, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestParallelDeliverTx(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	// blocks of txs incrementing buckets, summing them through iteration,
+	// conditionally paying fees to a shared account and failing randomly
+	r := rand.New(rand.NewSource(42))
+	blocks := make([][][]byte, 3)
+	for h := range blocks {
+		for i := 0; i < 40; i++ {
+			builder := txConfig.NewTxBuilder()
+			builder.SetMemo(fmt.Sprintf("counter=%d&failOnAnte=%t", r.Intn(9), r.Intn(10) == 0))
+
+			var msg sdk.Msg
+			switch kind := r.Intn(10); {
+			case kind < 3:
+				msg = &baseapptestutil.MsgCounter2{Counter: int64(i)}
+			default:
+				msg = &baseapptestutil.MsgCounter{Counter: r.Int63n(6), FailOnHandler: kind == 3}
+			}
+			require.NoError(t, builder.SetMsgs(msg))
+
+			txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			blocks[h] = append(blocks[h], txBytes)
+		}
+	}
+
+	type outcome struct {
+		responses []abci.ResponseDeliverTx
+		appHashes [][]byte
+		// lateExecutions counts the message executions happening after the
+		// first DeliverTx of each block
+		lateExecutions int64
+	}
+
+	run := func(t *testing.T, workers int, maxGas int64) outcome {
+		var executions int64
+
+		app := setupBaseApp(t,
+			baseapp.SetParallelExecution(workers),
+			func(bapp *baseapp.BaseApp) {
+				bapp.SetAnteHandler(parallelAnteHandler)
+				bapp.SetProcessProposal(baseapp.NoOpProcessProposal())
+			},
+		)
+		app.SetTxDecoder(txConfig.TxDecoder())
+		app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+		baseapptestutil.RegisterCounterServer(app.MsgServiceRouter(), parallelBucketServer{&executions})
+		baseapptestutil.RegisterCounter2Server(app.MsgServiceRouter(), parallelSumServer{&executions})
+
+		app.InitChain(abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: maxGas},
+			},
+		})
+
+		var out outcome
+		for h, txs := range blocks {
+			height := int64(h + 1)
+			hash := []byte(fmt.Sprintf("block-%d", height))
+
+			res := app.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Hash: hash, Height: height})
+			require.True(t, res.IsAccepted())
+
+			app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}, Hash: hash})
+
+			var executed int64
+			for i, tx := range txs {
+				out.responses = append(out.responses, app.DeliverTx(abci.RequestDeliverTx{Tx: tx}))
+				if i == 0 {
+					executed = atomic.LoadInt64(&executions)
+				}
+			}
+			out.lateExecutions += atomic.LoadInt64(&executions) - executed
+
+			app.EndBlock(abci.RequestEndBlock{Height: height})
+			out.appHashes = append(out.appHashes, app.Commit().Data)
+		}
+
+		return out
+	}
+
+	testCases := map[string]struct {
+		maxGas int64
+		// speculative is set if every tx is expected to be committed from its
+		// speculative execution
+		speculative bool
+	}{
+		"unlimited block gas": {
+			maxGas:      -1,
+			speculative: true,
+		},
+		"block gas limit reached": {
+			maxGas: 150000,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			serial := run(t, 0, tc.maxGas)
+			parallel := run(t, 4, tc.maxGas)
+
+			require.Equal(t, serial.responses, parallel.responses)
+			require.Equal(t, serial.appHashes, parallel.appHashes)
+
+			if tc.speculative {
+				require.Zero(t, parallel.lateExecutions)
+			} else {
+				require.NotZero(t, parallel.lateExecutions)
+			}
+		})
+	}
+}
+
 func getCheckStateCtx(app *baseapp.BaseApp) sdk.Context {
 	v := reflect.ValueOf(app).Elem()
 	f := v.FieldByName("checkState")
@@ -2148,6 +2454,67 @@ func (m CounterServerImplGasMeterOnly) IncrementCounter(ctx context.Context, msg
 	return &baseapptestutil.MsgCreateCounterResponse{}, nil
 }
 
+// parallelAnteHandler sets the tx gas meter and pays fees to a shared account
+// for one tx out of three, conflicting with all the txs doing the same.
+func parallelAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	counter, failOnAnte := parseTxMemo(tx)
+
+	store := ctx.KVStore(capKey1)
+	if counter%3 == 0 {
+		setIntOnStore(store, []byte("fees"), getIntFromStore(store, []byte("fees"))+1)
+	}
+
+	if failOnAnte {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+	}
+
+	return ctx, nil
+}
+
+// parallelBucketServer increments the bucket of the message counter.
+type parallelBucketServer struct {
+	executions *int64
+}
+
+func (s parallelBucketServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	atomic.AddInt64(s.executions, 1)
+
+	if msg.FailOnHandler {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	store := sdk.UnwrapSDKContext(ctx).KVStore(capKey1)
+	key := []byte(fmt.Sprintf("bucket/%d", msg.Counter))
+	setIntOnStore(store, key, getIntFromStore(store, key)+1)
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// parallelSumServer stores the sum of all the buckets, emitting it as an event.
+type parallelSumServer struct {
+	executions *int64
+}
+
+func (s parallelSumServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter2) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	atomic.AddInt64(s.executions, 1)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey1)
+
+	var sum int64
+	iter := sdk.KVStorePrefixIterator(store, []byte("bucket/"))
+	for ; iter.Valid(); iter.Next() {
+		sum += getIntFromStore(store, iter.Key())
+	}
+	iter.Close()
+
+	setIntOnStore(store, []byte(fmt.Sprintf("sum/%d", msg.Counter)), sum)
+	sdkCtx.EventManager().EmitEvents(counterEvent("sum", sum))
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
 type paramStore struct {
 	db *dbm.MemDB
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -49,6 +51,20 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetChainID provides a BaseApp option function that sets the chain ID. It
+// allows validating block proposals before the first BeginBlock following a
+// node restart.
+func SetChainID(chainID string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setChainID(chainID) }
+}
+
+// SetDefaultProcessProposal provides a BaseApp option function that validates
+// block proposals with DefaultProcessProposal. It must follow the SetChainID
+// option.
+func SetDefaultProcessProposal() func(*BaseApp) {
+	return func(app *BaseApp) { app.SetProcessProposal(app.DefaultProcessProposal()) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -70,6 +86,23 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetParallelExecution provides a BaseApp option function that enables the
+// speculative, concurrent execution of the transactions of a block with the
+// given number of workers, 0 disabling it. The outcome of DeliverTx is the same
+// as with serial execution.
+//
+// Transactions must only share state through the stores: keepers caching
+// state in memory, or mutating it outside of the stores, during transaction
+// execution must not be used with parallel execution.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelExecution(workers) }
+}
+
+// SetMempool provides a BaseApp option function that sets the app-side mempool.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
@@ -161,6 +194,16 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetProcessProposal sets the handler validating block proposals in
+// ProcessProposal. Without a handler, every proposal is accepted.
+func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
+	if app.sealed {
+		panic("SetProcessProposal() on sealed BaseApp")
+	}
+
+	app.processProposal = handler
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -222,16 +265,42 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 
 // SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
 func (app *BaseApp) SetStreamingService(s StreamingService) {
+	// the change set of the stores the service listens to is collected in memory for its Commit hook
+	changeSet := storetypes.NewMemoryListener()
 	// add the listeners for each StoreKey
 	for key, lis := range s.Listeners() {
-		app.cms.AddListeners(key, lis)
+		listeners := make([]storetypes.WriteListener, 0, len(lis)+1)
+		listeners = append(listeners, lis...)
+		app.cms.AddListeners(key, append(listeners, changeSet))
 	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
-	app.abciListeners = append(app.abciListeners, s)
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, abciListener{ABCIListener: s, changeSet: changeSet})
+}
+
+// SetStopNodeOnStreamingErr sets whether the node halts when the hook of a streaming service fails. By default the
+// error is only logged, letting the state and the external consumer diverge.
+func (app *BaseApp) SetStopNodeOnStreamingErr(stop bool) {
+	app.stopNodeOnStreamingErr = stop
 }
 
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
+
+// SetTxEncoder sets the TxEncoder. It is required when an app-side mempool is
+// set, in order to encode the selected transactions in PrepareProposal.
+func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
+	app.txEncoder = txEncoder
+}
+
+// SetMempool sets the app-side mempool which CheckTx feeds and PrepareProposal
+// selects transactions from.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
@@ -0,0 +1,382 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Parallel execution
+//
+// When enabled, the transactions of a block are executed speculatively and
+// concurrently on the first DeliverTx, in the fashion of Block-STM: every
+// transaction executes against multi-version views of the stores (see the
+// store/multiversion package) which serve the values written by the preceding
+// transactions of the block. Executions are then validated in block order and
+// the ones which read a value since written by a preceding transaction are
+// executed again, against the final writes of the preceding transactions.
+//
+// DeliverTx then commits the outcome of the speculative execution of each
+// transaction rather than running it, as long as it is certain to be the one a
+// serial execution would produce. Otherwise the transaction, and all the
+// following ones of the block, are run serially. The block transactions are
+// taken from the proposals seen in PrepareProposal and ProcessProposal.
+
+// parallelBlock is the speculative execution of the transactions of a block.
+type parallelBlock struct {
+	txs [][]byte
+	// executions holds the outcome of the execution of each transaction, it is
+	// nil until the block is executed.
+	executions []*txExecution
+	// next is the index of the next transaction to be delivered.
+	next int
+}
+
+// txExecution is the outcome of the speculative execution of a transaction.
+type txExecution struct {
+	views       multiversion.Views
+	incarnation int
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+
+	// gasMeter and blockGasMeter stand for the gas meter of the DeliverTx
+	// context and the block gas meter, shared by the block transactions.
+	gasMeter, blockGasMeter *speculativeGasMeter
+	// eventManager stands for the event manager of the DeliverTx context.
+	eventManager *sdk.EventManager
+	// aborted is set if the execution panicked outside of runTx.
+	aborted bool
+}
+
+// setParallelExecution sets the number of workers executing the transactions
+// of a block concurrently, 0 disabling parallel execution.
+func (app *BaseApp) setParallelExecution(workers int) {
+	if workers < 0 {
+		panic(fmt.Sprintf("invalid number of parallel execution workers: %d", workers))
+	}
+
+	app.parallelWorkers = workers
+}
+
+// trackProposal records the transactions of a block proposal, prepared when
+// hash is nil, as candidates for parallel execution.
+func (app *BaseApp) trackProposal(hash []byte, txs [][]byte) {
+	if app.parallelWorkers == 0 {
+		return
+	}
+
+	if app.proposals == nil {
+		app.proposals = make(map[string][][]byte)
+	}
+
+	app.proposals[string(hash)] = txs
+}
+
+// startParallelBlock selects the transactions of the block being started
+// among the tracked proposals, preferring the accepted proposal of the same
+// hash over the prepared one.
+func (app *BaseApp) startParallelBlock(hash []byte) {
+	txs, ok := app.proposals[string(hash)]
+	if !ok {
+		txs = app.proposals[""]
+	}
+
+	app.proposals = nil
+	app.parallelBlock = nil
+
+	if len(txs) > 1 {
+		app.parallelBlock = &parallelBlock{txs: txs}
+	}
+}
+
+// deliverTx runs a DeliverTx transaction, committing the outcome of its
+// speculative execution when available.
+func (app *BaseApp) deliverTx(txBytes []byte) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+	if block := app.parallelBlock; block != nil {
+		if exec := app.nextExecution(block, txBytes); exec != nil && app.commitExecution(exec) {
+			return exec.gInfo, exec.result, exec.anteEvents, exec.err
+		}
+
+		// the remaining transactions of the block are run serially
+		app.parallelBlock = nil
+	}
+
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, txBytes)
+	return gInfo, result, anteEvents, err
+}
+
+// nextExecution returns the speculative execution of the next transaction of
+// the block, executing the block on its first transaction. nil is returned if
+// the block cannot be executed in parallel or if txBytes is not the expected
+// transaction.
+func (app *BaseApp) nextExecution(block *parallelBlock, txBytes []byte) *txExecution {
+	if block.executions == nil {
+		if !app.canExecuteParallel() {
+			return nil
+		}
+
+		block.executions = app.executeParallel(block.txs)
+	}
+
+	i := block.next
+	block.next++
+
+	if i >= len(block.txs) || !bytes.Equal(block.txs[i], txBytes) {
+		return nil
+	}
+
+	return block.executions[i]
+}
+
+// canExecuteParallel reports whether the transactions of the current block
+// can be executed speculatively. Executions are neither traced nor listened
+// to, and must not depend on events emitted before them.
+func (app *BaseApp) canExecuteParallel() bool {
+	if app.trace || app.cms.TracingEnabled() || len(app.deliverState.ctx.EventManager().Events()) > 0 {
+		return false
+	}
+
+	keys := app.storeKeys()
+	if len(keys) == 0 {
+		return false
+	}
+
+	for _, key := range keys {
+		if app.deliverState.ms.ListeningEnabled(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// storeKeys returns the keys of the stores mounted on the commit multi-store.
+func (app *BaseApp) storeKeys() map[string]storetypes.StoreKey {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil
+	}
+
+	return cms.StoreKeysByName()
+}
+
+// executeParallel speculatively executes txs on top of the DeliverTx state,
+// which is left untouched.
+func (app *BaseApp) executeParallel(txs [][]byte) []*txExecution {
+	keys := app.storeKeys()
+
+	parents := make(map[storetypes.StoreKey]storetypes.KVStore, len(keys))
+	for _, key := range keys {
+		parents[key] = app.deliverState.ms.GetKVStore(key)
+	}
+
+	var (
+		memory     = multiversion.NewMemory(parents)
+		executions = make([]*txExecution, len(txs))
+		executed   = make([]chan struct{}, len(txs))
+		next       = int64(-1)
+		wg         sync.WaitGroup
+	)
+
+	for i := range executed {
+		executed[i] = make(chan struct{})
+	}
+
+	// workers execute the transactions in block order, so that executions only
+	// ever wait for the re-execution of a transaction preceding them
+	for w := 0; w < app.parallelWorkers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(txs) {
+					return
+				}
+
+				executions[i] = app.executeSpeculatively(memory, keys, i, 0, txs[i])
+				close(executed[i])
+			}
+		}()
+	}
+
+	// Executions are validated in block order, once all the preceding ones are
+	// final. An invalid execution is therefore valid once executed again.
+	for i := range txs {
+		<-executed[i]
+
+		if !memory.Validate(executions[i].views) {
+			memory.MarkEstimate(i)
+			executions[i] = app.executeSpeculatively(memory, keys, i, executions[i].incarnation+1, txs[i])
+		}
+	}
+
+	wg.Wait()
+
+	return executions
+}
+
+// executeSpeculatively executes the given incarnation of the transaction at
+// index against the multi-version memory, recording its writes.
+func (app *BaseApp) executeSpeculatively(
+	memory *multiversion.Memory, keys map[string]storetypes.StoreKey, index, incarnation int, txBytes []byte,
+) (exec *txExecution) {
+	exec = &txExecution{
+		views:         memory.NewViews(index, incarnation),
+		incarnation:   incarnation,
+		gasMeter:      &speculativeGasMeter{},
+		blockGasMeter: &speculativeGasMeter{},
+		eventManager:  sdk.NewEventManager(),
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			exec.aborted = true
+		}
+
+		memory.Record(exec.views)
+	}()
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(exec.views))
+	for key, view := range exec.views {
+		stores[key] = view
+	}
+
+	ms := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keys, nil, nil, nil)
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(ms).
+		WithGasMeter(exec.gasMeter).
+		WithBlockGasMeter(exec.blockGasMeter).
+		WithEventManager(exec.eventManager)
+	ctx = app.prepareContextForTx(ctx, runTxModeDeliver, txBytes)
+
+	exec.gInfo, exec.result, exec.anteEvents, _, exec.err = app.runTxWithContext(ctx, runTxModeDeliver, txBytes)
+	ms.Write()
+
+	return exec
+}
+
+// commitExecution writes the outcome of a speculative execution to the
+// DeliverTx state. It returns false, leaving the state untouched, if the
+// outcome may differ from the one of a serial execution.
+func (app *BaseApp) commitExecution(exec *txExecution) bool {
+	var (
+		gasMeter      = app.deliverState.ctx.GasMeter()
+		blockGasMeter = app.deliverState.ctx.BlockGasMeter()
+	)
+
+	switch {
+	case exec.aborted, exec.gasMeter.observed, exec.blockGasMeter.observed:
+		return false
+
+	case len(exec.eventManager.Events()) > 0:
+		return false
+
+	case gasMeter.IsOutOfGas(), exec.gasMeter.consumed >= gasMeter.GasRemaining():
+		return false
+
+	case blockGasMeter.IsOutOfGas(), exec.blockGasMeter.consumed > blockGasMeter.GasRemaining():
+		return false
+	}
+
+	gasMeter.ConsumeGas(exec.gasMeter.consumed, "speculative execution")
+	blockGasMeter.ConsumeGas(exec.blockGasMeter.consumed, "block gas meter")
+
+	for key, view := range exec.views {
+		store := app.deliverState.ms.GetKVStore(key)
+		for _, pair := range view.Writes() {
+			if pair.Value == nil {
+				store.Delete(pair.Key)
+			} else {
+				store.Set(pair.Key, pair.Value)
+			}
+		}
+	}
+
+	return true
+}
+
+// speculativeGasMeter stands for a gas meter shared by the transactions of a
+// block during a speculative execution. It records the gas consumed by the
+// execution, to be consumed from the actual meter on commit, and whether the
+// execution observed the gas consumed so far, in which case its outcome
+// depends on the preceding transactions.
+//
+// IsOutOfGas and IsPastLimit are not considered as observations: the actual
+// meter is checked not to run out of gas before the execution is committed.
+type speculativeGasMeter struct {
+	consumed storetypes.Gas
+	observed bool
+}
+
+var _ storetypes.GasMeter = (*speculativeGasMeter)(nil)
+
+func (m *speculativeGasMeter) GasConsumed() storetypes.Gas {
+	m.observed = true
+	return m.consumed
+}
+
+func (m *speculativeGasMeter) GasConsumedToLimit() storetypes.Gas {
+	m.observed = true
+	return m.consumed
+}
+
+func (m *speculativeGasMeter) GasRemaining() storetypes.Gas {
+	m.observed = true
+	return math.MaxUint64
+}
+
+func (m *speculativeGasMeter) Limit() storetypes.Gas {
+	m.observed = true
+	return math.MaxUint64
+}
+
+func (m *speculativeGasMeter) ConsumeGas(amount storetypes.Gas, _ string) {
+	if math.MaxUint64-m.consumed < amount {
+		m.observed = true
+		m.consumed = math.MaxUint64
+
+		return
+	}
+
+	m.consumed += amount
+}
+
+func (m *speculativeGasMeter) RefundGas(amount storetypes.Gas, _ string) {
+	m.observed = true
+	if amount <= m.consumed {
+		m.consumed -= amount
+	}
+}
+
+func (m *speculativeGasMeter) IsPastLimit() bool {
+	return false
+}
+
+func (m *speculativeGasMeter) IsOutOfGas() bool {
+	return false
+}
+
+func (m *speculativeGasMeter) String() string {
+	m.observed = true
+	return fmt.Sprintf("SpeculativeGasMeter:\n  consumed: %d", m.consumed)
+}
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"fmt"
 	"io"
 	"sync"
 
@@ -18,6 +19,10 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the streaming service with the latest Commit message, once the block is final.
+	// The response holds the app hash of the block and changeSet holds all the state changes of the block
+	// written to the stores the streaming service listens to, in the order they were written.
+	ListenCommit(ctx types.Context, res abci.ResponseCommit, changeSet []*store.StoreKVPair) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -31,3 +36,20 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// abciListener is an ABCIListener registered with the BaseApp along with the
+// MemoryListener collecting the change set of the stores it listens to.
+type abciListener struct {
+	ABCIListener
+	changeSet *store.MemoryListener
+}
+
+// streamingError handles an error returned by an ABCIListener hook. The error
+// is logged, and the node is halted if the BaseApp is configured to stop on
+// streaming errors so that the state and the external consumers never diverge.
+func (app *BaseApp) streamingError(hook string, height int64, err error) {
+	app.logger.Error(hook+" listening hook failed", "height", height, "err", err)
+	if app.stopNodeOnStreamingErr {
+		panic(fmt.Errorf("halting node, %s listening hook failed at height %d: %w", hook, height, err))
+	}
+}
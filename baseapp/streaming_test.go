@@ -0,0 +1,180 @@
+package baseapp_test
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+var _ baseapp.StreamingService = &mockStreamingService{}
+
+// mockStreamingService records the ABCI messages it receives and fails its
+// hooks when err is set.
+type mockStreamingService struct {
+	keys []storetypes.StoreKey
+	err  error
+
+	beginBlocks int
+	deliverTxs  []abci.ResponseDeliverTx
+	endBlocks   int
+	commits     []abci.ResponseCommit
+	changeSets  [][]*storetypes.StoreKVPair
+}
+
+func (m *mockStreamingService) Stream(_ *sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(m.keys))
+	for _, key := range m.keys {
+		listeners[key] = nil
+	}
+	return listeners
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.beginBlocks++
+	return m.err
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	m.deliverTxs = append(m.deliverTxs, res)
+	return m.err
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.endBlocks++
+	return m.err
+}
+
+func (m *mockStreamingService) ListenCommit(_ sdk.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	m.commits = append(m.commits, res)
+	m.changeSets = append(m.changeSets, changeSet)
+	return m.err
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func setupStreamingBaseApp(t *testing.T, services ...baseapp.StreamingService) (*baseapp.BaseApp, client.TxConfig) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	var (
+		appBuilder *runtime.AppBuilder
+		cdc        codec.ProtoCodecMarshaler
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &cdc)
+	require.NoError(t, err)
+
+	testCtx := testutil.DefaultContextWithDB(t, capKey1, sdk.NewTransientStoreKey("transient_test"))
+
+	app := appBuilder.Build(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), testCtx.DB, nil, anteOpt)
+	app.SetCMS(testCtx.CMS)
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	app.SetTxDecoder(txConfig.TxDecoder())
+	for _, service := range services {
+		app.SetStreamingService(service)
+	}
+
+	app.InitChain(abci.RequestInitChain{})
+	baseapptestutil.RegisterCounterServer(app.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	return app.BaseApp, txConfig
+}
+
+func TestStreamingService(t *testing.T) {
+	service := &mockStreamingService{keys: []storetypes.StoreKey{capKey1}}
+	// a service not listening to any store receives an empty change set
+	otherService := &mockStreamingService{}
+	app, txConfig := setupStreamingBaseApp(t, service, otherService)
+
+	nBlocks := 2
+	txPerHeight := 3
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: int64(blockN) + 1}})
+
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+			txBytes, err := txConfig.TxEncoder()(newTxCounter(txConfig, counter, counter))
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, res.IsOK())
+			require.Equal(t, res, service.deliverTxs[len(service.deliverTxs)-1])
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		res := app.Commit()
+
+		require.Equal(t, blockN+1, service.beginBlocks)
+		require.Equal(t, blockN+1, service.endBlocks)
+		require.Equal(t, res, service.commits[blockN])
+		require.Equal(t, app.LastCommitID().Hash, service.commits[blockN].Data)
+
+		// the change set holds the writes of the ante handler and the counter
+		// msg server for each tx of the block
+		changeSet := service.changeSets[blockN]
+		require.NotEmpty(t, changeSet)
+		keys := make(map[string]bool)
+		for _, pair := range changeSet {
+			require.Equal(t, capKey1.Name(), pair.StoreKey)
+			keys[string(pair.Key)] = true
+		}
+		require.Equal(t, map[string]bool{"ante-key": true, "deliver-key": true}, keys)
+
+		require.Len(t, otherService.commits, blockN+1)
+		require.Empty(t, otherService.changeSets[blockN])
+	}
+	require.Len(t, service.deliverTxs, nBlocks*txPerHeight)
+}
+
+func TestStreamingService_StopNodeOnErr(t *testing.T) {
+	service := &mockStreamingService{keys: []storetypes.StoreKey{capKey1}, err: errors.New("consumer failure")}
+	app, txConfig := setupStreamingBaseApp(t, service)
+
+	// by default the errors are only logged
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	txBytes, err := txConfig.TxEncoder()(newTxCounter(txConfig, 0, 0))
+	require.NoError(t, err)
+	app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Len(t, service.commits, 1)
+
+	// once configured, a failing hook halts the node
+	app.SetStopNodeOnStreamingErr(true)
+	header = tmproto.Header{Height: 2}
+	require.PanicsWithError(t, "halting node, BeginBlock listening hook failed at height 2: consumer failure", func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	})
+
+	service.err = nil
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	txBytes, err = txConfig.TxEncoder()(newTxCounter(txConfig, 1, 1))
+	require.NoError(t, err)
+
+	service.err = errors.New("consumer failure")
+	require.Panics(t, func() { app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}) })
+	require.Panics(t, func() { app.EndBlock(abci.RequestEndBlock{}) })
+	require.PanicsWithError(t, "halting node, Commit listening hook failed at height 2: consumer failure", func() { app.Commit() })
+}
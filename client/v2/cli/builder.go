@@ -2,10 +2,19 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
 )
 
 // Builder manages options for building CLI commands.
@@ -17,3 +26,240 @@ type Builder struct {
 	// from a given context.
 	GetClientConn func(context.Context) grpc.ClientConnInterface
 }
+
+// HasAutoCLIConfig is an extension interface for app modules declaring the
+// options of the commands generated for their query and Msg services.
+type HasAutoCLIConfig interface {
+	// AutoCLIOptions returns the autocli options of the module.
+	AutoCLIOptions() *autocliv1.ModuleOptions
+}
+
+// AddModuleCommands adds the query and tx commands of modules, keyed by
+// module name, to the root query and tx commands. A module command which
+// already exists, e.g. a hand-written one, is kept and only receives the
+// generated commands whose names it does not already use.
+func (b *Builder) AddModuleCommands(queryCmd, txCmd *cobra.Command, moduleOptions map[string]*autocliv1.ModuleOptions) error {
+	moduleNames := make([]string, 0, len(moduleOptions))
+	for moduleName := range moduleOptions {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		options := moduleOptions[moduleName]
+		if options == nil {
+			continue
+		}
+
+		if options.Query != nil {
+			cmd := findSubCommand(queryCmd, moduleName)
+			if cmd == nil {
+				cmd = topLevelCmd(moduleName, fmt.Sprintf("Querying commands for the %s module", moduleName))
+				queryCmd.AddCommand(cmd)
+			}
+
+			if err := b.AddQueryServiceCommands(cmd, options.Query); err != nil {
+				return err
+			}
+		}
+
+		if options.Tx != nil {
+			cmd := findSubCommand(txCmd, moduleName)
+			if cmd == nil {
+				cmd = topLevelCmd(moduleName, fmt.Sprintf("Transactions commands for the %s module", moduleName))
+				txCmd.AddCommand(cmd)
+			}
+
+			if err := b.AddMsgServiceCommands(cmd, options.Tx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// BuildModuleQueryCommand builds the query command of a module from the
+// descriptor of its query service commands.
+func (b *Builder) BuildModuleQueryCommand(moduleName string, cmdDescriptor *autocliv1.ServiceCommandDescriptor) (*cobra.Command, error) {
+	cmd := topLevelCmd(moduleName, fmt.Sprintf("Querying commands for the %s module", moduleName))
+	if err := b.AddQueryServiceCommands(cmd, cmdDescriptor); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+// BuildModuleMsgCommand builds the tx command of a module from the descriptor
+// of its Msg service commands.
+func (b *Builder) BuildModuleMsgCommand(moduleName string, cmdDescriptor *autocliv1.ServiceCommandDescriptor) (*cobra.Command, error) {
+	cmd := topLevelCmd(moduleName, fmt.Sprintf("Transactions commands for the %s module", moduleName))
+	if err := b.AddMsgServiceCommands(cmd, cmdDescriptor); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+type methodCommandBuilder func(protoreflect.MethodDescriptor, *autocliv1.RpcCommandOptions) (*cobra.Command, error)
+
+// addServiceCommands adds a sub-command to the provided command for each
+// method of the service described by the command descriptor, and for each of
+// its sub-commands.
+func (b *Builder) addServiceCommands(command *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor, buildMethodCommand methodCommandBuilder) error {
+	subCommandNames := make([]string, 0, len(cmdDescriptor.SubCommands))
+	for name := range cmdDescriptor.SubCommands {
+		subCommandNames = append(subCommandNames, name)
+	}
+	sort.Strings(subCommandNames)
+
+	for _, name := range subCommandNames {
+		subCmd := findSubCommand(command, name)
+		if subCmd == nil {
+			subCmd = topLevelCmd(name, fmt.Sprintf("%s sub-commands", name))
+			command.AddCommand(subCmd)
+		}
+
+		if err := b.addServiceCommands(subCmd, cmdDescriptor.SubCommands[name], buildMethodCommand); err != nil {
+			return err
+		}
+	}
+
+	if cmdDescriptor.Service == "" {
+		return nil
+	}
+
+	service, err := b.resolveService(protoreflect.FullName(cmdDescriptor.Service))
+	if err != nil {
+		return err
+	}
+
+	methods := service.Methods()
+	rpcOptions := map[protoreflect.Name]*autocliv1.RpcCommandOptions{}
+	for _, options := range cmdDescriptor.RpcCommandOptions {
+		name := protoreflect.Name(options.RpcMethod)
+		if methods.ByName(name) == nil {
+			return fmt.Errorf("rpc method %s not found for service %s", name, service.FullName())
+		}
+		rpcOptions[name] = options
+	}
+
+	n := methods.Len()
+	for i := 0; i < n; i++ {
+		method := methods.Get(i)
+		options, ok := rpcOptions[method.Name()]
+		if !ok {
+			options = &autocliv1.RpcCommandOptions{}
+		}
+
+		if options.Skip {
+			continue
+		}
+
+		cmd, err := buildMethodCommand(method, options)
+		if err != nil {
+			return err
+		}
+
+		if findSubCommand(command, cmd.Name()) != nil {
+			continue
+		}
+
+		command.AddCommand(cmd)
+	}
+
+	return nil
+}
+
+// newMethodCommand creates a command for the given service method, its flags
+// and positional arguments being bound to the returned message binder.
+func (b *Builder) newMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions, flagOptions flag.Options) (*cobra.Command, *flag.MessageBinder, error) {
+	if options == nil {
+		options = &autocliv1.RpcCommandOptions{}
+	}
+
+	long := options.Long
+	if long == "" {
+		long = util.DescriptorDocs(descriptor)
+	}
+
+	use := options.Use
+	if use == "" {
+		use = methodUse(descriptor, options)
+	}
+
+	cmd := &cobra.Command{
+		Use:        use,
+		Long:       long,
+		Short:      options.Short,
+		Example:    options.Example,
+		Aliases:    options.Alias,
+		SuggestFor: options.SuggestFor,
+		Deprecated: options.Deprecated,
+		Version:    options.Version,
+	}
+
+	inputType := util.ResolveMessageType(b.TypeResolver, descriptor.Input())
+	binder, err := b.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, options, flagOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmd.Args = binder.CobraArgs
+
+	return cmd, binder, nil
+}
+
+func (b *Builder) resolveService(serviceName protoreflect.FullName) (protoreflect.ServiceDescriptor, error) {
+	resolver := b.FileResolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalFiles
+	}
+	descriptor, err := resolver.FindDescriptorByName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+
+	return service, nil
+}
+
+// methodUse returns the default usage of the command of a service method,
+// listing its positional arguments.
+func methodUse(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) string {
+	use := protoNameToCliName(descriptor.Name())
+	for _, arg := range options.PositionalArgs {
+		name := protoNameToCliName(protoreflect.Name(arg.ProtoField))
+		if arg.Varargs {
+			use += fmt.Sprintf(" [%s...]", name)
+		} else {
+			use += fmt.Sprintf(" [%s]", name)
+		}
+	}
+
+	return use
+}
+
+func findSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name {
+			return subCmd
+		}
+	}
+
+	return nil
+}
+
+func topLevelCmd(use, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:                        use,
+		Short:                      short,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+}
@@ -19,8 +19,9 @@ type Builder struct {
 	// nil protoregistry.GlobalFiles will be used.
 	FileResolver protodesc.Resolver
 
-	messageFlagTypes map[protoreflect.FullName]Type
-	scalarFlagTypes  map[string]Type
+	messageFlagTypes     map[protoreflect.FullName]Type
+	messageListFlagTypes map[protoreflect.FullName]Type
+	scalarFlagTypes      map[string]Type
 }
 
 func (b *Builder) init() {
@@ -28,11 +29,21 @@ func (b *Builder) init() {
 		b.messageFlagTypes = map[protoreflect.FullName]Type{}
 		b.messageFlagTypes["google.protobuf.Timestamp"] = timestampType{}
 		b.messageFlagTypes["google.protobuf.Duration"] = durationType{}
+		b.messageFlagTypes["cosmos.base.v1beta1.Coin"] = coinType{}
+		b.messageFlagTypes["cosmos.base.v1beta1.DecCoin"] = decCoinType{}
+	}
+
+	if b.messageListFlagTypes == nil {
+		b.messageListFlagTypes = map[protoreflect.FullName]Type{}
+		b.messageListFlagTypes["cosmos.base.v1beta1.Coin"] = coinsType{}
+		b.messageListFlagTypes["cosmos.base.v1beta1.DecCoin"] = decCoinsType{}
 	}
 
 	if b.scalarFlagTypes == nil {
 		b.scalarFlagTypes = map[string]Type{}
 		b.scalarFlagTypes["cosmos.AddressString"] = addressStringType{}
+		b.scalarFlagTypes["cosmos.Dec"] = decType{}
+		b.scalarFlagTypes["cosmos.Int"] = intType{}
 	}
 }
 
@@ -41,6 +52,14 @@ func (b *Builder) DefineMessageFlagType(messageName protoreflect.FullName, flagT
 	b.messageFlagTypes[messageName] = flagType
 }
 
+// DefineMessageListFlagType defines the flag type of repeated fields of the
+// given message type. Each flag value is expected to hold any number of
+// messages, unlike for the list types derived from DefineMessageFlagType.
+func (b *Builder) DefineMessageListFlagType(messageName protoreflect.FullName, flagType Type) {
+	b.init()
+	b.messageListFlagTypes[messageName] = flagType
+}
+
 func (b *Builder) DefineScalarFlagType(scalarName string, flagType Type) {
 	b.init()
 	b.scalarFlagTypes[scalarName] = flagType
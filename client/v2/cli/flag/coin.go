@@ -0,0 +1,216 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type coinType struct{}
+
+func (c coinType) NewValue(context.Context, *Builder) pflag.Value {
+	return &coinValue{}
+}
+
+func (c coinType) DefaultValue() string {
+	return ""
+}
+
+type coinValue struct {
+	value *basev1beta1.Coin
+}
+
+func (c coinValue) Get() protoreflect.Value {
+	if c.value == nil {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(c.value.ProtoReflect())
+}
+
+func (c coinValue) String() string {
+	if c.value == nil {
+		return ""
+	}
+	return c.value.Amount + c.value.Denom
+}
+
+func (c *coinValue) Set(s string) error {
+	coin, err := parseCoin(s)
+	if err != nil {
+		return err
+	}
+	c.value = coin
+	return nil
+}
+
+func (c coinValue) Type() string {
+	return "coin"
+}
+
+// coinsType binds repeated coin fields, each flag value being a comma
+// separated list of coins.
+type coinsType struct{}
+
+func (c coinsType) NewValue(context.Context, *Builder) pflag.Value {
+	return &coinsValue{}
+}
+
+func (c coinsType) DefaultValue() string {
+	return ""
+}
+
+type coinsValue struct {
+	values []*basev1beta1.Coin
+}
+
+func (c coinsValue) AppendTo(list protoreflect.List) {
+	for _, value := range c.values {
+		list.Append(protoreflect.ValueOfMessage(value.ProtoReflect()))
+	}
+}
+
+func (c coinsValue) String() string {
+	coins := make([]string, len(c.values))
+	for i, value := range c.values {
+		coins[i] = value.Amount + value.Denom
+	}
+	return strings.Join(coins, ",")
+}
+
+func (c *coinsValue) Set(s string) error {
+	for _, coinStr := range strings.Split(s, ",") {
+		coin, err := parseCoin(strings.TrimSpace(coinStr))
+		if err != nil {
+			return err
+		}
+		c.values = append(c.values, coin)
+	}
+	return nil
+}
+
+func (c coinsValue) Type() string {
+	return "coins"
+}
+
+func parseCoin(s string) (*basev1beta1.Coin, error) {
+	coin, err := sdk.ParseDecCoin(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if !coin.Amount.IsInteger() {
+		return nil, fmt.Errorf("coin amount must be an integer: %s", s)
+	}
+
+	return &basev1beta1.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.TruncateInt().String(),
+	}, nil
+}
+
+type decCoinType struct{}
+
+func (d decCoinType) NewValue(context.Context, *Builder) pflag.Value {
+	return &decCoinValue{}
+}
+
+func (d decCoinType) DefaultValue() string {
+	return ""
+}
+
+type decCoinValue struct {
+	value *basev1beta1.DecCoin
+	str   string
+}
+
+func (d decCoinValue) Get() protoreflect.Value {
+	if d.value == nil {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(d.value.ProtoReflect())
+}
+
+func (d decCoinValue) String() string {
+	return d.str
+}
+
+func (d *decCoinValue) Set(s string) error {
+	coin, err := parseDecCoin(s)
+	if err != nil {
+		return err
+	}
+	d.value = coin
+	d.str = s
+	return nil
+}
+
+func (d decCoinValue) Type() string {
+	return "dec coin"
+}
+
+// decCoinsType binds repeated dec coin fields, each flag value being a comma
+// separated list of dec coins.
+type decCoinsType struct{}
+
+func (d decCoinsType) NewValue(context.Context, *Builder) pflag.Value {
+	return &decCoinsValue{}
+}
+
+func (d decCoinsType) DefaultValue() string {
+	return ""
+}
+
+type decCoinsValue struct {
+	values []*basev1beta1.DecCoin
+	strs   []string
+}
+
+func (d decCoinsValue) AppendTo(list protoreflect.List) {
+	for _, value := range d.values {
+		list.Append(protoreflect.ValueOfMessage(value.ProtoReflect()))
+	}
+}
+
+func (d decCoinsValue) String() string {
+	return strings.Join(d.strs, ",")
+}
+
+func (d *decCoinsValue) Set(s string) error {
+	for _, coinStr := range strings.Split(s, ",") {
+		coinStr = strings.TrimSpace(coinStr)
+		coin, err := parseDecCoin(coinStr)
+		if err != nil {
+			return err
+		}
+		d.values = append(d.values, coin)
+		d.strs = append(d.strs, coinStr)
+	}
+	return nil
+}
+
+func (d decCoinsValue) Type() string {
+	return "dec coins"
+}
+
+func parseDecCoin(s string) (*basev1beta1.DecCoin, error) {
+	coin, err := sdk.ParseDecCoin(s)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := decToProtoString(coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &basev1beta1.DecCoin{
+		Denom:  coin.Denom,
+		Amount: amount,
+	}, nil
+}
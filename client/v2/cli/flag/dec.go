@@ -0,0 +1,108 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type decType struct{}
+
+func (d decType) NewValue(context.Context, *Builder) pflag.Value {
+	return &decValue{}
+}
+
+func (d decType) DefaultValue() string {
+	return ""
+}
+
+// decValue binds cosmos.Dec scalar fields. Decimals are parsed from their
+// usual representation, e.g. 0.05, and bound in their protobuf one, i.e. as
+// an integer scaled by 10^18.
+type decValue struct {
+	value string
+	str   string
+}
+
+func (d decValue) Get() protoreflect.Value {
+	if d.str == "" {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(d.value)
+}
+
+func (d decValue) String() string {
+	return d.str
+}
+
+func (d *decValue) Set(s string) error {
+	dec, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return err
+	}
+
+	value, err := decToProtoString(dec)
+	if err != nil {
+		return err
+	}
+
+	d.value = value
+	d.str = s
+	return nil
+}
+
+func (d decValue) Type() string {
+	return "dec"
+}
+
+// decToProtoString returns the representation of a decimal in protobuf
+// messages.
+func decToProtoString(dec math.LegacyDec) (string, error) {
+	bz, err := dec.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+type intType struct{}
+
+func (i intType) NewValue(context.Context, *Builder) pflag.Value {
+	return &intValue{}
+}
+
+func (i intType) DefaultValue() string {
+	return ""
+}
+
+// intValue binds cosmos.Int scalar fields.
+type intValue struct {
+	value string
+}
+
+func (i intValue) Get() protoreflect.Value {
+	if i.value == "" {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(i.value)
+}
+
+func (i intValue) String() string {
+	return i.value
+}
+
+func (i *intValue) Set(s string) error {
+	value, ok := math.NewIntFromString(s)
+	if !ok {
+		return fmt.Errorf("invalid integer: %s", s)
+	}
+	i.value = value.String()
+	return nil
+}
+
+func (i intValue) Type() string {
+	return "int"
+}
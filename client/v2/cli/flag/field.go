@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/proto"
@@ -22,18 +23,35 @@ type FieldValueBinder interface {
 type Options struct {
 	// Prefix is a prefix to prepend to all flags.
 	Prefix string
+
+	// SkipFields lists the fields of a message for which no flag is added.
+	SkipFields []protoreflect.Name
 }
 
-// AddFieldFlag adds a flag for the provided field to the flag set.
-func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, field protoreflect.FieldDescriptor, options Options) FieldValueBinder {
+// AddFieldFlag adds a flag for the provided field to the flag set, customized
+// by the flag options if they are not nil.
+func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, field protoreflect.FieldDescriptor, opts *autocliv1.FlagOptions, options Options) (FieldValueBinder, error) {
 	if field.Kind() == protoreflect.MessageKind && field.Message().FullName() == "cosmos.base.query.v1beta1.PageRequest" {
 		return b.bindPageRequest(ctx, flagSet, field)
 	}
 
-	name := options.Prefix + util.DescriptorKebabName(field)
-	usage := util.DescriptorDocs(field)
-	shorthand := ""
+	if opts == nil {
+		opts = &autocliv1.FlagOptions{}
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = options.Prefix + util.DescriptorKebabName(field)
+	}
+
+	usage := opts.Usage
+	if usage == "" {
+		usage = util.DescriptorDocs(field)
+	}
+
+	shorthand := opts.Shorthand
 
+	var binder FieldValueBinder
 	if typ := b.resolveFlagType(field); typ != nil {
 		val := typ.NewValue(ctx, b)
 		flagSet.AddFlag(&pflag.Flag{
@@ -45,29 +63,70 @@ func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, fiel
 		})
 		switch val := val.(type) {
 		case SimpleValue:
-			return simpleValueBinder{val}
+			binder = simpleValueBinder{val}
 		case ListValue:
-			return listValueBinder{val}
+			binder = listValueBinder{val}
 		default:
 			panic(fmt.Errorf("%T does not implement SimpleValue or ListValue", val))
 		}
+	} else if field.IsList() {
+		if value := bindSimpleListFlag(flagSet, field.Kind(), name, shorthand, usage); value != nil {
+			binder = listValueBinder{value}
+		}
+	} else if value := bindSimpleFlag(flagSet, field.Kind(), name, shorthand, usage); value != nil {
+		binder = simpleValueBinder{value}
 	}
 
-	if field.IsList() {
-		if value := bindSimpleListFlag(flagSet, field.Kind(), name, shorthand, usage); value != nil {
-			return listValueBinder{value}
+	if binder == nil {
+		return nil, nil
+	}
+
+	return binder, applyFlagOptions(flagSet, name, opts)
+}
+
+// applyFlagOptions applies the flag options which cannot be set when
+// creating the flag.
+func applyFlagOptions(flagSet *pflag.FlagSet, name string, opts *autocliv1.FlagOptions) error {
+	flag := flagSet.Lookup(name)
+
+	if opts.DefaultValue != "" {
+		if err := flag.Value.Set(opts.DefaultValue); err != nil {
+			return fmt.Errorf("invalid default value %q for flag %s: %w", opts.DefaultValue, name, err)
 		}
-		return nil
+		flag.DefValue = opts.DefaultValue
+	}
+
+	if opts.NoOptDefaultValue != "" {
+		flag.NoOptDefVal = opts.NoOptDefaultValue
 	}
 
-	if value := bindSimpleFlag(flagSet, field.Kind(), name, shorthand, usage); value != nil {
-		return simpleValueBinder{value}
+	if opts.Hidden {
+		flag.Hidden = true
+	}
+
+	if opts.Deprecated != "" {
+		if err := flagSet.MarkDeprecated(name, opts.Deprecated); err != nil {
+			return err
+		}
+	}
+
+	if opts.ShorthandDeprecated != "" {
+		if err := flagSet.MarkShorthandDeprecated(name, opts.ShorthandDeprecated); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (b *Builder) resolveFlagType(field protoreflect.FieldDescriptor) Type {
+	if field.IsList() && field.Kind() == protoreflect.MessageKind {
+		b.init()
+		if typ, ok := b.messageListFlagTypes[field.Message().FullName()]; ok {
+			return typ
+		}
+	}
+
 	typ := b.resolveFlagTypeBasic(field)
 	if field.IsList() {
 		if typ != nil {
@@ -124,5 +183,5 @@ type listValueBinder struct {
 }
 
 func (s listValueBinder) Bind(message protoreflect.Message, field protoreflect.FieldDescriptor) {
-	s.AppendTo(message.NewField(field).List())
+	s.AppendTo(message.Mutable(field).List())
 }
@@ -9,12 +9,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
 )
 
-func (b *Builder) bindPageRequest(ctx context.Context, flagSet *pflag.FlagSet, field protoreflect.FieldDescriptor) FieldValueBinder {
-	handler := b.AddMessageFlags(
+func (b *Builder) bindPageRequest(ctx context.Context, flagSet *pflag.FlagSet, field protoreflect.FieldDescriptor) (FieldValueBinder, error) {
+	handler, err := b.AddMessageFlags(
 		ctx,
 		flagSet,
 		util.ResolveMessageType(b.TypeResolver, field.Message()),
+		nil,
 		Options{Prefix: "page-"},
 	)
-	return simpleValueBinder{handler}
+	if err != nil {
+		return nil, err
+	}
+	return simpleValueBinder{handler}, nil
 }
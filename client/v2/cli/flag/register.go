@@ -4,56 +4,167 @@ import (
 	"context"
 	"fmt"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
 )
 
 // AddMessageFlags adds flags for each field in the message to the flag set.
-func (b *Builder) AddMessageFlags(ctx context.Context, set *pflag.FlagSet, messageType protoreflect.MessageType, options Options) *MessageBinder {
+// The fields listed as positional arguments in the command options, which may
+// be nil, are bound to positional arguments rather than to flags.
+func (b *Builder) AddMessageFlags(ctx context.Context, set *pflag.FlagSet, messageType protoreflect.MessageType, commandOptions *autocliv1.RpcCommandOptions, options Options) (*MessageBinder, error) {
+	if commandOptions == nil {
+		commandOptions = &autocliv1.RpcCommandOptions{}
+	}
+
 	fields := messageType.Descriptor().Fields()
-	numFields := fields.Len()
 	handler := &MessageBinder{
-		messageType: messageType,
+		messageType:       messageType,
+		positionalFlagSet: pflag.NewFlagSet("positional", pflag.ContinueOnError),
 	}
+
+	isPositional := map[protoreflect.Name]bool{}
+	n := len(commandOptions.PositionalArgs)
+	for i, arg := range commandOptions.PositionalArgs {
+		field := fields.ByName(protoreflect.Name(arg.ProtoField))
+		if field == nil {
+			return nil, fmt.Errorf("can't find field %s on %s", arg.ProtoField, messageType.Descriptor().FullName())
+		}
+
+		if arg.Varargs {
+			if i != n-1 {
+				return nil, fmt.Errorf("varargs positional argument %s must be the last argument", arg.ProtoField)
+			}
+
+			if !field.IsList() {
+				return nil, fmt.Errorf("varargs positional argument %s must be a repeated field", arg.ProtoField)
+			}
+
+			handler.hasVarargs = true
+		}
+
+		binder, err := b.AddFieldFlag(ctx, handler.positionalFlagSet, field, nil, Options{})
+		if err != nil {
+			return nil, err
+		}
+		if binder == nil {
+			return nil, fmt.Errorf("unable to bind field %s to a positional argument", field.FullName())
+		}
+
+		isPositional[field.Name()] = true
+		handler.positionalArgs = append(handler.positionalArgs, fieldBinding{
+			binder: binder,
+			field:  field,
+		})
+	}
+
+	if handler.hasVarargs {
+		handler.CobraArgs = cobra.MinimumNArgs(n - 1)
+	} else {
+		handler.CobraArgs = cobra.ExactArgs(n)
+	}
+
+	numFields := fields.Len()
 	for i := 0; i < numFields; i++ {
 		field := fields.Get(i)
-		binder := b.AddFieldFlag(ctx, set, field, options)
+		if isPositional[field.Name()] || options.skipsField(field) {
+			continue
+		}
+
+		binder, err := b.AddFieldFlag(ctx, set, field, commandOptions.FlagOptions[string(field.Name())], options)
+		if err != nil {
+			return nil, err
+		}
 		if binder == nil {
 			fmt.Printf("unable to bind field %s to a flag, support will be added soon\n", field)
 			continue
 		}
-		handler.flagFieldPairs = append(handler.flagFieldPairs, struct {
-			binder FieldValueBinder
-			field  protoreflect.FieldDescriptor
-		}{binder: binder, field: field})
+		handler.flagBindings = append(handler.flagBindings, fieldBinding{
+			binder: binder,
+			field:  field,
+		})
 	}
-	return handler
+	return handler, nil
 }
 
-// MessageBinder binds multiple flags in a flag set to a protobuf message.
-type MessageBinder struct {
-	flagFieldPairs []struct {
-		binder FieldValueBinder
-		field  protoreflect.FieldDescriptor
+func (o Options) skipsField(field protoreflect.FieldDescriptor) bool {
+	for _, name := range o.SkipFields {
+		if field.Name() == name {
+			return true
+		}
 	}
-	messageType protoreflect.MessageType
+	return false
 }
 
-// BuildMessage builds and returns a new message for the bound flags.
-func (m MessageBinder) BuildMessage() protoreflect.Message {
+type fieldBinding struct {
+	binder FieldValueBinder
+	field  protoreflect.FieldDescriptor
+}
+
+// MessageBinder binds multiple flags in a flag set, and positional arguments,
+// to a protobuf message.
+type MessageBinder struct {
+	// CobraArgs validates the positional arguments of the command.
+	CobraArgs cobra.PositionalArgs
+
+	positionalFlagSet *pflag.FlagSet
+	positionalArgs    []fieldBinding
+	hasVarargs        bool
+
+	flagBindings []fieldBinding
+	messageType  protoreflect.MessageType
+}
+
+// BuildMessage builds and returns a new message for the bound flags and the
+// given positional arguments.
+func (m MessageBinder) BuildMessage(positionalArgs []string) (protoreflect.Message, error) {
 	msg := m.messageType.New()
-	m.Bind(msg)
-	return msg
+	err := m.Bind(msg, positionalArgs)
+	return msg, err
+}
+
+// Bind binds the flag values and the given positional arguments to an
+// existing protobuf message.
+func (m MessageBinder) Bind(msg protoreflect.Message, positionalArgs []string) error {
+	n := len(m.positionalArgs)
+	for i, arg := range positionalArgs {
+		if i >= n && !m.hasVarargs {
+			return fmt.Errorf("expected %d positional arguments, got %d", n, len(positionalArgs))
+		}
+
+		// the extra arguments are appended to the varargs field
+		binding := m.positionalArgs[n-1]
+		if i < n {
+			binding = m.positionalArgs[i]
+		}
+
+		name := util.DescriptorKebabName(binding.field)
+		if err := m.positionalFlagSet.Set(name, arg); err != nil {
+			return fmt.Errorf("invalid argument %q for %s: %w", arg, name, err)
+		}
+	}
+
+	for _, binding := range m.positionalArgs {
+		binding.binder.Bind(msg, binding.field)
+	}
+
+	m.bindFlags(msg)
+	return nil
 }
 
-// Bind binds the flag values to an existing protobuf message.
-func (m MessageBinder) Bind(msg protoreflect.Message) {
-	for _, pair := range m.flagFieldPairs {
-		pair.binder.Bind(msg, pair.field)
+func (m MessageBinder) bindFlags(msg protoreflect.Message) {
+	for _, binding := range m.flagBindings {
+		binding.binder.Bind(msg, binding.field)
 	}
 }
 
-// Get calls BuildMessage and wraps the result in a protoreflect.Value.
+// Get builds a new message for the bound flags and wraps it in a
+// protoreflect.Value.
 func (m MessageBinder) Get() protoreflect.Value {
-	return protoreflect.ValueOfMessage(m.BuildMessage())
+	msg := m.messageType.New()
+	m.bindFlags(msg)
+	return protoreflect.ValueOfMessage(msg)
 }
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+)
+
+// AddMsgServiceCommands adds a sub-command to the provided command for each
+// method of the Msg service described by the command descriptor, applying the
+// rpc command options it specifies. The methods which are skipped, or whose
+// command name is already used by a sub-command, are left out.
+func (b *Builder) AddMsgServiceCommands(command *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	return b.addServiceCommands(command, cmdDescriptor, b.CreateMsgMethodCommand)
+}
+
+// CreateMsgMethodCommand creates a command generating, signing and
+// broadcasting a transaction with the message of the given Msg service method.
+// The signer of the message, as defined by its cosmos.msg.v1.signer option, is
+// filled from the --from flag rather than from a flag of its own.
+func (b *Builder) CreateMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	signerField := msgSignerField(descriptor.Input())
+	flagOptions := flag.Options{}
+	if signerField != nil {
+		flagOptions.SkipFields = []protoreflect.Name{signerField.Name()}
+	}
+
+	cmd, binder, err := b.newMethodCommand(descriptor, options, flagOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		input, err := binder.BuildMessage(args)
+		if err != nil {
+			return err
+		}
+
+		if signerField != nil {
+			input.Set(signerField, protoreflect.ValueOfString(clientCtx.GetFromAddress().String()))
+		}
+
+		msg, err := toSdkMsg(clientCtx, input)
+		if err != nil {
+			return err
+		}
+
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd, nil
+}
+
+// msgSignerField returns the field of a message holding the address of its
+// signer, or nil if the signer of the message cannot be filled from an
+// address.
+func msgSignerField(descriptor protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	signers, _ := proto.GetExtension(descriptor.Options(), msgv1.E_Signer).([]string)
+	if len(signers) != 1 {
+		return nil
+	}
+
+	field := descriptor.Fields().ByName(protoreflect.Name(signers[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return nil
+	}
+
+	return field
+}
+
+// toSdkMsg converts a message built from flags to the sdk.Msg registered for
+// its type in the interface registry of the client context.
+func toSdkMsg(clientCtx client.Context, input protoreflect.Message) (sdk.Msg, error) {
+	bz, err := proto.Marshal(input.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	msgAny := &codectypes.Any{
+		TypeUrl: "/" + string(input.Descriptor().FullName()),
+		Value:   bz,
+	}
+
+	var msg sdk.Msg
+	if err := clientCtx.InterfaceRegistry.UnpackAny(msgAny, &msg); err != nil {
+		return nil, fmt.Errorf("%s is not a registered sdk.Msg: %w", input.Descriptor().FullName(), err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+const testFromAddress = "cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc"
+
+var testMsgCmdDesc = &autocliv1.ServiceCommandDescriptor{
+	Service: bankv1beta1.Msg_ServiceDesc.ServiceName,
+}
+
+func testExecMsg(t *testing.T, args ...string) (*bytes.Buffer, error) {
+	return testExecMsgCommon(t, testMsgCmdDesc, args...)
+}
+
+func testExecMsgCommon(t *testing.T, cmdDescriptor *autocliv1.ServiceCommandDescriptor, args ...string) (*bytes.Buffer, error) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	out := &bytes.Buffer{}
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino).
+		WithChainID("test-chain").
+		WithOutput(out)
+
+	b := &Builder{}
+	cmd := &cobra.Command{Use: "test"}
+	if err := b.AddMsgServiceCommands(cmd, cmdDescriptor); err != nil {
+		return out, err
+	}
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	return out, cmd.Execute()
+}
+
+func TestMsgSend(t *testing.T) {
+	out, err := testExecMsg(t,
+		"send",
+		"--to-address", "cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6",
+		"--amount", "3foo,10stake",
+		"--from", testFromAddress,
+		"--generate-only",
+	)
+	assert.NilError(t, err)
+
+	var tx struct {
+		Body struct {
+			Messages []map[string]interface{} `json:"messages"`
+		} `json:"body"`
+	}
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &tx))
+	assert.Equal(t, len(tx.Body.Messages), 1)
+
+	msg := tx.Body.Messages[0]
+	assert.Equal(t, msg["@type"], "/cosmos.bank.v1beta1.MsgSend")
+	assert.Equal(t, msg["from_address"], testFromAddress)
+	assert.Equal(t, msg["to_address"], "cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6")
+	assert.DeepEqual(t, msg["amount"], []interface{}{
+		map[string]interface{}{"denom": "foo", "amount": "3"},
+		map[string]interface{}{"denom": "stake", "amount": "10"},
+	})
+}
+
+func TestMsgPositionalArgs(t *testing.T) {
+	cmdDesc := &autocliv1.ServiceCommandDescriptor{
+		Service: bankv1beta1.Msg_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+			{
+				RpcMethod: "Send",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+					{ProtoField: "to_address"},
+					{ProtoField: "amount", Varargs: true},
+				},
+			},
+		},
+	}
+
+	out, err := testExecMsgCommon(t, cmdDesc,
+		"send",
+		"cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6",
+		"10stake",
+		"--from", testFromAddress,
+		"--generate-only",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, bytes.Contains(out.Bytes(), []byte(`"to_address":"cosmos1w3h47h6lta047h6lta047h6lta047h6l620gq6"`)))
+	assert.Assert(t, bytes.Contains(out.Bytes(), []byte(`"amount":[{"denom":"stake","amount":"10"}]`)))
+}
+
+func TestMsgValidateBasic(t *testing.T) {
+	_, err := testExecMsg(t,
+		"send",
+		"--to-address", "invalid",
+		"--amount", "10stake",
+		"--from", testFromAddress,
+		"--generate-only",
+	)
+	assert.ErrorContains(t, err, "invalid to address")
+}
+
+func TestMsgHelp(t *testing.T) {
+	out, err := testExecMsg(t, "send", "-h")
+	assert.NilError(t, err)
+	golden.Assert(t, out.String(), "help-msg.golden")
+}
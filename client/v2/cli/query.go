@@ -3,55 +3,36 @@ package cli
 import (
 	"fmt"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"github.com/iancoleman/strcase"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
 	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
 )
 
 // AddQueryServiceCommands adds a sub-command to the provided command for each
-// method in the specified service and returns the command.
-func (b *Builder) AddQueryServiceCommands(command *cobra.Command, serviceName protoreflect.FullName) *cobra.Command {
-	resolver := b.FileResolver
-	if resolver == nil {
-		resolver = protoregistry.GlobalFiles
-	}
-	descriptor, err := resolver.FindDescriptorByName(serviceName)
-	if err != nil {
-		panic(err)
-	}
-
-	service := descriptor.(protoreflect.ServiceDescriptor)
-	methods := service.Methods()
-	n := methods.Len()
-	for i := 0; i < n; i++ {
-		cmd := b.CreateQueryMethodCommand(methods.Get(i))
-		command.AddCommand(cmd)
-	}
-	return command
+// method of the query service described by the command descriptor, applying
+// the rpc command options it specifies. The methods which are skipped, or
+// whose command name is already used by a sub-command, are left out.
+func (b *Builder) AddQueryServiceCommands(command *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	return b.addServiceCommands(command, cmdDescriptor, b.CreateQueryMethodCommand)
 }
 
 // CreateQueryMethodCommand creates a gRPC query command for the given service method.
-func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescriptor) *cobra.Command {
+func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
 	serviceDescriptor := descriptor.Parent().(protoreflect.ServiceDescriptor)
-	docs := util.DescriptorDocs(descriptor)
 	getClientConn := b.GetClientConn
 	methodName := fmt.Sprintf("/%s/%s", serviceDescriptor.FullName(), descriptor.Name())
 
-	inputDesc := descriptor.Input()
-	inputType := util.ResolveMessageType(b.TypeResolver, inputDesc)
 	outputType := util.ResolveMessageType(b.TypeResolver, descriptor.Output())
-	cmd := &cobra.Command{
-		Use:  protoNameToCliName(descriptor.Name()),
-		Long: docs,
+	cmd, binder, err := b.newMethodCommand(descriptor, options, flag.Options{})
+	if err != nil {
+		return nil, err
 	}
 
-	binder := b.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, flag.Options{})
-
 	jsonMarshalOptions := protojson.MarshalOptions{
 		Indent:          "  ",
 		UseProtoNames:   true,
@@ -63,9 +44,13 @@ func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescrip
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 		clientConn := getClientConn(ctx)
-		input := binder.BuildMessage()
+		input, err := binder.BuildMessage(args)
+		if err != nil {
+			return err
+		}
+
 		output := outputType.New()
-		err := clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface())
+		err = clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface())
 		if err != nil {
 			return err
 		}
@@ -79,7 +64,7 @@ func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescrip
 		return err
 	}
 
-	return cmd
+	return cmd, nil
 }
 
 func protoNameToCliName(name protoreflect.Name) string {
@@ -6,10 +6,11 @@ import (
 	"net"
 	"testing"
 
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/golden"
@@ -17,7 +18,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/v2/internal/testpb"
 )
 
+var testCmdDesc = &autocliv1.ServiceCommandDescriptor{
+	Service: testpb.Query_ServiceDesc.ServiceName,
+}
+
 func testExec(t *testing.T, args ...string) *testClientConn {
+	conn, err := testExecCommon(t, testCmdDesc, args...)
+	assert.NilError(t, err)
+	return conn
+}
+
+func testExecCommon(t *testing.T, cmdDescriptor *autocliv1.ServiceCommandDescriptor, args ...string) (*testClientConn, error) {
 	server := grpc.NewServer()
 	testpb.RegisterQueryServer(server, &testEchoServer{})
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
@@ -38,11 +49,13 @@ func testExec(t *testing.T, args ...string) *testClientConn {
 			return conn
 		},
 	}
-	cmd := b.AddQueryServiceCommands(&cobra.Command{Use: "test"}, protoreflect.FullName(testpb.Query_ServiceDesc.ServiceName))
+	cmd := &cobra.Command{Use: "test"}
+	if err := b.AddQueryServiceCommands(cmd, cmdDescriptor); err != nil {
+		return conn, err
+	}
 	cmd.SetArgs(args)
 	cmd.SetOut(conn.out)
-	assert.NilError(t, cmd.Execute())
-	return conn
+	return conn, cmd.Execute()
 }
 
 func TestEcho(t *testing.T) {
@@ -58,7 +71,7 @@ func TestEcho(t *testing.T) {
 		"--i-64", "-234602347",
 		"--str", "def",
 		"--timestamp", "2019-01-02T00:01:02Z",
-		"--a-coin", `{"denom":"foo","amount":"100000"}`,
+		"--a-coin", "100000foo",
 		"--an-address", "cosmossdghdsfoi2134sdgh",
 		"--bz", "c2RncXdlZndkZ3NkZw==",
 		"--page-count-total",
@@ -82,8 +95,144 @@ func TestEcho(t *testing.T) {
 		"--some-messages", `{"baz":-1}`,
 		"--uints", "1,2,3",
 		"--uints", "4",
+		"--a-dec", "0.05",
+		"--an-int", "-1234567890123456789012",
+		"--coins", "10uatom,5stake",
+		"--coins", "7foo",
+		"--dec-coins", "1.5uatom",
 	)
 	assert.DeepEqual(t, conn.lastRequest, conn.lastResponse.(*testpb.EchoResponse).Request, protocmp.Transform())
+
+	request := conn.lastRequest.(*testpb.EchoRequest)
+	assert.DeepEqual(t, request.Strings, []string{"abc", "xyz", "xyz", "qrs"})
+	assert.DeepEqual(t, request.ACoin, &basev1beta1.Coin{Denom: "foo", Amount: "100000"}, protocmp.Transform())
+	assert.Equal(t, request.ADec, "50000000000000000")
+	assert.Equal(t, request.AnInt, "-1234567890123456789012")
+	assert.DeepEqual(t, request.Coins, []*basev1beta1.Coin{
+		{Denom: "uatom", Amount: "10"},
+		{Denom: "stake", Amount: "5"},
+		{Denom: "foo", Amount: "7"},
+	}, protocmp.Transform())
+	assert.DeepEqual(t, request.DecCoins, []*basev1beta1.DecCoin{
+		{Denom: "uatom", Amount: "1500000000000000000"},
+	}, protocmp.Transform())
+}
+
+func TestInvalidCoinAndDecFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--a-coin", "1.5foo"},
+		{"--coins", "10uatom,abc"},
+		{"--dec-coins", "1.5"},
+		{"--a-dec", "abc"},
+		{"--an-int", "1.5"},
+	} {
+		_, err := testExecCommon(t, &autocliv1.ServiceCommandDescriptor{
+			Service: testpb.Query_ServiceDesc.ServiceName,
+		}, append([]string{"echo"}, args...)...)
+		assert.ErrorContains(t, err, "invalid argument")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	cmdDesc := &autocliv1.ServiceCommandDescriptor{
+		Service: testpb.Query_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+			{
+				RpcMethod: "Echo",
+				Use:       "echo [an-address] [u32] [strings...]",
+				Short:     "Echo the request",
+				Example:   "echo cosmos1... 1 abc xyz",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+					{ProtoField: "an_address"},
+					{ProtoField: "u32"},
+					{ProtoField: "strings", Varargs: true},
+				},
+				FlagOptions: map[string]*autocliv1.FlagOptions{
+					"str": {
+						Name:         "text",
+						Shorthand:    "t",
+						Usage:        "some text",
+						DefaultValue: "hello",
+					},
+					"bz": {Hidden: true},
+				},
+			},
+		},
+	}
+
+	conn, err := testExecCommon(t, cmdDesc, "echo", "cosmos1abc", "5", "abc", "xyz", "-t", "world", "--u-64", "3")
+	assert.NilError(t, err)
+	req := conn.lastRequest.(*testpb.EchoRequest)
+	assert.Equal(t, req.AnAddress, "cosmos1abc")
+	assert.Equal(t, req.U32, uint32(5))
+	assert.DeepEqual(t, req.Strings, []string{"abc", "xyz"})
+	assert.Equal(t, req.Str, "world")
+	assert.Equal(t, req.U64, uint64(3))
+
+	conn, err = testExecCommon(t, cmdDesc, "echo", "cosmos1abc", "5")
+	assert.NilError(t, err)
+	req = conn.lastRequest.(*testpb.EchoRequest)
+	assert.Equal(t, req.Str, "hello")
+	assert.Equal(t, len(req.Strings), 0)
+
+	_, err = testExecCommon(t, cmdDesc, "echo", "cosmos1abc")
+	assert.ErrorContains(t, err, "requires at least 2 arg(s)")
+
+	_, err = testExecCommon(t, cmdDesc, "echo", "cosmos1abc", "abc")
+	assert.ErrorContains(t, err, "invalid argument")
+
+	conn, err = testExecCommon(t, cmdDesc, "echo", "-h")
+	assert.NilError(t, err)
+	golden.Assert(t, conn.out.String(), "help-options.golden")
+}
+
+func TestInvalidOptions(t *testing.T) {
+	_, err := testExecCommon(t, &autocliv1.ServiceCommandDescriptor{
+		Service:           testpb.Query_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{{RpcMethod: "Unknown"}},
+	})
+	assert.ErrorContains(t, err, "rpc method Unknown not found")
+
+	_, err = testExecCommon(t, &autocliv1.ServiceCommandDescriptor{
+		Service: testpb.Query_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{{
+			RpcMethod:      "Echo",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "unknown"}},
+		}},
+	})
+	assert.ErrorContains(t, err, "can't find field unknown")
+
+	_, err = testExecCommon(t, &autocliv1.ServiceCommandDescriptor{
+		Service: testpb.Query_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{{
+			RpcMethod:      "Echo",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "str", Varargs: true}},
+		}},
+	})
+	assert.ErrorContains(t, err, "must be a repeated field")
+}
+
+func TestSkipAndExistingCommands(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	b := &Builder{}
+	assert.NilError(t, b.AddQueryServiceCommands(cmd, &autocliv1.ServiceCommandDescriptor{
+		Service:           testpb.Query_ServiceDesc.ServiceName,
+		RpcCommandOptions: []*autocliv1.RpcCommandOptions{{RpcMethod: "Echo", Skip: true}},
+	}))
+	assert.Equal(t, len(cmd.Commands()), 0)
+
+	// hand-written commands take precedence over the generated ones
+	echoCmd := &cobra.Command{Use: "echo", Short: "hand-written"}
+	cmd.AddCommand(echoCmd)
+	assert.NilError(t, b.AddQueryServiceCommands(cmd, &autocliv1.ServiceCommandDescriptor{
+		Service: testpb.Query_ServiceDesc.ServiceName,
+		SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+			"sub": {Service: testpb.Query_ServiceDesc.ServiceName},
+		},
+	}))
+	assert.Equal(t, len(cmd.Commands()), 2)
+	assert.Equal(t, findSubCommand(cmd, "echo"), echoCmd)
+	assert.Assert(t, findSubCommand(findSubCommand(cmd, "sub"), "echo") != nil)
 }
 
 func TestHelp(t *testing.T) {
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var timestampFullName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
+// column maps a message field to a column of a table.
+type column struct {
+	field protoreflect.FieldDescriptor
+}
+
+// name returns the name of the column, which is the name of the field.
+func (c column) name() string {
+	return string(c.field.Name())
+}
+
+// sqlType returns the Postgres type of the column.
+//
+// Repeated, map and message fields are stored as JSONB, except for
+// google.protobuf.Timestamp which is stored as TIMESTAMPTZ. uint64 fields are
+// stored as NUMERIC(20) because they don't fit into a BIGINT.
+func (c column) sqlType() string {
+	if c.field.IsList() || c.field.IsMap() {
+		return "JSONB"
+	}
+
+	switch c.field.Kind() {
+	case protoreflect.BoolKind:
+		return "BOOLEAN"
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "INTEGER"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "BIGINT"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "NUMERIC(20)"
+	case protoreflect.FloatKind:
+		return "REAL"
+	case protoreflect.DoubleKind:
+		return "DOUBLE PRECISION"
+	case protoreflect.StringKind:
+		return "TEXT"
+	case protoreflect.BytesKind:
+		return "BYTEA"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if c.field.Message().FullName() == timestampFullName {
+			return "TIMESTAMPTZ"
+		}
+		return "JSONB"
+	default:
+		panic(fmt.Sprintf("unexpected kind %s for field %s", c.field.Kind(), c.field.FullName()))
+	}
+}
+
+// nullable returns true if the column accepts NULL values, which is the case
+// for the fields tracking their presence, such as message and oneof fields.
+func (c column) nullable() bool {
+	return c.field.HasPresence()
+}
+
+// definition returns the definition of the column in a CREATE TABLE statement.
+func (c column) definition() string {
+	def := fmt.Sprintf("%s %s", quoteIdentifier(c.name()), c.sqlType())
+	if !c.nullable() {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// messageValue returns the SQL value of the column for the provided message.
+func (c column) messageValue(message protoreflect.Message) (interface{}, error) {
+	if c.nullable() && !message.Has(c.field) {
+		return nil, nil
+	}
+
+	if c.field.IsList() || c.field.IsMap() {
+		return c.jsonValue(message)
+	}
+
+	return c.value(message.Get(c.field))
+}
+
+// value returns the SQL value of a singular value of the field, such as a
+// primary key value.
+func (c column) value(value protoreflect.Value) (interface{}, error) {
+	switch c.field.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool(), nil
+	case protoreflect.EnumKind:
+		return int64(value.Enum()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return value.Int(), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return int64(value.Uint()), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float(), nil
+	case protoreflect.StringKind:
+		return value.String(), nil
+	case protoreflect.BytesKind:
+		if value.Bytes() == nil {
+			// a nil slice would be stored as NULL
+			return []byte{}, nil
+		}
+		return value.Bytes(), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := value.Message()
+		if c.field.Message().FullName() != timestampFullName {
+			return marshalJSON(msg.Interface())
+		}
+		ts := &timestamppb.Timestamp{
+			Seconds: msg.Get(msg.Descriptor().Fields().ByName("seconds")).Int(),
+			Nanos:   int32(msg.Get(msg.Descriptor().Fields().ByName("nanos")).Int()),
+		}
+		if err := ts.CheckValid(); err != nil {
+			return nil, err
+		}
+		return ts.AsTime(), nil
+	default:
+		return nil, fmt.Errorf("unexpected kind %s for field %s", c.field.Kind(), c.field.FullName())
+	}
+}
+
+// jsonValue returns the JSON representation of a list or map field of the
+// message.
+func (c column) jsonValue(message protoreflect.Message) (interface{}, error) {
+	if !message.Has(c.field) {
+		if c.field.IsList() {
+			return "[]", nil
+		}
+		return "{}", nil
+	}
+
+	// marshal a message holding only the field to reuse the protojson
+	// representation of the lists and maps
+	msg := message.New()
+	msg.Set(c.field, message.Get(c.field))
+	bz, err := marshalJSON(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(bz), &fields); err != nil {
+		return nil, err
+	}
+	return string(fields[c.name()]), nil
+}
+
+// marshalJSON returns the compact protojson representation of the message.
+func marshalJSON(message proto.Message) (string, error) {
+	bz, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	// protojson randomly adds whitespaces to its output
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, bz); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
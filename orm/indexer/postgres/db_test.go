@@ -0,0 +1,119 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// testDB is a database/sql driver standing in for Postgres, which records the
+// statements of the committed transactions instead of executing them.
+type testDB struct {
+	mtx       sync.Mutex
+	fail      bool
+	committed []string
+}
+
+var (
+	_ driver.Connector     = &testDB{}
+	_ driver.Driver        = &testDB{}
+	_ driver.ExecerContext = &testConn{}
+)
+
+func openTestDB() (*sql.DB, *testDB) {
+	tdb := &testDB{}
+	return sql.OpenDB(tdb), tdb
+}
+
+func (d *testDB) Connect(context.Context) (driver.Conn, error) {
+	return &testConn{db: d}, nil
+}
+
+func (d *testDB) Driver() driver.Driver {
+	return d
+}
+
+func (d *testDB) Open(string) (driver.Conn, error) {
+	return &testConn{db: d}, nil
+}
+
+func (d *testDB) setFail(fail bool) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.fail = fail
+}
+
+// statements returns the statements committed since the last call.
+func (d *testDB) statements() string {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	statements := strings.Join(d.committed, "\n")
+	d.committed = nil
+	return statements
+}
+
+type testConn struct {
+	db      *testDB
+	pending []string
+}
+
+func (c *testConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *testConn) Close() error {
+	return nil
+}
+
+func (c *testConn) Begin() (driver.Tx, error) {
+	c.pending = nil
+	return c, nil
+}
+
+func (c *testConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.mtx.Lock()
+	defer c.db.mtx.Unlock()
+	if c.db.fail {
+		return nil, errors.New("database unavailable")
+	}
+
+	statement := query
+	for _, arg := range args {
+		statement += fmt.Sprintf("\n\t$%d = %s", arg.Ordinal, formatArg(arg.Value))
+	}
+	c.pending = append(c.pending, statement)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *testConn) Commit() error {
+	c.db.mtx.Lock()
+	defer c.db.mtx.Unlock()
+	c.db.committed = append(c.db.committed, c.pending...)
+	c.pending = nil
+	return nil
+}
+
+func (c *testConn) Rollback() error {
+	c.pending = nil
+	return nil
+}
+
+func formatArg(value driver.Value) string {
+	switch value := value.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return fmt.Sprintf("'\\x%x'", value)
+	case string:
+		return fmt.Sprintf("'%s'", value)
+	case time.Time:
+		return fmt.Sprintf("'%s'", value.Format(time.RFC3339Nano))
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
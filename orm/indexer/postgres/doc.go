@@ -0,0 +1,8 @@
+// Package postgres mirrors the state of ORM modules into a Postgres database.
+//
+// The Indexer derives a SQL table from the table descriptor of each message of
+// a module schema and applies the state changes of the module store to it as
+// typed INSERT statements, updating on conflict, and DELETE statements. The
+// Sink feeds an Indexer with the state changes pushed by the gRPC streaming
+// service of a node.
+package postgres
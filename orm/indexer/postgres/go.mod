@@ -0,0 +1,48 @@
+module github.com/cosmos/cosmos-sdk/orm/indexer/postgres
+
+go 1.18
+
+require (
+	cosmossdk.io/api v0.2.1
+	github.com/cosmos/cosmos-sdk/orm v0.0.0-00010101000000-000000000000
+	github.com/iancoleman/strcase v0.2.0
+	github.com/tendermint/tm-db v0.6.7
+	google.golang.org/protobuf v1.28.1
+	gotest.tools/v3 v3.3.0
+)
+
+require (
+	cosmossdk.io/errors v1.0.0-beta.7 // indirect
+	github.com/cespare/xxhash v1.1.0 // indirect
+	github.com/cespare/xxhash/v2 v2.1.2 // indirect
+	github.com/cosmos/cosmos-proto v1.0.0-alpha7 // indirect
+	github.com/cosmos/gogoproto v1.4.1 // indirect
+	github.com/cosmos/gorocksdb v1.2.0 // indirect
+	github.com/dgraph-io/badger/v2 v2.2007.4 // indirect
+	github.com/dgraph-io/ristretto v0.1.0 // indirect
+	github.com/dgryski/go-farm v0.0.0-20200201041132-a6ae2369ad13 // indirect
+	github.com/dustin/go-humanize v1.0.0 // indirect
+	github.com/golang/glog v1.0.0 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/golang/snappy v0.0.4 // indirect
+	github.com/google/btree v1.0.1 // indirect
+	github.com/google/go-cmp v0.5.9 // indirect
+	github.com/jmhodges/levigo v1.0.0 // indirect
+	github.com/klauspost/compress v1.15.9 // indirect
+	github.com/nxadm/tail v1.4.8 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/syndtr/goleveldb v1.0.1-0.20210819022825-2ae1ddf74ef7 // indirect
+	go.etcd.io/bbolt v1.3.6 // indirect
+	golang.org/x/net v0.0.0-20220726230323-06994584191e // indirect
+	golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab // indirect
+	golang.org/x/text v0.3.7 // indirect
+	google.golang.org/genproto v0.0.0-20220725144611-272f38e5d71b // indirect
+	google.golang.org/grpc v1.49.0 // indirect
+)
+
+// The indexer uses the streaming types of cosmossdk.io/api, which are not in
+// a tagged api release yet.
+replace (
+	cosmossdk.io/api => ../../../api
+	github.com/cosmos/cosmos-sdk/orm => ../..
+)
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	storev1beta1 "cosmossdk.io/api/cosmos/base/store/v1beta1"
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// Indexer mirrors the tables of ORM modules into a Postgres database.
+//
+// Each table or singleton of a module schema is mirrored by a SQL table with
+// a column per message field, the same primary key and an index per
+// secondary index of the ORM table. The state changes of the module stores
+// are applied to the SQL tables as INSERT statements, updating the row with
+// the same primary key on conflict, and DELETE statements.
+type Indexer struct {
+	db      *sql.DB
+	modules map[string]*module
+	tables  []*table
+}
+
+// module holds the schema of an ORM module registered with an Indexer.
+type module struct {
+	prefix []byte
+	db     ormdb.ModuleDB
+	tables map[protoreflect.FullName]*table
+
+	// singletons are the singleton tables by key, ormdb can't decode their
+	// entries
+	singletons map[string]*table
+}
+
+// NewIndexer returns an Indexer writing into the provided database, which is
+// expected to use a Postgres driver.
+func NewIndexer(db *sql.DB) *Indexer {
+	return &Indexer{
+		db:      db,
+		modules: map[string]*module{},
+	}
+}
+
+// RegisterModule registers the schema of the ORM module persisting its state
+// in the store with the provided key. The file and type resolvers of the
+// options are used to resolve the table descriptors of the schema.
+func (i *Indexer) RegisterModule(storeKey string, schema *ormv1alpha1.ModuleSchemaDescriptor, options ormdb.ModuleDBOptions) error {
+	if _, ok := i.modules[storeKey]; ok {
+		return fmt.Errorf("a module is already registered for store %s", storeKey)
+	}
+
+	db, err := ormdb.NewModuleDB(schema, options)
+	if err != nil {
+		return err
+	}
+
+	fileResolver := options.FileResolver
+	if fileResolver == nil {
+		fileResolver = protoregistry.GlobalFiles
+	}
+
+	typeResolver := options.TypeResolver
+	if typeResolver == nil {
+		typeResolver = protoregistry.GlobalTypes
+	}
+
+	m := &module{
+		prefix:     schema.Prefix,
+		db:         db,
+		tables:     map[protoreflect.FullName]*table{},
+		singletons: map[string]*table{},
+	}
+	var tables []*table
+	for _, entry := range schema.SchemaFile {
+		fileDescriptor, err := fileResolver.FindFileByPath(entry.ProtoFileName)
+		if err != nil {
+			return err
+		}
+
+		filePrefix := encodeutil.AppendVarUInt32(schema.Prefix, entry.Id)
+		messages := fileDescriptor.Messages()
+		for j := 0; j < messages.Len(); j++ {
+			messageType, err := typeResolver.FindMessageByName(messages.Get(j).FullName())
+			if err != nil {
+				return err
+			}
+
+			t, err := newTable(messageType)
+			if err != nil {
+				return err
+			}
+
+			m.tables[t.message.FullName()] = t
+			if t.singleton {
+				singletonDesc := proto.GetExtension(t.message.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor)
+				m.singletons[string(encodeutil.AppendVarUInt32(filePrefix, singletonDesc.Id))] = t
+			}
+			tables = append(tables, t)
+		}
+	}
+
+	i.modules[storeKey] = m
+	i.tables = append(i.tables, tables...)
+	return nil
+}
+
+// CreateTables creates the SQL tables and indexes of the registered modules
+// which don't exist yet.
+func (i *Indexer) CreateTables(ctx context.Context) error {
+	return i.withTx(ctx, func(tx *sql.Tx) error {
+		for _, t := range i.tables {
+			for _, statement := range t.createStatements() {
+				if _, err := tx.ExecContext(ctx, statement); err != nil {
+					return fmt.Errorf("failed to create table %s: %w", t.name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// ApplyChanges applies the state changes of a block to the SQL tables in a
+// single transaction, in the order they were written.
+//
+// Only the primary key entries of the ORM tables are applied, the SQL
+// indexes being maintained by the database. Changes of the stores without a
+// registered module, or outside the prefix of the module schema, are ignored.
+func (i *Indexer) ApplyChanges(ctx context.Context, changeSet []*storev1beta1.StoreKVPair) error {
+	return i.withTx(ctx, func(tx *sql.Tx) error {
+		for _, pair := range changeSet {
+			if err := i.apply(ctx, tx, pair); err != nil {
+				return fmt.Errorf("failed to apply the change of key %X in store %s: %w", pair.Key, pair.StoreKey, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (i *Indexer) apply(ctx context.Context, tx *sql.Tx, pair *storev1beta1.StoreKVPair) error {
+	m, ok := i.modules[pair.StoreKey]
+	if !ok || !bytes.HasPrefix(pair.Key, m.prefix) {
+		return nil
+	}
+
+	if t, ok := m.singletons[string(pair.Key)]; ok {
+		if pair.Delete {
+			return t.delete(ctx, tx, nil)
+		}
+
+		msg := t.messageType.New().Interface()
+		if err := proto.Unmarshal(pair.Value, msg); err != nil {
+			return err
+		}
+		return t.save(ctx, tx, msg)
+	}
+
+	// only decode the primary key entries, the index entries can't be decoded
+	// without their value once deleted
+	indexID, err := readIndexID(pair.Key[len(m.prefix):])
+	if err != nil {
+		return err
+	}
+	if indexID != primaryKeyID {
+		return nil
+	}
+
+	entry, err := m.db.DecodeEntry(pair.Key, pair.Value)
+	if err != nil {
+		return err
+	}
+
+	pkEntry, ok := entry.(*ormkv.PrimaryKeyEntry)
+	if !ok {
+		return ormerrors.BadDecodeEntry.Wrapf("expected a primary key entry, got %s", entry)
+	}
+
+	t, ok := m.tables[pkEntry.TableName]
+	if !ok {
+		return ormerrors.TableNotFound.Wrap(string(pkEntry.TableName))
+	}
+
+	if pair.Delete {
+		return t.delete(ctx, tx, pkEntry.Key)
+	}
+	return t.save(ctx, tx, pkEntry.Value)
+}
+
+// primaryKeyID is the ID of the primary key index of the ORM tables.
+const primaryKeyID = 0
+
+// readIndexID reads the ID of the index of an ORM table entry from its key
+// without the module prefix, which starts with the IDs of the file and the
+// table of the entry.
+func readIndexID(key []byte) (uint64, error) {
+	r := bytes.NewReader(key)
+	var id uint64
+	for i := 0; i < 3; i++ {
+		var err error
+		id, err = binary.ReadUvarint(r)
+		if err != nil {
+			return 0, ormerrors.UnexpectedDecodePrefix.Wrapf("can't read the IDs of the entry: %v", err)
+		}
+	}
+	return id, nil
+}
+
+func (i *Indexer) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w, rollback failed: %v", err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,173 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	storev1beta1 "cosmossdk.io/api/cosmos/base/store/v1beta1"
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	abciv1beta1 "cosmossdk.io/api/tendermint/abci"
+
+	"github.com/cosmos/cosmos-sdk/orm/indexer/postgres"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+)
+
+const storeKey = "test"
+
+var testSchema = &ormv1alpha1.ModuleSchemaDescriptor{
+	SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{
+			Id:            1,
+			ProtoFileName: testpb.File_testpb_test_schema_proto.Path(),
+		},
+	},
+	Prefix: []byte{0xab},
+}
+
+// recordingStore records the writes of an ORM module as they would be
+// streamed by a node.
+type recordingStore struct {
+	kv.Store
+
+	changeSet []*storev1beta1.StoreKVPair
+}
+
+func (s *recordingStore) Set(key, value []byte) error {
+	s.changeSet = append(s.changeSet, &storev1beta1.StoreKVPair{StoreKey: storeKey, Key: key, Value: value})
+	return s.Store.Set(key, value)
+}
+
+func (s *recordingStore) Delete(key []byte) error {
+	s.changeSet = append(s.changeSet, &storev1beta1.StoreKVPair{StoreKey: storeKey, Delete: true, Key: key})
+	return s.Store.Delete(key)
+}
+
+// popChangeSet returns the writes recorded since the last call.
+func (s *recordingStore) popChangeSet() []*storev1beta1.StoreKVPair {
+	changeSet := s.changeSet
+	s.changeSet = nil
+	return changeSet
+}
+
+func setup(t *testing.T) (*postgres.Indexer, *testDB, testpb.TestSchemaStore, context.Context, *recordingStore) {
+	db, tdb := openTestDB()
+	indexer := postgres.NewIndexer(db)
+	assert.NilError(t, indexer.RegisterModule(storeKey, testSchema, ormdb.ModuleDBOptions{}))
+	assert.ErrorContains(t, indexer.RegisterModule(storeKey, testSchema, ormdb.ModuleDBOptions{}), "already registered")
+
+	moduleDB, err := ormdb.NewModuleDB(testSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	store, err := testpb.NewTestSchemaStore(moduleDB)
+	assert.NilError(t, err)
+
+	kvStore := &recordingStore{Store: dbm.NewMemDB()}
+	ctx := ormtable.WrapContextDefault(ormtable.NewBackend(ormtable.BackendOptions{CommitmentStore: kvStore}))
+	return indexer, tdb, store, ctx, kvStore
+}
+
+func TestCreateTables(t *testing.T) {
+	indexer, tdb, _, ctx, _ := setup(t)
+	assert.NilError(t, indexer.CreateTables(ctx))
+	golden.Assert(t, tdb.statements(), "create_tables.golden")
+}
+
+func TestApplyChanges(t *testing.T) {
+	indexer, tdb, store, ctx, kvStore := setup(t)
+
+	ts := timestamppb.New(time.Date(2022, 10, 1, 12, 30, 0, 5000, time.UTC))
+	assert.NilError(t, store.ExampleTableTable().Insert(ctx, &testpb.ExampleTable{
+		U32:      7,
+		U64:      18446744073709551615,
+		Str:      "abc",
+		Bz:       []byte{0xde, 0xad},
+		Ts:       ts,
+		Dur:      durationpb.New(90 * time.Second),
+		I32:      -3,
+		I64:      -10,
+		B:        true,
+		E:        testpb.Enum_ENUM_TWO,
+		Repeated: []uint32{1, 2},
+		Map:      map[string]uint32{"a": 1},
+		Msg:      &testpb.ExampleTable_ExampleMessage{Foo: "foo", Bar: 2},
+		Sum:      &testpb.ExampleTable_Oneof{Oneof: 3},
+	}))
+	assert.NilError(t, store.ExampleAutoIncrementTableTable().Insert(ctx, &testpb.ExampleAutoIncrementTable{X: "x", Y: 5}))
+	assert.NilError(t, store.ExampleSingletonTable().Save(ctx, &testpb.ExampleSingleton{Foo: "foo", Bar: 1}))
+	assert.NilError(t, store.ExampleTimestampTable().Insert(ctx, &testpb.ExampleTimestamp{Name: "ts", Ts: ts}))
+
+	changeSet := append(kvStore.popChangeSet(),
+		// writes of other stores or outside of the schema prefix are ignored
+		&storev1beta1.StoreKVPair{StoreKey: "other", Key: []byte{0xab, 0x01}, Value: []byte{0x01}},
+		&storev1beta1.StoreKVPair{StoreKey: storeKey, Key: []byte{0x01}, Value: []byte{0x01}},
+	)
+	assert.NilError(t, indexer.ApplyChanges(ctx, changeSet))
+	golden.Assert(t, tdb.statements(), "apply_insert.golden")
+
+	assert.NilError(t, store.ExampleTableTable().Update(ctx, &testpb.ExampleTable{U32: 7, I64: -10, Str: "abc", U64: 1}))
+	assert.NilError(t, store.ExampleAutoIncrementTableTable().Delete(ctx, &testpb.ExampleAutoIncrementTable{Id: 1, X: "x"}))
+	assert.NilError(t, store.ExampleSingletonTable().Save(ctx, &testpb.ExampleSingleton{Foo: "bar"}))
+	assert.NilError(t, indexer.ApplyChanges(ctx, kvStore.popChangeSet()))
+	golden.Assert(t, tdb.statements(), "apply_update.golden")
+
+	// a failure rolls back the changes of the block
+	tdb.setFail(true)
+	assert.NilError(t, store.ExampleTimestampTable().Delete(ctx, &testpb.ExampleTimestamp{Id: 1}))
+	changeSet = kvStore.popChangeSet()
+	assert.ErrorContains(t, indexer.ApplyChanges(ctx, changeSet), "database unavailable")
+	tdb.setFail(false)
+	assert.Equal(t, "", tdb.statements())
+
+	// a malformed entry fails the changes
+	assert.ErrorContains(t, indexer.ApplyChanges(ctx, []*storev1beta1.StoreKVPair{
+		{StoreKey: storeKey, Key: []byte{0xab, 0x01, 0x07}, Value: []byte{0x01}},
+	}), "failed to apply the change of key AB0107 in store test")
+	assert.Equal(t, "", tdb.statements())
+}
+
+func TestSink(t *testing.T) {
+	indexer, tdb, store, ctx, kvStore := setup(t)
+	sink := postgres.NewSink(indexer)
+
+	assert.NilError(t, store.SimpleExampleTable().Insert(ctx, &testpb.SimpleExample{Name: "foo", Unique: "bar"}))
+	changeSet := kvStore.popChangeSet()
+
+	// the state changes are only applied along with the Commit response
+	_, err := sink.Push(ctx, &storev1beta1.StreamMessage{
+		BlockHeight:      1,
+		RequestEndBlock:  &abciv1beta1.RequestEndBlock{Height: 1},
+		ResponseEndBlock: &abciv1beta1.ResponseEndBlock{},
+		StateChanges:     changeSet,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", tdb.statements())
+
+	_, err = sink.Push(ctx, &storev1beta1.StreamMessage{
+		BlockHeight:    1,
+		ResponseCommit: &abciv1beta1.ResponseCommit{Data: []byte{1, 2, 3}},
+		StateChanges:   changeSet,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, `INSERT INTO "testpb_simple_example" ("name", "unique", "not_unique") VALUES ($1, $2, $3) ON CONFLICT ("name") DO UPDATE SET "unique" = EXCLUDED."unique", "not_unique" = EXCLUDED."not_unique"
+	$1 = 'foo'
+	$2 = 'bar'
+	$3 = ''`, tdb.statements())
+
+	// a failure is reported to the streaming service
+	tdb.setFail(true)
+	_, err = sink.Push(ctx, &storev1beta1.StreamMessage{
+		BlockHeight:    2,
+		ResponseCommit: &abciv1beta1.ResponseCommit{},
+		StateChanges:   changeSet,
+	})
+	assert.ErrorContains(t, err, "database unavailable")
+}
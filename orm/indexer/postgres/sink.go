@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	storev1beta1 "cosmossdk.io/api/cosmos/base/store/v1beta1"
+)
+
+var _ storev1beta1.StreamingSinkServer = &Sink{}
+
+// Sink is a StreamingSink server, to be used with the gRPC streaming service
+// of a node, mirroring the state changes of each committed block into the
+// SQL tables of an Indexer.
+type Sink struct {
+	storev1beta1.UnimplementedStreamingSinkServer
+
+	indexer *Indexer
+}
+
+// NewSink returns a Sink applying the state changes with the provided Indexer.
+func NewSink(indexer *Indexer) *Sink {
+	return &Sink{indexer: indexer}
+}
+
+// Push applies the state changes of a block once its Commit message is
+// received, and ignores the other messages. A failure is returned to the
+// streaming service so that it applies its back pressure policy.
+func (s *Sink) Push(ctx context.Context, msg *storev1beta1.StreamMessage) (*storev1beta1.PushResponse, error) {
+	if msg.ResponseCommit == nil {
+		return &storev1beta1.PushResponse{}, nil
+	}
+
+	if err := s.indexer.ApplyChanges(ctx, msg.StateChanges); err != nil {
+		return nil, err
+	}
+	return &storev1beta1.PushResponse{}, nil
+}
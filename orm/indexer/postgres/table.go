@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/fieldnames"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// table maps an ORM table or singleton to a SQL table with a column per
+// message field.
+type table struct {
+	name        string
+	messageType protoreflect.MessageType
+	message     protoreflect.MessageDescriptor
+	columns     []column
+	primaryKey  []column
+	indexes     []index
+	singleton   bool
+}
+
+// index maps a secondary index of an ORM table to a SQL index.
+type index struct {
+	name    string
+	columns []column
+	unique  bool
+}
+
+func newTable(messageType protoreflect.MessageType) (*table, error) {
+	message := messageType.Descriptor()
+	t := &table{
+		name:        tableName(message),
+		messageType: messageType,
+		message:     message,
+	}
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		t.columns = append(t.columns, column{field: fields.Get(i)})
+	}
+
+	tableDesc := proto.GetExtension(message.Options(), ormv1.E_Table).(*ormv1.TableDescriptor)
+	singletonDesc := proto.GetExtension(message.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor)
+	switch {
+	case tableDesc != nil:
+		if tableDesc.PrimaryKey == nil {
+			return nil, ormerrors.MissingPrimaryKey.Wrap(string(message.FullName()))
+		}
+
+		var err error
+		t.primaryKey, err = t.fieldColumns(tableDesc.PrimaryKey.Fields)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, idxDesc := range tableDesc.Index {
+			columns, err := t.fieldColumns(idxDesc.Fields)
+			if err != nil {
+				return nil, err
+			}
+
+			t.indexes = append(t.indexes, index{
+				name:    fmt.Sprintf("%s_idx_%d", t.name, idxDesc.Id),
+				columns: columns,
+				unique:  idxDesc.Unique,
+			})
+		}
+	case singletonDesc != nil:
+		t.singleton = true
+	default:
+		return nil, ormerrors.InvalidTableDefinition.Wrapf("missing table or singleton descriptor for %s", message.FullName())
+	}
+
+	return t, nil
+}
+
+// tableName returns the name of the SQL table of the message, which is its
+// fully-qualified name in snake case, ex. testpb_example_table for
+// testpb.ExampleTable.
+func tableName(message protoreflect.MessageDescriptor) string {
+	pkg := string(message.ParentFile().Package())
+	name := strings.TrimPrefix(string(message.FullName()), pkg+".")
+
+	parts := strings.Split(pkg, ".")
+	for _, part := range strings.Split(name, ".") {
+		parts = append(parts, strcase.ToSnake(part))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (t *table) fieldColumns(fields string) ([]column, error) {
+	var columns []column
+	for _, name := range fieldnames.CommaSeparatedFieldNames(fields).Names() {
+		field := t.message.Fields().ByName(name)
+		if field == nil {
+			return nil, ormerrors.FieldNotFound.Wrapf("%s on %s", name, t.message.FullName())
+		}
+		columns = append(columns, column{field: field})
+	}
+	return columns, nil
+}
+
+// createStatements returns the statements creating the table and its indexes
+// if they don't exist.
+func (t *table) createStatements() []string {
+	definitions := make([]string, 0, len(t.columns)+1)
+	for _, col := range t.columns {
+		definitions = append(definitions, col.definition())
+	}
+	if len(t.primaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", columnList(t.primaryKey)))
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)", quoteIdentifier(t.name), strings.Join(definitions, ",\n\t")),
+	}
+	for _, idx := range t.indexes {
+		create := "CREATE INDEX"
+		if idx.unique {
+			create = "CREATE UNIQUE INDEX"
+		}
+		statements = append(statements, fmt.Sprintf("%s IF NOT EXISTS %s ON %s (%s)",
+			create, quoteIdentifier(idx.name), quoteIdentifier(t.name), columnList(idx.columns)))
+	}
+	return statements
+}
+
+// save inserts the message into the table, or updates the row with the same
+// primary key.
+func (t *table) save(ctx context.Context, tx *sql.Tx, message proto.Message) error {
+	msg := message.ProtoReflect()
+	args := make([]interface{}, len(t.columns))
+	placeholders := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		value, err := col.messageValue(msg)
+		if err != nil {
+			return err
+		}
+		args[i] = value
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quoteIdentifier(t.name), columnList(t.columns), strings.Join(placeholders, ", "))
+	if t.singleton {
+		// a singleton table holds at most one row
+		if err := t.delete(ctx, tx, nil); err != nil {
+			return err
+		}
+	} else {
+		query += t.onConflict()
+	}
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// onConflict returns the ON CONFLICT clause turning an INSERT statement into
+// an update of the row with the same primary key.
+func (t *table) onConflict() string {
+	isKey := make(map[protoreflect.Name]bool, len(t.primaryKey))
+	for _, col := range t.primaryKey {
+		isKey[col.field.Name()] = true
+	}
+
+	var updates []string
+	for _, col := range t.columns {
+		if !isKey[col.field.Name()] {
+			name := quoteIdentifier(col.name())
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", name, name))
+		}
+	}
+
+	if len(updates) == 0 {
+		return fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", columnList(t.primaryKey))
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", columnList(t.primaryKey), strings.Join(updates, ", "))
+}
+
+// delete deletes the row with the provided primary key values from the table.
+func (t *table) delete(ctx context.Context, tx *sql.Tx, key []protoreflect.Value) error {
+	if len(key) != len(t.primaryKey) {
+		return ormerrors.UnexpectedError.Wrapf("expected %d primary key values for %s, got %d", len(t.primaryKey), t.message.FullName(), len(key))
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s", quoteIdentifier(t.name))
+	args := make([]interface{}, len(key))
+	conditions := make([]string, len(key))
+	for i, col := range t.primaryKey {
+		value, err := col.value(key[i])
+		if err != nil {
+			return err
+		}
+		args[i] = value
+		conditions[i] = fmt.Sprintf("%s = $%d", quoteIdentifier(col.name()), i+1)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func columnList(columns []column) string {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = quoteIdentifier(col.name())
+	}
+	return strings.Join(names, ", ")
+}
+
+// quoteIdentifier quotes a SQL identifier so that it can't be mistaken for a
+// keyword, ex. a field named "order".
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -49,6 +49,7 @@ type App struct {
 	endBlockers       []func(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 	baseAppOptions    []BaseAppOption
 	msgServiceRouter  *baseapp.MsgServiceRouter
+	genesisSource     module.GenesisSource
 }
 
 // RegisterModules registers the provided modules with the module manager and
@@ -119,8 +120,27 @@ func (a *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respons
 	return a.ModuleManager.EndBlock(ctx, req)
 }
 
+// SetGenesisSource sets the source of the genesis state of the modules, read
+// at InitChain instead of the app state of the genesis file, which must be
+// empty.
+func (a *App) SetGenesisSource(source module.GenesisSource) {
+	a.genesisSource = source
+}
+
 // InitChainer initializes the chain.
 func (a *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	if a.genesisSource != nil {
+		if len(req.AppStateBytes) != 0 {
+			panic("the app state of the genesis file must be empty when initializing from a genesis source")
+		}
+
+		res, err := a.ModuleManager.InitGenesisFrom(ctx, a.cdc, a.genesisSource)
+		if err != nil {
+			panic(err)
+		}
+		return res
+	}
+
 	var genesisState map[string]json.RawMessage
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
@@ -33,6 +33,16 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// MempoolTypeNone disables the app-side mempool, the block proposal then
+	// consists of the transactions provided by Tendermint.
+	MempoolTypeNone = "none"
+
+	// MempoolTypePriority defines the priority ordered app-side mempool.
+	MempoolTypePriority = "priority"
+
+	// MempoolTypeSenderNonce defines the sender sequence ordered app-side mempool.
+	MempoolTypeSenderNonce = "sender-nonce"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -84,6 +94,11 @@ type BaseConfig struct {
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
+	// ParallelExecutionWorkers defines the number of workers speculatively
+	// executing the transactions of a block concurrently. A value of 0 disables
+	// parallel execution.
+	ParallelExecutionWorkers uint64 `mapstructure:"parallel-execution-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -192,6 +207,13 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// MempoolConfig defines the configuration of the app-side mempool.
+type MempoolConfig struct {
+	// Type defines the app-side mempool implementation, one of "none",
+	// "priority" or "sender-nonce".
+	Type string `mapstructure:"type"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -203,6 +225,7 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Mempool   MempoolConfig    `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -283,6 +306,9 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Mempool: MempoolConfig{
+			Type: MempoolTypeNone,
+		},
 	}
 }
 
@@ -317,6 +343,8 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
 			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
 			AppDBBackend:      v.GetString("app-db-backend"),
+
+			ParallelExecutionWorkers: v.GetUint64("parallel-execution-workers"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -363,6 +391,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Mempool: MempoolConfig{
+			Type: v.GetString("mempool.type"),
+		},
 	}, nil
 }
 
@@ -74,6 +74,11 @@ index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 # Default cache size is 50mb.
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
+# ParallelExecutionWorkers defines the number of workers speculatively executing
+# the transactions of a block concurrently, the outcome being identical to a
+# serial execution. A value of 0 disables parallel execution.
+parallel-execution-workers = {{ .BaseConfig.ParallelExecutionWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -231,6 +236,19 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                           Mempool Configuration                         ###
+###############################################################################
+
+# The app-side mempool is fed by CheckTx and used to build block proposals.
+[mempool]
+
+# type defines the app-side mempool implementation:
+# "none": no app-side mempool, block proposals contain the transactions provided by Tendermint (default).
+# "priority": transactions are ordered by priority, honoring each sender's sequence ordering.
+# "sender-nonce": transactions are strictly ordered by sender sequence, senders being served in turn.
+type = "{{ .Mempool.Type }}"
 `
 
 var configTemplate *template.Template
@@ -13,12 +13,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
 const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagOutputDir        = "output-dir"
+	FlagOutputTarball    = "output-tarball"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -26,6 +30,15 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export state to JSON",
+		Long: `Export state to JSON.
+
+By default the genesis document, including the state of all the modules, is built in
+memory and printed. For large states, --output-dir streams the genesis to a directory
+holding the genesis document without its app state in genesis.json and the state of
+each module in app_state/{module}.json, written one module at a time. --output-tarball
+writes the same files to a tar archive. Such a streamed genesis is imported by starting
+a node with --streamed-genesis, the genesis.json of the export being its genesis file.
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
@@ -65,9 +78,32 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			height, _ := cmd.Flags().GetInt64(FlagHeight)
 			forZeroHeight, _ := cmd.Flags().GetBool(FlagForZeroHeight)
 			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(FlagJailAllowedAddrs)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+			outputTarball, _ := cmd.Flags().GetString(FlagOutputTarball)
+
+			var streamedGenesis *genutil.StreamedGenesisWriter
+			switch {
+			case outputDir != "" && outputTarball != "":
+				return fmt.Errorf("--%s and --%s are mutually exclusive", FlagOutputDir, FlagOutputTarball)
+			case outputDir != "":
+				streamedGenesis, err = genutil.NewStreamedGenesisDirWriter(outputDir)
+			case outputTarball != "":
+				streamedGenesis, err = genutil.NewStreamedGenesisTarballWriter(outputTarball)
+			}
+			if err != nil {
+				return err
+			}
+
+			var genesisTarget module.GenesisTarget
+			if streamedGenesis != nil {
+				genesisTarget = streamedGenesis.ModuleTarget()
+			}
 
-			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper)
+			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper, genesisTarget)
 			if err != nil {
+				if streamedGenesis != nil {
+					_ = streamedGenesis.Close()
+				}
 				return fmt.Errorf("error exporting state: %v", err)
 			}
 
@@ -94,6 +130,14 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				},
 			}
 
+			if streamedGenesis != nil {
+				if err := streamedGenesis.WriteGenesisDoc(doc); err != nil {
+					_ = streamedGenesis.Close()
+					return err
+				}
+				return streamedGenesis.Close()
+			}
+
 			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
 			// (except for stuff inside AppState). Inside AppState, we're free
 			// to encode as protobuf or amino.
@@ -113,6 +157,8 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().String(FlagOutputDir, "", "Stream the genesis to files in the provided directory instead of printing it")
+	cmd.Flags().String(FlagOutputTarball, "", "Stream the genesis to files in a tar archive created at the provided path instead of printing it")
 
 	return cmd
 }
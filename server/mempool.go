@@ -0,0 +1,34 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// GetMempoolFromFlags parses command flags and returns the app-side mempool
+// implementation selected in the application configuration. A nil Mempool is
+// returned when the app-side mempool is disabled, which is the case when no type
+// is configured.
+func GetMempoolFromFlags(appOpts types.AppOptions) (mempool.Mempool, error) {
+	mempoolType := strings.ToLower(cast.ToString(appOpts.Get(FlagMempoolType)))
+
+	switch mempoolType {
+	case config.MempoolTypeNone, "":
+		return nil, nil
+
+	case config.MempoolTypePriority:
+		return mempool.NewPriorityMempool(), nil
+
+	case config.MempoolTypeSenderNonce:
+		return mempool.NewSenderNonceMempool(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown mempool type %s", mempoolType)
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestGetMempoolFromFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		mempoolType string
+		expected    mempool.Mempool
+		wantErr     bool
+	}{
+		{
+			name:     "default",
+			expected: nil,
+		},
+		{
+			name:        config.MempoolTypePriority,
+			mempoolType: config.MempoolTypePriority,
+			expected:    mempool.NewPriorityMempool(),
+		},
+		{
+			name:        config.MempoolTypeSenderNonce,
+			mempoolType: config.MempoolTypeSenderNonce,
+			expected:    mempool.NewSenderNonceMempool(),
+		},
+		{
+			name:        config.MempoolTypeNone,
+			mempoolType: config.MempoolTypeNone,
+			expected:    nil,
+		},
+		{
+			name:        "unknown",
+			mempoolType: "fifo",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			if tt.mempoolType != "" {
+				v.Set(FlagMempoolType, tt.mempoolType)
+			}
+
+			mp, err := GetMempoolFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, mp)
+		})
+	}
+}
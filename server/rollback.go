@@ -1,52 +1,342 @@
 package server
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmstore "github.com/tendermint/tendermint/proto/tendermint/store"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/spf13/cobra"
-	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
+const (
+	flagRollbackHeight     = "height"
+	flagRollbackNumHeights = "num-heights"
+	flagRollbackPrune      = "prune"
+	flagRollbackDryRun     = "dry-run"
+)
+
+// NewRollbackCmd creates a command to rollback tendermint and multistore state by one or more heights.
 func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
-progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting Tendermint the transactions in block n will be re-executed against the
-application.
+progress, or from a bad upgrade. Rollback overwrites a state at height n with the
+state at a target height, n - 1 by default. The application also rolls back to the
+target height, and its app hash is verified against the Tendermint block store.
+
+The blocks after the target height + 1 are removed from the block store, and upon
+restarting Tendermint the transactions in the block at the target height + 1 are
+re-executed against the application, the following blocks being synced again from
+the network.
+
+By default, the versions after the target height are deleted from the IAVL stores.
+With --prune=false they are kept, and the re-executed blocks must produce the same
+state, which is useful to verify that a node replays the chain deterministically.
+
+Use --dry-run to report the stores and versions which would be affected without
+writing anything.
 `,
+		Example: fmt.Sprintf("$ %s rollback --num-heights 3\n$ %s rollback --height 1000 --dry-run", version.AppName, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
-			home := cfg.RootDir
-			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+
+			targetHeight, _ := cmd.Flags().GetInt64(flagRollbackHeight)
+			numHeights, _ := cmd.Flags().GetInt64(flagRollbackNumHeights)
+			prune, _ := cmd.Flags().GetBool(flagRollbackPrune)
+			dryRun, _ := cmd.Flags().GetBool(flagRollbackDryRun)
+			if targetHeight != 0 && cmd.Flags().Changed(flagRollbackNumHeights) {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", flagRollbackHeight, flagRollbackNumHeights)
+			}
+			if numHeights < 1 {
+				return fmt.Errorf("--%s must be positive, got %d", flagRollbackNumHeights, numHeights)
+			}
+
+			blockStoreDB, stateStore, err := openTendermintStores(cfg)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = blockStoreDB.Close()
+				_ = stateStore.Close()
+			}()
+
+			tmState, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if tmState.IsEmpty() {
+				return errors.New("no tendermint state found")
+			}
+			height := tmState.LastBlockHeight
+			if targetHeight == 0 {
+				targetHeight = height - numHeights
+			}
+
+			appHash, err := checkTendermintRollback(blockStoreDB, stateStore, height, targetHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(cfg.RootDir, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
 			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
-			// rollback tendermint state
-			height, hash, err := tmcmd.RollbackState(ctx.Config)
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("rollback is not supported by the %T multistore", app.CommitMultiStore())
+			}
+
+			// verify the app hash before writing anything
+			appVersion := cms.LastCommitID().Version
+			if appVersion < targetHeight {
+				return fmt.Errorf("cannot rollback the application at height %d to the greater height %d", appVersion, targetHeight)
+			}
+			commitInfo, err := cms.GetCommitInfo(targetHeight)
 			if err != nil {
+				return fmt.Errorf("failed to load the application state at height %d: %w", targetHeight, err)
+			}
+			if !bytes.Equal(commitInfo.Hash(), appHash) {
+				return fmt.Errorf("app hash %X at height %d doesn't match the app hash %X of the block store", commitInfo.Hash(), targetHeight, appHash)
+			}
+			iavlStores, err := iavlStoresByName(cms)
+			if err != nil {
+				return err
+			}
+			for name, iavlStore := range iavlStores {
+				if !iavlStore.VersionExists(targetHeight) {
+					return fmt.Errorf("store %s has no version %d, it may have been pruned", name, targetHeight)
+				}
+			}
+
+			if dryRun {
+				printRollbackPlan(cmd, iavlStores, blockStoreDB, height, appVersion, targetHeight, appHash, prune)
+				return nil
+			}
+
+			// rollback tendermint state
+			if err := rollbackTendermintState(blockStoreDB, stateStore, targetHeight); err != nil {
 				return fmt.Errorf("failed to rollback tendermint state: %w", err)
 			}
-			// rollback the multistore
 
-			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+			// rollback the multistore
+			if prune {
+				err = cms.RollbackToVersion(targetHeight)
+			} else {
+				err = cms.RewindToVersion(targetHeight)
+			}
+			if err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
-			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
+			if commitID := cms.LastCommitID(); commitID.Version != targetHeight || !bytes.Equal(commitID.Hash, appHash) {
+				return fmt.Errorf("rolled back application state at height %d with app hash %X doesn't match the app hash %X of the block store", commitID.Version, commitID.Hash, appHash)
+			}
+
+			cmd.Printf("Rolled back state from height %d to height %d and hash %X\n", height, targetHeight, appHash)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagRollbackHeight, 0, "The height to rollback to, instead of a number of heights")
+	cmd.Flags().Int64(flagRollbackNumHeights, 1, "The number of heights to rollback")
+	cmd.Flags().Bool(flagRollbackPrune, true, "Delete the versions after the target height from the IAVL stores")
+	cmd.Flags().Bool(flagRollbackDryRun, false, "Report the stores and versions affected by the rollback without writing anything")
 	return cmd
 }
+
+// printRollbackPlan reports the blocks and the store versions discarded by a
+// rollback to the target height.
+func printRollbackPlan(cmd *cobra.Command, iavlStores map[string]*iavl.Store, blockStoreDB dbm.DB, height, appVersion, targetHeight int64, appHash []byte, prune bool) {
+	blockStore := store.NewBlockStore(blockStoreDB)
+	cmd.Printf("Tendermint state: height %d -> %d, app hash %X verified\n", height, targetHeight, appHash)
+	if blockStore.Height() > targetHeight+1 {
+		cmd.Printf("Block store: blocks %d to %d removed, block %d re-executed on restart\n", targetHeight+2, blockStore.Height(), targetHeight+1)
+	} else {
+		cmd.Printf("Block store: no block removed, block %d re-executed on restart\n", targetHeight+1)
+	}
+	cmd.Printf("Application state: version %d -> %d\n", appVersion, targetHeight)
+
+	names := make([]string, 0, len(iavlStores))
+	for name := range iavlStores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cmd.Println("Stores:")
+	for _, name := range names {
+		var discarded []int
+		for _, v := range iavlStores[name].GetAllVersions() {
+			if int64(v) > targetHeight {
+				discarded = append(discarded, v)
+			}
+		}
+
+		switch {
+		case len(discarded) == 0:
+			cmd.Printf("  %s: no version after %d\n", name, targetHeight)
+		case prune:
+			cmd.Printf("  %s: %d versions deleted (%d to %d)\n", name, len(discarded), discarded[0], discarded[len(discarded)-1])
+		default:
+			cmd.Printf("  %s: %d versions kept (%d to %d)\n", name, len(discarded), discarded[0], discarded[len(discarded)-1])
+		}
+	}
+}
+
+// iavlStoresByName returns the IAVL stores of the multistore by name.
+func iavlStoresByName(cms *rootmulti.Store) (map[string]*iavl.Store, error) {
+	stores := map[string]*iavl.Store{}
+	for name, key := range cms.StoreKeysByName() {
+		kvStore := cms.GetCommitKVStore(key)
+		if kvStore == nil || kvStore.GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+
+		iavlStore, ok := kvStore.(*iavl.Store)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T of IAVL store %s", kvStore, name)
+		}
+		stores[name] = iavlStore
+	}
+	return stores, nil
+}
+
+// openTendermintStores opens the block store database and the state store of
+// Tendermint.
+func openTendermintStores(cfg *tmcfg.Config) (dbm.DB, state.Store, error) {
+	dbType := dbm.BackendType(cfg.DBBackend)
+
+	if !tmos.FileExists(filepath.Join(cfg.DBDir(), "blockstore.db")) {
+		return nil, nil, fmt.Errorf("no blockstore found in %v", cfg.DBDir())
+	}
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, cfg.DBDir())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !tmos.FileExists(filepath.Join(cfg.DBDir(), "state.db")) {
+		_ = blockStoreDB.Close()
+		return nil, nil, fmt.Errorf("no statestore found in %v", cfg.DBDir())
+	}
+	stateDB, err := dbm.NewDB("state", dbType, cfg.DBDir())
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+
+	return blockStoreDB, stateStore, nil
+}
+
+// checkTendermintRollback checks that the Tendermint state at the provided
+// height can be rolled back to the target height, and returns the app hash
+// of the state at the target height, as agreed upon in the following block.
+func checkTendermintRollback(blockStoreDB dbm.DB, stateStore state.Store, height, targetHeight int64) ([]byte, error) {
+	blockStore := store.NewBlockStore(blockStoreDB)
+	if targetHeight >= height {
+		return nil, fmt.Errorf("cannot rollback to height %d, the tendermint state is at height %d", targetHeight, height)
+	}
+	if targetHeight < blockStore.Base() {
+		return nil, fmt.Errorf("cannot rollback to height %d, the block store starts at height %d", targetHeight, blockStore.Base())
+	}
+
+	for h := targetHeight; h <= height; h++ {
+		if blockStore.LoadBlockMeta(h) == nil {
+			return nil, fmt.Errorf("block at height %d not found", h)
+		}
+	}
+	for h := targetHeight; h < height; h++ {
+		if _, err := stateStore.LoadValidators(h); err != nil {
+			return nil, fmt.Errorf("validators at height %d not found: %w", h, err)
+		}
+		if _, err := stateStore.LoadConsensusParams(h + 1); err != nil {
+			return nil, fmt.Errorf("consensus params at height %d not found: %w", h+1, err)
+		}
+	}
+
+	return blockStore.LoadBlockMeta(targetHeight + 1).Header.AppHash, nil
+}
+
+// rollbackTendermintState rolls back the Tendermint state to the target
+// height, one height at a time, and removes the blocks after the target
+// height + 1 from the block store, since Tendermint only replays the block
+// following its state on restart.
+func rollbackTendermintState(blockStoreDB dbm.DB, stateStore state.Store, targetHeight int64) error {
+	for {
+		tmState, err := stateStore.Load()
+		if err != nil {
+			return err
+		}
+		if tmState.LastBlockHeight <= targetHeight {
+			return nil
+		}
+
+		blockStore := store.NewBlockStore(blockStoreDB)
+		if blockStore.Height() > tmState.LastBlockHeight {
+			if err := deleteLatestBlock(blockStoreDB, blockStore); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, _, err := state.Rollback(blockStore, stateStore); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteLatestBlock removes the latest block from the block store. The keys
+// mirror the ones of the Tendermint block store.
+func deleteLatestBlock(blockStoreDB dbm.DB, blockStore *store.BlockStore) error {
+	height := blockStore.Height()
+
+	batch := blockStoreDB.NewBatch()
+	defer batch.Close()
+
+	keys := [][]byte{
+		[]byte(fmt.Sprintf("C:%v", height-1)),
+		[]byte(fmt.Sprintf("SC:%v", height)),
+	}
+	if meta := blockStore.LoadBlockMeta(height); meta != nil {
+		keys = append(keys, []byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)))
+		for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", height, i)))
+		}
+	}
+	// delete the block meta last, as it's loaded first to check that a block exists
+	keys = append(keys, []byte(fmt.Sprintf("H:%v", height)))
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	store.SaveBlockStoreState(&tmstore.BlockStoreState{Base: blockStore.Base(), Height: height - 1}, blockStoreDB)
+	return nil
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmversioninfo "github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func rollbackTestAppHash(height int64) []byte {
+	return tmhash.Sum([]byte(fmt.Sprintf("app_hash_%d", height)))
+}
+
+// setupRollbackStores returns a block store and a state store at the provided
+// height, the app hash of each height being committed in the following block.
+func setupRollbackStores(t *testing.T, height int64) (dbm.DB, state.Store) {
+	blockStoreDB := dbm.NewMemDB()
+	stateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{DiscardABCIResponses: false})
+	saveRollbackStores(t, blockStoreDB, stateStore, height, rollbackTestAppHash)
+	return blockStoreDB, stateStore
+}
+
+// saveRollbackStores saves the blocks and the states up to the provided height
+// in the block store and the state store.
+func saveRollbackStores(t *testing.T, blockStoreDB dbm.DB, stateStore state.Store, height int64, appHash func(int64) []byte) {
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	valSet, _ := tmtypes.RandValidatorSet(5, 10)
+	tmState := state.State{
+		Version: tmstate.Version{
+			Consensus: tmversion.Consensus{
+				Block: tmversioninfo.BlockProtocol,
+				App:   1,
+			},
+			Software: tmversioninfo.TMCoreSemVer,
+		},
+		ChainID:                          "test-chain",
+		InitialHeight:                    1,
+		LastBlockHeight:                  1,
+		AppHash:                          appHash(1),
+		LastValidators:                   valSet,
+		Validators:                       valSet,
+		NextValidators:                   valSet,
+		LastHeightValidatorsChanged:      2,
+		ConsensusParams:                  *tmtypes.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 2,
+	}
+	require.NoError(t, stateStore.Bootstrap(tmState))
+
+	lastCommit := &tmtypes.Commit{}
+	for h := int64(1); h <= height; h++ {
+		block := tmtypes.MakeBlock(h, nil, lastCommit, nil)
+		block.ChainID = tmState.ChainID
+		block.AppHash = appHash(h - 1)
+		block.ProposerAddress = valSet.Proposer.Address
+		partSet, err := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		lastCommit = &tmtypes.Commit{Height: h, BlockID: blockID}
+		blockStore.SaveBlock(block, partSet, lastCommit)
+
+		if h > 1 {
+			tmState.LastBlockHeight = h
+			tmState.LastBlockID = blockID
+			tmState.AppHash = appHash(h)
+			require.NoError(t, stateStore.Save(tmState))
+		}
+	}
+}
+
+func TestCheckTendermintRollback(t *testing.T) {
+	blockStoreDB, stateStore := setupRollbackStores(t, 5)
+
+	appHash, err := checkTendermintRollback(blockStoreDB, stateStore, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, rollbackTestAppHash(2), appHash)
+
+	_, err = checkTendermintRollback(blockStoreDB, stateStore, 5, 5)
+	require.ErrorContains(t, err, "cannot rollback to height 5")
+	_, err = checkTendermintRollback(blockStoreDB, stateStore, 5, 0)
+	require.ErrorContains(t, err, "the block store starts at height 1")
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	require.NoError(t, deleteLatestBlock(blockStoreDB, blockStore))
+	_, err = checkTendermintRollback(blockStoreDB, stateStore, 5, 2)
+	require.ErrorContains(t, err, "block at height 5 not found")
+}
+
+func TestRollbackTendermintState(t *testing.T) {
+	blockStoreDB, stateStore := setupRollbackStores(t, 5)
+	removedBlock := store.NewBlockStore(blockStoreDB).LoadBlockMeta(4)
+	require.NotNil(t, removedBlock)
+
+	require.NoError(t, rollbackTendermintState(blockStoreDB, stateStore, 2))
+
+	tmState, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), tmState.LastBlockHeight)
+	require.Equal(t, rollbackTestAppHash(2), []byte(tmState.AppHash))
+
+	// the block following the state is kept to be replayed on restart
+	blockStore := store.NewBlockStore(blockStoreDB)
+	require.Equal(t, int64(1), blockStore.Base())
+	require.Equal(t, int64(3), blockStore.Height())
+	require.NotNil(t, blockStore.LoadBlockPart(3, 0))
+	requireHasKey(t, blockStoreDB, "SC:3", true)
+	require.Equal(t, tmState.LastBlockID, blockStore.LoadBlockMeta(2).BlockID)
+	for h := int64(4); h <= 5; h++ {
+		require.Nil(t, blockStore.LoadBlockMeta(h))
+		require.Nil(t, blockStore.LoadBlockPart(h, 0))
+		requireHasKey(t, blockStoreDB, fmt.Sprintf("SC:%d", h), false)
+		requireHasKey(t, blockStoreDB, fmt.Sprintf("C:%d", h-1), false)
+	}
+	require.Nil(t, blockStore.LoadBlockByHash(removedBlock.BlockID.Hash))
+
+	// the rolled back state is replayed from the kept block
+	require.NoError(t, rollbackTendermintState(blockStoreDB, stateStore, 2))
+	require.Equal(t, int64(3), store.NewBlockStore(blockStoreDB).Height())
+}
+
+// rollbackTestApp is an application which only exposes its multistore, the
+// only part of the application used by the rollback command.
+type rollbackTestApp struct {
+	types.Application
+	cms sdk.CommitMultiStore
+}
+
+func (app rollbackTestApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
+func newRollbackTestStore(t *testing.T, db dbm.DB, key storetypes.StoreKey) *rootmulti.Store {
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	return cms
+}
+
+func TestRollbackCmdDryRun(t *testing.T) {
+	home := t.TempDir()
+	serverCtx := NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	cfg := serverCtx.Config
+	dbType := dbm.BackendType(cfg.DBBackend)
+	key := storetypes.NewKVStoreKey("main")
+
+	// commit the application state, and the blocks agreeing on its app hashes
+	db, err := openDB(home, GetAppDBBackend(serverCtx.Viper))
+	require.NoError(t, err)
+	cms := newRollbackTestStore(t, db, key)
+	appHashes := map[int64][]byte{}
+	for h := int64(1); h <= 5; h++ {
+		cms.GetCommitKVStore(key).Set([]byte("key"), []byte(fmt.Sprintf("value_%d", h)))
+		appHashes[h] = cms.Commit().Hash
+	}
+	lastCommitID := cms.LastCommitID()
+	require.NoError(t, db.Close())
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, cfg.DBDir())
+	require.NoError(t, err)
+	stateDB, err := dbm.NewDB("state", dbType, cfg.DBDir())
+	require.NoError(t, err)
+	stateStore := state.NewStore(stateDB, state.StoreOptions{DiscardABCIResponses: false})
+	saveRollbackStores(t, blockStoreDB, stateStore, 5, func(h int64) []byte { return appHashes[h] })
+	require.NoError(t, blockStoreDB.Close())
+	require.NoError(t, stateStore.Close())
+
+	var appDB dbm.DB
+	appCreator := func(_ log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+		appDB = db
+		return rollbackTestApp{cms: newRollbackTestStore(t, db, key)}
+	}
+	cmd := NewRollbackCmd(appCreator, home)
+	cmd.SetArgs([]string{"--height", "3", "--dry-run"})
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	ctx := context.WithValue(context.Background(), ServerContextKey, serverCtx)
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "Application state: version 5 -> 3")
+	require.NotContains(t, out.String(), "Rolled back state")
+	require.NoError(t, appDB.Close())
+
+	// nothing has been written
+	blockStoreDB, stateStore, err = openTendermintStores(cfg)
+	require.NoError(t, err)
+	defer func() {
+		_ = blockStoreDB.Close()
+		_ = stateStore.Close()
+	}()
+	require.Equal(t, int64(5), store.NewBlockStore(blockStoreDB).Height())
+	tmState, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), tmState.LastBlockHeight)
+
+	db, err = openDB(home, GetAppDBBackend(serverCtx.Viper))
+	require.NoError(t, err)
+	defer db.Close()
+	require.Equal(t, lastCommitID, newRollbackTestStore(t, db, key).LastCommitID())
+}
+
+func requireHasKey(t *testing.T, db dbm.DB, key string, expected bool) {
+	has, err := db.Has([]byte(key))
+	require.NoError(t, err)
+	require.Equal(t, expected, has, key)
+}
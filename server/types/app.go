@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -68,7 +69,8 @@ type (
 	// ExportedApp represents an exported app state, along with
 	// validators, consensus params and latest app height.
 	ExportedApp struct {
-		// AppState is the application state as JSON.
+		// AppState is the application state as JSON. It is empty when the
+		// application state is streamed to a genesis target.
 		AppState json.RawMessage
 		// Validators is the exported validator set.
 		Validators []tmtypes.GenesisValidator
@@ -80,5 +82,7 @@ type (
 
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
-	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+	// When the genesis target is not nil, the genesis state of each module is
+	// written to the target instead of the AppState of the exported app.
+	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions, module.GenesisTarget) (ExportedApp, error)
 )
@@ -9,6 +9,7 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -18,6 +19,15 @@ import (
 // file.
 func (app *SimApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
+	return app.ExportAppStateAndValidatorsTo(forZeroHeight, jailAllowedAddrs, nil)
+}
+
+// ExportAppStateAndValidatorsTo exports the state of the application for a
+// genesis file, streaming the genesis state of each module to the provided
+// target when not nil.
+func (app *SimApp) ExportAppStateAndValidatorsTo(
+	forZeroHeight bool, jailAllowedAddrs []string, target module.GenesisTarget,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -30,10 +40,18 @@ func (app *SimApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.ModuleManager.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
+	var appState json.RawMessage
+	if target != nil {
+		if err := app.ModuleManager.ExportGenesisTo(ctx, app.appCodec, target); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	} else {
+		genState := app.ModuleManager.ExportGenesis(ctx, app.appCodec)
+		var err error
+		appState, err = json.MarshalIndent(genState, "", "  ")
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
 	}
 
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
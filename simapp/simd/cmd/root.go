@@ -12,6 +12,7 @@ import (
 	tmcfg "github.com/tendermint/tendermint/config"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"cosmossdk.io/simapp"
@@ -24,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -32,7 +34,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	simutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -81,6 +85,16 @@ func NewRootCmd() *cobra.Command {
 				return err
 			}
 
+			// SIGN_MODE_TEXTUAL renders coins with the denom metadata queried
+			// from the node of the command when signing
+			initClientCtx = initClientCtx.WithTxConfig(authtx.NewTxConfigWithTextual(
+				codec.NewProtoCodec(initClientCtx.InterfaceRegistry),
+				authtx.DefaultSignModes,
+				authtx.NewClientCoinMetadataQueryFn(func() (client.Context, error) {
+					return client.GetClientTxContext(cmd)
+				}),
+			))
+
 			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -291,9 +305,12 @@ func newApp(
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
 
-	return simapp.NewSimApp(
-		logger, db, traceStore, true,
-		appOpts,
+	appMempool, err := server.GetMempoolFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	baseAppOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
@@ -304,9 +321,51 @@ func newApp(
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize))),
+		baseapp.SetParallelExecution(cast.ToInt(appOpts.Get(server.FlagParallelExecutionWorkers))),
+		baseapp.SetMempool(appMempool),
+	}
+
+	// the chain-id is needed to validate block proposals before the first
+	// BeginBlock following a restart. When it can't be determined, it is left
+	// to InitChain and BeginBlock and block proposals aren't validated.
+	if chainID := getChainID(appOpts); chainID != "" {
+		baseAppOptions = append(baseAppOptions, baseapp.SetChainID(chainID), baseapp.SetDefaultProcessProposal())
+	} else {
+		logger.Info("chain ID unknown, not validating block proposals")
+	}
+
+	return simapp.NewSimApp(
+		logger, db, traceStore, true,
+		appOpts,
+		baseAppOptions...,
 	)
 }
 
+// getChainID returns the chain ID set with the --chain-id flag, falling back
+// to the one of the genesis file configured in Tendermint's genesis_file. It
+// returns "" if the genesis file can't be read, e.g. when it was removed
+// after a state sync.
+func getChainID(appOpts servertypes.AppOptions) string {
+	if chainID := cast.ToString(appOpts.Get(flags.FlagChainID)); chainID != "" {
+		return chainID
+	}
+
+	genesisFile := cast.ToString(appOpts.Get("genesis_file"))
+	if genesisFile == "" {
+		genesisFile = tmcfg.DefaultBaseConfig().Genesis
+	}
+	if !filepath.IsAbs(genesisFile) {
+		genesisFile = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), genesisFile)
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return ""
+	}
+
+	return genDoc.ChainID
+}
+
 // appExport creates a new simapp (optionally at a given height) and exports state.
 func appExport(
 	logger log.Logger,
@@ -316,6 +375,7 @@ func appExport(
 	forZeroHeight bool,
 	jailAllowedAddrs []string,
 	appOpts servertypes.AppOptions,
+	genesisTarget module.GenesisTarget,
 ) (servertypes.ExportedApp, error) {
 	var simApp *simapp.SimApp
 
@@ -345,5 +405,5 @@ func appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, appOpts)
 	}
 
-	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	return simApp.ExportAppStateAndValidatorsTo(forZeroHeight, jailAllowedAddrs, genesisTarget)
 }
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestGetChainID(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "relocated"), 0o755))
+
+	saveGenesis := func(path, chainID string) {
+		genDoc := &tmtypes.GenesisDoc{ChainID: chainID}
+		require.NoError(t, genDoc.ValidateAndComplete())
+		require.NoError(t, genDoc.SaveAs(filepath.Join(home, path)))
+	}
+	saveGenesis("config/genesis.json", "default-chain")
+	saveGenesis("relocated/genesis.json", "relocated-chain")
+
+	testCases := []struct {
+		name    string
+		opts    map[string]interface{}
+		chainID string
+	}{
+		{
+			"chain-id flag",
+			map[string]interface{}{flags.FlagChainID: "flag-chain"},
+			"flag-chain",
+		},
+		{
+			"default genesis file",
+			map[string]interface{}{},
+			"default-chain",
+		},
+		{
+			"genesis file relative to the home directory",
+			map[string]interface{}{"genesis_file": "relocated/genesis.json"},
+			"relocated-chain",
+		},
+		{
+			"absolute genesis file",
+			map[string]interface{}{"genesis_file": filepath.Join(home, "relocated", "genesis.json")},
+			"relocated-chain",
+		},
+		{
+			"missing genesis file",
+			map[string]interface{}{"genesis_file": "missing/genesis.json"},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appOpts := viper.New()
+			appOpts.Set(flags.FlagHome, home)
+			for k, v := range tc.opts {
+				appOpts.Set(k, v)
+			}
+
+			require.Equal(t, tc.chainID, getChainID(appOpts))
+		})
+	}
+}
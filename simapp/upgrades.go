@@ -4,8 +4,10 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -31,6 +33,8 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			Added: []string{
 				group.ModuleName,
 				nft.ModuleName,
+				epochingtypes.ModuleName,
+				tokenfactorytypes.ModuleName,
 			},
 		}
 
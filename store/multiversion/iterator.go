@@ -0,0 +1,267 @@
+package multiversion
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// parentChunkSize is the number of parent store items read at once by an
+// iterator. Parent iterators are only held while the Memory lock is, so that
+// the parent stores are never iterated concurrently.
+const parentChunkSize = 64
+
+// iterator iterates over a View, merging the values written by the preceding
+// transactions, and by the View itself, with the ones of the parent store.
+type iterator struct {
+	view       *View
+	start, end []byte
+	ascending  bool
+	read       *rangeRead
+
+	// written holds the written items of the domain in iteration order,
+	// deleted keys having a nil value.
+	written []types.KVPair
+
+	// chunk holds the parent items read so far and not yet iterated over.
+	chunk []types.KVPair
+	// cursor is the bound of the parent domain left to read: the start of the
+	// domain when ascending, its end otherwise.
+	cursor     []byte
+	parentDone bool
+
+	key, value []byte
+	valid      bool
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func (v *View) iterator(start, end []byte, ascending bool) types.Iterator {
+	var (
+		keys    []string
+		entries []*entry
+	)
+
+	for {
+		var (
+			blocking Version
+			ok       bool
+		)
+
+		keys, entries, blocking, ok = v.store.snapshot(start, end, ascending, v.version.Index)
+		if ok {
+			break
+		}
+
+		v.memory.wait(blocking.Index)
+	}
+
+	read := &rangeRead{
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		keys:      keys,
+		versions:  make([]Version, len(entries)),
+	}
+
+	values := make(map[string][]byte, len(keys))
+	for i, e := range entries {
+		read.versions[i] = e.Version
+		values[keys[i]] = e.value
+	}
+
+	v.ranges = append(v.ranges, read)
+
+	// the View's own writes take precedence
+	for key, value := range v.writes {
+		if (start == nil || key >= string(start)) && (end == nil || key < string(end)) {
+			values[key] = value
+		}
+	}
+
+	written := make([]types.KVPair, 0, len(values))
+	for key, value := range values {
+		written = append(written, types.KVPair{Key: []byte(key), Value: value})
+	}
+
+	sort.Slice(written, func(i, j int) bool {
+		less := bytes.Compare(written[i].Key, written[j].Key) < 0
+		if ascending {
+			return less
+		}
+		return !less
+	})
+
+	it := &iterator{
+		view:      v,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+		read:      read,
+		written:   written,
+	}
+
+	if ascending {
+		it.cursor = start
+	} else {
+		it.cursor = end
+	}
+
+	it.seek()
+
+	return it
+}
+
+// Domain implements types.Iterator.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements types.Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator.
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	it.seek()
+}
+
+// Key implements types.Iterator.
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	return it.value
+}
+
+// Error implements types.Iterator.
+func (it *iterator) Error() error {
+	return nil
+}
+
+// Close implements types.Iterator.
+func (it *iterator) Close() error {
+	it.valid = false
+	it.chunk = nil
+	it.written = nil
+
+	return nil
+}
+
+// seek positions the iterator on the next non-deleted item, merging the
+// written items with the parent ones.
+func (it *iterator) seek() {
+	for {
+		parent, hasParent := it.peekParent()
+		hasWritten := len(it.written) > 0
+
+		if !hasParent && !hasWritten {
+			it.valid = false
+			it.read.exhausted = true
+			return
+		}
+
+		var item types.KVPair
+		switch {
+		case !hasWritten:
+			item = parent
+			it.chunk = it.chunk[1:]
+
+		case !hasParent:
+			item = it.written[0]
+			it.written = it.written[1:]
+
+		default:
+			cmp := bytes.Compare(it.written[0].Key, parent.Key)
+			if !it.ascending {
+				cmp = -cmp
+			}
+
+			if cmp <= 0 {
+				item = it.written[0]
+				it.written = it.written[1:]
+				if cmp == 0 {
+					// the written value shadows the parent one
+					it.chunk = it.chunk[1:]
+				}
+			} else {
+				item = parent
+				it.chunk = it.chunk[1:]
+			}
+		}
+
+		it.read.advance(item.Key)
+
+		if item.Value != nil {
+			it.key, it.value, it.valid = item.Key, item.Value, true
+			return
+		}
+	}
+}
+
+// peekParent returns the next parent item, reading a new chunk when needed.
+func (it *iterator) peekParent() (types.KVPair, bool) {
+	if len(it.chunk) == 0 && !it.parentDone {
+		it.readChunk()
+	}
+
+	if len(it.chunk) == 0 {
+		return types.KVPair{}, false
+	}
+
+	return it.chunk[0], true
+}
+
+// readChunk reads the next parentChunkSize items of the parent store.
+func (it *iterator) readChunk() {
+	memory := it.view.memory
+	memory.parentMtx.Lock()
+	defer memory.parentMtx.Unlock()
+
+	var parent types.Iterator
+	if it.ascending {
+		parent = it.view.store.parent.Iterator(it.cursor, it.end)
+	} else {
+		parent = it.view.store.parent.ReverseIterator(it.start, it.cursor)
+	}
+	defer parent.Close()
+
+	it.chunk = make([]types.KVPair, 0, parentChunkSize)
+	for ; parent.Valid() && len(it.chunk) < parentChunkSize; parent.Next() {
+		it.chunk = append(it.chunk, types.KVPair{
+			Key:   append([]byte(nil), parent.Key()...),
+			Value: append([]byte(nil), parent.Value()...),
+		})
+	}
+
+	if !parent.Valid() {
+		it.parentDone = true
+		return
+	}
+
+	last := it.chunk[len(it.chunk)-1].Key
+	if it.ascending {
+		// the smallest key greater than last
+		it.cursor = append(append([]byte(nil), last...), 0)
+		it.parentDone = it.end != nil && bytes.Compare(it.cursor, it.end) >= 0
+	} else {
+		it.cursor = last
+		it.parentDone = it.start != nil && bytes.Compare(it.cursor, it.start) <= 0
+	}
+}
@@ -0,0 +1,320 @@
+// Package multiversion implements the multi-version data structure used to
+// execute the transactions of a block concurrently, in the fashion of
+// Block-STM.
+//
+// Each transaction of the block executes against a set of Views, one per
+// store, which read the values written by the closest preceding transaction of
+// the block, or the parent store when no preceding transaction wrote the key.
+// Views record the versions they read so that an execution can later be
+// validated: it is valid as long as re-executing it would read the very same
+// versions, in which case its writes are those a serial execution would
+// produce.
+package multiversion
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Version identifies the execution which wrote a value.
+type Version struct {
+	// Index is the position in the block of the transaction which wrote the
+	// value, or -1 for a value read from the parent store.
+	Index int
+	// Incarnation is the execution attempt of the transaction, starting at 0.
+	Incarnation int
+}
+
+// parentVersion is the version of the values read from the parent store.
+var parentVersion = Version{Index: -1}
+
+// entry is a value written to a key by a transaction execution.
+type entry struct {
+	Version
+	// value is nil when the key was deleted.
+	value []byte
+	// estimate is set when the execution which wrote the value was invalidated:
+	// the transaction is being re-executed and will likely write the key again.
+	estimate bool
+}
+
+// mvStore holds the values written to a single store by the transactions of
+// the block.
+type mvStore struct {
+	mtx    sync.RWMutex
+	parent types.KVStore
+	// entries holds the values written to each key, ordered by transaction index.
+	entries map[string][]*entry
+	// keys holds the keys of entries in ascending order.
+	keys []string
+	// written holds the keys written by each transaction.
+	written map[int][]string
+}
+
+// Memory is the multi-version memory shared by the concurrent executions of
+// the transactions of a block.
+//
+// The parent stores are never written to through the Memory. They must not be
+// written to either while any View is in use since they are only read under
+// the Memory lock, and are not required to be safe for concurrent use.
+type Memory struct {
+	stores map[types.StoreKey]*mvStore
+
+	// parentMtx serializes the accesses to the parent stores.
+	parentMtx sync.Mutex
+
+	// pendingMtx guards pending.
+	pendingMtx sync.Mutex
+	// pending holds, for every transaction marked as an estimate, a channel
+	// closed once its new execution is recorded.
+	pending map[int]chan struct{}
+}
+
+// NewMemory returns an empty Memory over the given parent stores.
+func NewMemory(parents map[types.StoreKey]types.KVStore) *Memory {
+	stores := make(map[types.StoreKey]*mvStore, len(parents))
+	for key, parent := range parents {
+		stores[key] = &mvStore{
+			parent:  parent,
+			entries: make(map[string][]*entry),
+			written: make(map[int][]string),
+		}
+	}
+
+	return &Memory{
+		stores:  stores,
+		pending: make(map[int]chan struct{}),
+	}
+}
+
+// NewViews returns a View over every store of the Memory for the given
+// incarnation of the transaction at index.
+func (m *Memory) NewViews(index, incarnation int) Views {
+	views := make(Views, len(m.stores))
+	for key, store := range m.stores {
+		views[key] = newView(m, store, Version{Index: index, Incarnation: incarnation})
+	}
+
+	return views
+}
+
+// Record publishes the values written through views, replacing the ones
+// written by any previous incarnation of the same transaction. Transactions
+// waiting on the transaction to be re-executed are released.
+func (m *Memory) Record(views Views) {
+	var index int
+	for key, view := range views {
+		index = view.version.Index
+		m.stores[key].record(view.version, view.writes)
+	}
+
+	m.pendingMtx.Lock()
+	defer m.pendingMtx.Unlock()
+
+	if ch, ok := m.pending[index]; ok {
+		close(ch)
+		delete(m.pending, index)
+	}
+}
+
+// MarkEstimate flags the values written by the transaction at index as
+// estimates of the values its next incarnation will write. Transactions
+// reading them wait for the next incarnation to be recorded.
+func (m *Memory) MarkEstimate(index int) {
+	m.pendingMtx.Lock()
+	if _, ok := m.pending[index]; !ok {
+		m.pending[index] = make(chan struct{})
+	}
+	m.pendingMtx.Unlock()
+
+	for _, store := range m.stores {
+		store.markEstimate(index)
+	}
+}
+
+// Validate reports whether the reads performed through views would be the same
+// if they were performed again, i.e. if no transaction preceding the one of
+// the views wrote, or stopped writing, any key read since then.
+func (m *Memory) Validate(views Views) bool {
+	for _, view := range views {
+		if !view.validate() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wait blocks until the transaction at index has been re-executed.
+func (m *Memory) wait(index int) {
+	m.pendingMtx.Lock()
+	ch, ok := m.pending[index]
+	m.pendingMtx.Unlock()
+
+	if ok {
+		<-ch
+	}
+}
+
+// parentGet reads key from the parent store.
+func (m *Memory) parentGet(store *mvStore, key []byte) []byte {
+	m.parentMtx.Lock()
+	defer m.parentMtx.Unlock()
+
+	return store.parent.Get(key)
+}
+
+// latest returns the entry of key written by the closest transaction
+// preceding index, or nil if there is none. The caller must hold the store
+// lock.
+func (s *mvStore) latest(key string, index int) *entry {
+	entries := s.entries[key]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Index >= index })
+	if i == 0 {
+		return nil
+	}
+
+	return entries[i-1]
+}
+
+// read returns the version and value of key as seen by the transaction at
+// index. The returned value is only meaningful for versions other than
+// parentVersion. ok is false if the value is an estimate, in which case the
+// returned version identifies the transaction to wait for.
+func (s *mvStore) read(key string, index int) (version Version, value []byte, ok bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	e := s.latest(key, index)
+	if e == nil {
+		return parentVersion, nil, true
+	}
+
+	return e.Version, e.value, !e.estimate
+}
+
+// record replaces the values written by the transaction of the given version.
+func (s *mvStore) record(version Version, writes map[string][]byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, key := range s.written[version.Index] {
+		if _, ok := writes[key]; !ok {
+			s.remove(key, version.Index)
+		}
+	}
+
+	keys := make([]string, 0, len(writes))
+	for key, value := range writes {
+		keys = append(keys, key)
+		s.insert(key, &entry{Version: version, value: value})
+	}
+
+	if len(keys) == 0 {
+		delete(s.written, version.Index)
+	} else {
+		s.written[version.Index] = keys
+	}
+}
+
+// markEstimate flags every value written by the transaction at index as an
+// estimate.
+func (s *mvStore) markEstimate(index int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, key := range s.written[index] {
+		for _, e := range s.entries[key] {
+			if e.Index == index {
+				e.estimate = true
+			}
+		}
+	}
+}
+
+// insert sets the entry written to key by the transaction of e, replacing any
+// existing one. The caller must hold the store lock.
+func (s *mvStore) insert(key string, e *entry) {
+	entries, ok := s.entries[key]
+	if !ok {
+		i := sort.SearchStrings(s.keys, key)
+		s.keys = append(s.keys, "")
+		copy(s.keys[i+1:], s.keys[i:])
+		s.keys[i] = key
+	}
+
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Index >= e.Index })
+	if i < len(entries) && entries[i].Index == e.Index {
+		entries[i] = e
+		return
+	}
+
+	entries = append(entries, nil)
+	copy(entries[i+1:], entries[i:])
+	entries[i] = e
+	s.entries[key] = entries
+}
+
+// remove removes the entry written to key by the transaction at index. The
+// caller must hold the store lock.
+func (s *mvStore) remove(key string, index int) {
+	entries := s.entries[key]
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Index >= index })
+	if i == len(entries) || entries[i].Index != index {
+		return
+	}
+
+	entries = append(entries[:i], entries[i+1:]...)
+	if len(entries) > 0 {
+		s.entries[key] = entries
+		return
+	}
+
+	delete(s.entries, key)
+	if i := sort.SearchStrings(s.keys, key); i < len(s.keys) && s.keys[i] == key {
+		s.keys = append(s.keys[:i], s.keys[i+1:]...)
+	}
+}
+
+// snapshot returns the keys of the domain [start, end) written by the
+// transactions preceding index, in iteration order, along with the entries
+// seen by the transaction at index. ok is false if one of the entries is an
+// estimate, in which case the returned version identifies the transaction to
+// wait for.
+func (s *mvStore) snapshot(start, end []byte, ascending bool, index int) (keys []string, entries []*entry, blocking Version, ok bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	lo := 0
+	if start != nil {
+		lo = sort.SearchStrings(s.keys, string(start))
+	}
+
+	hi := len(s.keys)
+	if end != nil {
+		hi = sort.SearchStrings(s.keys, string(end))
+	}
+
+	for i := lo; i < hi; i++ {
+		key := s.keys[i]
+		if !ascending {
+			key = s.keys[hi-1-(i-lo)]
+		}
+
+		e := s.latest(key, index)
+		if e == nil {
+			continue
+		}
+
+		if e.estimate {
+			return nil, nil, e.Version, false
+		}
+
+		keys = append(keys, key)
+		entries = append(entries, e)
+	}
+
+	return keys, entries, Version{}, true
+}
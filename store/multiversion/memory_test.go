@@ -0,0 +1,236 @@
+package multiversion_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var storeKey = types.NewKVStoreKey("test")
+
+func keyFmt(i int) []byte { return []byte(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return []byte(fmt.Sprintf("value%0.8d", i)) }
+
+func newMemory(t *testing.T, parentKeys ...int) (*multiversion.Memory, types.KVStore) {
+	t.Helper()
+
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	for _, i := range parentKeys {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+
+	return multiversion.NewMemory(map[types.StoreKey]types.KVStore{storeKey: parent}), parent
+}
+
+func iterate(iter types.Iterator) (keys []string) {
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+
+	return keys
+}
+
+func TestViewReads(t *testing.T) {
+	memory, parent := newMemory(t, 1, 2)
+
+	tx0 := memory.NewViews(0, 0)
+	tx0[storeKey].Set(keyFmt(1), valFmt(10))
+	tx0[storeKey].Delete(keyFmt(2))
+	memory.Record(tx0)
+
+	tx2 := memory.NewViews(2, 0)
+	tx2[storeKey].Set(keyFmt(1), valFmt(30))
+	memory.Record(tx2)
+
+	// tx 1 sees the writes of tx 0 but not the ones of tx 2
+	tx1 := memory.NewViews(1, 0)[storeKey]
+	require.Equal(t, valFmt(10), tx1.Get(keyFmt(1)))
+	require.False(t, tx1.Has(keyFmt(2)))
+	require.Nil(t, tx1.Get(keyFmt(3)))
+
+	// tx 3 sees the writes of tx 2
+	tx3 := memory.NewViews(3, 0)[storeKey]
+	require.Equal(t, valFmt(30), tx3.Get(keyFmt(1)))
+
+	// the parent store is left untouched
+	require.Equal(t, valFmt(1), parent.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), parent.Get(keyFmt(2)))
+}
+
+func TestViewWrites(t *testing.T) {
+	memory, _ := newMemory(t, 1)
+
+	views := memory.NewViews(0, 0)
+	view := views[storeKey]
+	view.Set(keyFmt(3), valFmt(3))
+	view.Set(keyFmt(2), valFmt(2))
+	view.Delete(keyFmt(1))
+
+	require.Equal(t, []types.KVPair{
+		{Key: keyFmt(1)},
+		{Key: keyFmt(2), Value: valFmt(2)},
+		{Key: keyFmt(3), Value: valFmt(3)},
+	}, view.Writes())
+
+	// a View sees its own writes
+	require.Equal(t, []string{string(keyFmt(2)), string(keyFmt(3))}, iterate(view.Iterator(nil, nil)))
+
+	// a new incarnation replaces all the writes of the previous one
+	memory.Record(views)
+	views = memory.NewViews(0, 1)
+	views[storeKey].Set(keyFmt(4), valFmt(4))
+	memory.Record(views)
+
+	next := memory.NewViews(1, 0)[storeKey]
+	require.Equal(t, valFmt(1), next.Get(keyFmt(1)))
+	require.Nil(t, next.Get(keyFmt(2)))
+	require.Equal(t, valFmt(4), next.Get(keyFmt(4)))
+}
+
+func TestValidatePointReads(t *testing.T) {
+	memory, _ := newMemory(t, 1)
+
+	tx1 := memory.NewViews(1, 0)
+	tx1[storeKey].Get(keyFmt(1))
+	tx1[storeKey].Get(keyFmt(2))
+	require.True(t, memory.Validate(tx1))
+
+	// writes of following transactions do not invalidate reads
+	tx2 := memory.NewViews(2, 0)
+	tx2[storeKey].Set(keyFmt(1), valFmt(20))
+	memory.Record(tx2)
+	require.True(t, memory.Validate(tx1))
+
+	// writes of preceding transactions do, even of missing keys
+	tx0 := memory.NewViews(0, 0)
+	tx0[storeKey].Set(keyFmt(2), valFmt(2))
+	memory.Record(tx0)
+	require.False(t, memory.Validate(tx1))
+
+	// as well as the removal of a previously read write
+	tx1 = memory.NewViews(1, 1)
+	require.Equal(t, valFmt(2), tx1[storeKey].Get(keyFmt(2)))
+	require.True(t, memory.Validate(tx1))
+
+	memory.Record(memory.NewViews(0, 1))
+	require.False(t, memory.Validate(tx1))
+}
+
+func TestIterator(t *testing.T) {
+	// more parent keys than read at once by an iterator
+	var parentKeys []int
+	for i := 0; i < 200; i += 2 {
+		parentKeys = append(parentKeys, i)
+	}
+	memory, _ := newMemory(t, parentKeys...)
+
+	tx0 := memory.NewViews(0, 0)
+	tx0[storeKey].Set(keyFmt(3), valFmt(3))
+	tx0[storeKey].Set(keyFmt(4), valFmt(40))
+	tx0[storeKey].Delete(keyFmt(6))
+	tx0[storeKey].Set(keyFmt(199), valFmt(199))
+	memory.Record(tx0)
+
+	var expected []string
+	for i := 0; i < 200; i++ {
+		if (i%2 == 0 && i != 6) || i == 3 || i == 199 {
+			expected = append(expected, string(keyFmt(i)))
+		}
+	}
+
+	view := memory.NewViews(1, 0)[storeKey]
+	require.Equal(t, expected, iterate(view.Iterator(nil, nil)))
+
+	reversed := make([]string, len(expected))
+	for i, key := range expected {
+		reversed[len(expected)-1-i] = key
+	}
+	require.Equal(t, reversed, iterate(view.ReverseIterator(nil, nil)))
+
+	require.Equal(t, []string{string(keyFmt(3)), string(keyFmt(4)), string(keyFmt(8))},
+		iterate(view.Iterator(keyFmt(3), keyFmt(10))))
+	require.Equal(t, []string{string(keyFmt(8)), string(keyFmt(4)), string(keyFmt(3))},
+		iterate(view.ReverseIterator(keyFmt(3), keyFmt(10))))
+
+	iter := view.Iterator(keyFmt(3), keyFmt(10))
+	require.Equal(t, valFmt(3), iter.Value())
+	iter.Next()
+	require.Equal(t, valFmt(40), iter.Value())
+	require.NoError(t, iter.Close())
+}
+
+func TestValidateIteration(t *testing.T) {
+	memory, _ := newMemory(t, 0, 2, 4, 6, 8)
+
+	// tx 2 stops iterating after the first two keys
+	tx2 := memory.NewViews(2, 0)
+	iter := tx2[storeKey].Iterator(nil, nil)
+	iter.Next()
+	require.Equal(t, keyFmt(2), iter.Key())
+	require.NoError(t, iter.Close())
+
+	// writes beyond the iterated keys do not invalidate it
+	tx0 := memory.NewViews(0, 0)
+	tx0[storeKey].Set(keyFmt(5), valFmt(5))
+	memory.Record(tx0)
+	require.True(t, memory.Validate(tx2))
+
+	// writes within do
+	tx1 := memory.NewViews(1, 0)
+	tx1[storeKey].Set(keyFmt(1), valFmt(1))
+	memory.Record(tx1)
+	require.False(t, memory.Validate(tx2))
+
+	// exhausted iterators depend on the whole domain
+	tx3 := memory.NewViews(3, 0)
+	require.Len(t, iterate(tx3[storeKey].ReverseIterator(keyFmt(4), nil)), 4)
+	require.True(t, memory.Validate(tx3))
+
+	tx1 = memory.NewViews(1, 1)
+	tx1[storeKey].Set(keyFmt(1), valFmt(1))
+	tx1[storeKey].Delete(keyFmt(4))
+	memory.Record(tx1)
+	require.False(t, memory.Validate(tx3))
+}
+
+func TestEstimate(t *testing.T) {
+	memory, _ := newMemory(t)
+
+	tx0 := memory.NewViews(0, 0)
+	tx0[storeKey].Set(keyFmt(1), valFmt(1))
+	memory.Record(tx0)
+
+	tx1 := memory.NewViews(1, 0)
+	require.Equal(t, valFmt(1), tx1[storeKey].Get(keyFmt(1)))
+
+	// tx 0 is being re-executed
+	memory.MarkEstimate(0)
+	require.False(t, memory.Validate(tx1))
+
+	read := make(chan []byte)
+	go func() {
+		read <- memory.NewViews(1, 1)[storeKey].Get(keyFmt(1))
+	}()
+
+	select {
+	case <-read:
+		t.Fatal("estimate read without waiting")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	tx0 = memory.NewViews(0, 1)
+	tx0[storeKey].Set(keyFmt(1), valFmt(2))
+	memory.Record(tx0)
+
+	require.Equal(t, valFmt(2), <-read)
+}
@@ -0,0 +1,271 @@
+package multiversion
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Views holds the Views of a transaction execution, by store.
+type Views map[types.StoreKey]*View
+
+// View is the KVStore a single transaction execution reads from and writes to.
+// Reads are served from the values written by the preceding transactions of
+// the block and are recorded for validation, writes are buffered until the
+// execution is recorded in the Memory.
+//
+// A View is not safe for concurrent use: it is meant to be branched by a
+// cachekv.Store, as done by cachemulti.Store, so that it only sees reads of
+// keys missing from the cache and the writes of the final Write.
+type View struct {
+	memory  *Memory
+	store   *mvStore
+	version Version
+
+	// reads holds the version of the first read of each key.
+	reads map[string]Version
+	// ranges holds the iterated domains.
+	ranges []*rangeRead
+	// writes holds the values written, nil for deleted keys.
+	writes map[string][]byte
+}
+
+var _ types.KVStore = (*View)(nil)
+
+func newView(memory *Memory, store *mvStore, version Version) *View {
+	return &View{
+		memory:  memory,
+		store:   store,
+		version: version,
+		reads:   make(map[string]Version),
+		writes:  make(map[string][]byte),
+	}
+}
+
+// Writes returns the pairs written through the View, sorted by key. Deleted
+// keys have a nil value.
+func (v *View) Writes() []types.KVPair {
+	keys := make([]string, 0, len(v.writes))
+	for key := range v.writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]types.KVPair, len(keys))
+	for i, key := range keys {
+		pairs[i] = types.KVPair{Key: []byte(key), Value: v.writes[key]}
+	}
+
+	return pairs
+}
+
+// GetStoreType implements types.Store.
+func (v *View) GetStoreType() types.StoreType {
+	return v.store.parent.GetStoreType()
+}
+
+// Get implements types.KVStore.
+func (v *View) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	if value, ok := v.writes[string(key)]; ok {
+		return value
+	}
+
+	for {
+		version, value, ok := v.store.read(string(key), v.version.Index)
+		if !ok {
+			v.memory.wait(version.Index)
+			continue
+		}
+
+		if version == parentVersion {
+			value = v.memory.parentGet(v.store, key)
+		}
+
+		if _, ok := v.reads[string(key)]; !ok {
+			v.reads[string(key)] = version
+		}
+
+		return value
+	}
+}
+
+// Has implements types.KVStore.
+func (v *View) Has(key []byte) bool {
+	return v.Get(key) != nil
+}
+
+// Set implements types.KVStore.
+func (v *View) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	v.writes[string(key)] = append([]byte(nil), value...)
+}
+
+// Delete implements types.KVStore.
+func (v *View) Delete(key []byte) {
+	types.AssertValidKey(key)
+
+	v.writes[string(key)] = nil
+}
+
+// Iterator implements types.KVStore.
+func (v *View) Iterator(start, end []byte) types.Iterator {
+	return v.iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (v *View) ReverseIterator(start, end []byte) types.Iterator {
+	return v.iterator(start, end, false)
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (v *View) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (v *View) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(v, w, tc))
+}
+
+// CacheWrapWithListeners implements types.CacheWrapper.
+func (v *View) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(v, storeKey, listeners))
+}
+
+// validate reports whether the reads performed through the View are still
+// valid.
+func (v *View) validate() bool {
+	v.store.mtx.RLock()
+	defer v.store.mtx.RUnlock()
+
+	for key, version := range v.reads {
+		if current(v.store.latest(key, v.version.Index)) != version {
+			return false
+		}
+	}
+
+	for _, r := range v.ranges {
+		if !r.validate(v.store, v.version.Index) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// current returns the version of e, parentVersion if e is nil, or an invalid
+// version if e is an estimate.
+func current(e *entry) Version {
+	switch {
+	case e == nil:
+		return parentVersion
+	case e.estimate:
+		return Version{Index: -2}
+	default:
+		return e.Version
+	}
+}
+
+// rangeRead records an iteration over a domain of the store.
+type rangeRead struct {
+	start, end []byte
+	ascending  bool
+
+	// keys and versions hold the written keys of the domain along with the
+	// versions seen when the iterator was created.
+	keys     []string
+	versions []Version
+
+	// bound is the furthest key the iterator was positioned on, in iteration
+	// order, and exhausted is set once the iterator went past the domain. The
+	// iteration only depends on the keys up to bound, or on the whole domain
+	// once exhausted.
+	bound     []byte
+	exhausted bool
+}
+
+// covers reports whether the iteration depends on key.
+func (r *rangeRead) covers(key string) bool {
+	switch {
+	case r.exhausted:
+		return true
+	case r.bound == nil:
+		return false
+	case r.ascending:
+		return key <= string(r.bound)
+	default:
+		return key >= string(r.bound)
+	}
+}
+
+// validate reports whether the keys the iteration depends on are still
+// written at the same versions.
+func (r *rangeRead) validate(store *mvStore, index int) bool {
+	var keys []string
+	var versions []Version
+
+	lo := 0
+	if r.start != nil {
+		lo = sortSearchStrings(store.keys, r.start)
+	}
+
+	hi := len(store.keys)
+	if r.end != nil {
+		hi = sortSearchStrings(store.keys, r.end)
+	}
+
+	for i := lo; i < hi; i++ {
+		key := store.keys[i]
+		if !r.ascending {
+			key = store.keys[hi-1-(i-lo)]
+		}
+
+		if !r.covers(key) {
+			continue
+		}
+
+		e := store.latest(key, index)
+		if e == nil {
+			continue
+		}
+
+		keys = append(keys, key)
+		versions = append(versions, current(e))
+	}
+
+	var j int
+	for i, key := range r.keys {
+		if !r.covers(key) {
+			continue
+		}
+
+		if j >= len(keys) || keys[j] != key || versions[j] != r.versions[i] {
+			return false
+		}
+		j++
+	}
+
+	return j == len(keys)
+}
+
+// advance records that the iterator was positioned on key.
+func (r *rangeRead) advance(key []byte) {
+	if r.bound == nil ||
+		(r.ascending && bytes.Compare(key, r.bound) > 0) ||
+		(!r.ascending && bytes.Compare(key, r.bound) < 0) {
+		r.bound = key
+	}
+}
+
+func sortSearchStrings(keys []string, key []byte) int {
+	return sort.SearchStrings(keys, string(key))
+}
@@ -966,6 +966,32 @@ func (rs *Store) RollbackToVersion(target int64) error {
 	return rs.LoadLatestVersion()
 }
 
+// RewindToVersion updates the latest version to `target` without deleting the
+// versions after it from the IAVL stores. The versions committed again must be
+// the same as the kept ones, committing a different state fails.
+func (rs *Store) RewindToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rewind height target: %d", target)
+	}
+	if _, err := getCommitInfo(rs.db, target); err != nil {
+		return errors.Wrapf(err, "failed to rewind to version %d", target)
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	flushLatestVersion(batch, target)
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	return rs.LoadLatestVersion()
+}
+
+// GetCommitInfo returns the commit info of the provided version from disk.
+func (rs *Store) GetCommitInfo(version int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, version)
+}
+
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
 	rs.logger.Debug("flushing metadata", "height", version)
 	batch := db.NewBatch()
@@ -625,6 +625,41 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestRewindToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, multi.LoadLatestVersion())
+
+	commit := func(value string) types.CommitID {
+		multi.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(value))
+		return multi.Commit()
+	}
+	var commitIDs []types.CommitID
+	for i := 1; i <= 4; i++ {
+		commitIDs = append(commitIDs, commit(fmt.Sprintf("value%d", i)))
+	}
+
+	require.Error(t, multi.RewindToVersion(0))
+	require.Error(t, multi.RewindToVersion(5))
+
+	require.NoError(t, multi.RewindToVersion(2))
+	require.Equal(t, commitIDs[1], multi.LastCommitID())
+	require.Equal(t, []byte("value2"), multi.GetStoreByName("store1").(types.KVStore).Get([]byte("key")))
+
+	// the versions after the target are kept, and must be committed again to
+	// the same state
+	iavlStore := multi.GetCommitKVStore(multi.keysByName["store1"]).(*iavl.Store)
+	require.True(t, iavlStore.VersionExists(4))
+	require.Equal(t, commitIDs[2], commit("value3"))
+	require.Panics(t, func() { commit("other") })
+
+	ci, err := multi.GetCommitInfo(3)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[2].Hash, ci.Hash())
+	_, err = multi.GetCommitInfo(5)
+	require.Error(t, err)
+}
+
 func TestAddListenersAndListeningEnabled(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
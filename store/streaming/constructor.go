@@ -2,6 +2,7 @@ package streaming
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -17,50 +19,62 @@ import (
 // ServiceConstructor is used to construct a streaming service
 type ServiceConstructor func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error)
 
-// ServiceType enum for specifying the type of StreamingService
-type ServiceType int
-
-const (
-	Unknown ServiceType = iota
-	File
-	// add more in the future
+var (
+	constructorsMtx sync.RWMutex
+	constructors    = map[string]ServiceConstructor{}
 )
 
-// ServiceTypeFromString returns the streaming.ServiceType corresponding to the provided name
-func ServiceTypeFromString(name string) ServiceType {
-	switch strings.ToLower(name) {
-	case "file", "f":
-		return File
-	default:
-		return Unknown
+func init() {
+	if err := RegisterServiceConstructor("file", NewFileStreamingService); err != nil {
+		panic(err)
+	}
+	if err := RegisterServiceConstructor("grpc", NewGRPCStreamingService); err != nil {
+		panic(err)
 	}
 }
 
-// String returns the string name of a streaming.ServiceType
-func (sst ServiceType) String() string {
-	switch sst {
-	case File:
-		return "file"
-	default:
-		return "unknown"
+// RegisterServiceConstructor registers a streaming.ServiceConstructor under the provided name, which
+// is the name used to enable the streaming service in the `store.streamers` list of app.toml and the
+// name of the `streamers.<name>` section holding its options. It returns an error if the name is
+// empty or already registered.
+func RegisterServiceConstructor(name string, constructor ServiceConstructor) error {
+	name = strings.ToLower(name)
+	if name == "" {
+		return fmt.Errorf("streaming service name cannot be empty")
+	}
+	if constructor == nil {
+		return fmt.Errorf("streaming service constructor of %s cannot be nil", name)
+	}
+
+	constructorsMtx.Lock()
+	defer constructorsMtx.Unlock()
+	if _, ok := constructors[name]; ok {
+		return fmt.Errorf("streaming service %s is already registered", name)
 	}
+	constructors[name] = constructor
+	return nil
 }
 
-// ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
-var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+// RegisteredServiceNames returns the sorted names of the registered streaming services
+func RegisteredServiceNames() []string {
+	constructorsMtx.RLock()
+	defer constructorsMtx.RUnlock()
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
-// NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
+// NewServiceConstructor returns the streaming.ServiceConstructor registered under the provided name
 func NewServiceConstructor(name string) (ServiceConstructor, error) {
-	ssType := ServiceTypeFromString(name)
-	if ssType == Unknown {
-		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
-	}
-	if constructor, ok := ServiceConstructorLookupTable[ssType]; ok && constructor != nil {
+	constructorsMtx.RLock()
+	defer constructorsMtx.RUnlock()
+	if constructor, ok := constructors[strings.ToLower(name)]; ok {
 		return constructor, nil
 	}
-	return nil, fmt.Errorf("streaming service constructor of type %s not found", ssType.String())
+	return nil, fmt.Errorf("unrecognized streaming service name %s", name)
 }
 
 // NewFileStreamingService is the streaming.ServiceConstructor function for creating a FileStreamingService
@@ -70,11 +84,25 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	socket := cast.ToString(opts.Get("streamers.grpc.socket"))
+	bufferSize := cast.ToInt(opts.Get("streamers.grpc.buffer_size"))
+	pushTimeout := cast.ToDuration(opts.Get("streamers.grpc.push_timeout"))
+	backPressure, err := grpc.BackPressureFromString(cast.ToString(opts.Get("streamers.grpc.back_pressure")))
+	if err != nil {
+		return nil, err
+	}
+	return grpc.NewStreamingService(socket, keys, backPressure, bufferSize, pushTimeout)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
 	// waitgroup and quit channel for optional shutdown coordination of the streaming service(s)
 	wg := new(sync.WaitGroup)
+	// halt the node when a streaming service fails, instead of letting the state and the consumers diverge
+	bApp.SetStopNodeOnStreamingErr(cast.ToBool(appOpts.Get("streamers.stop_node_on_err")))
 	// configure state listening capabilities using AppOptions
 	streamers := cast.ToStringSlice(appOpts.Get("store.streamers"))
 	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
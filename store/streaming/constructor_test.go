@@ -1,6 +1,8 @@
 package streaming_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -9,6 +11,7 @@ import (
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +53,54 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestRegisterServiceConstructor(t *testing.T) {
+	names := streaming.RegisteredServiceNames()
+	require.Contains(t, names, "file")
+	require.Contains(t, names, "grpc")
+
+	require.Error(t, streaming.RegisterServiceConstructor("", streaming.NewFileStreamingService))
+	require.Error(t, streaming.RegisterServiceConstructor("custom", nil))
+	require.Error(t, streaming.RegisterServiceConstructor("File", streaming.NewFileStreamingService))
+
+	var called bool
+	custom := func(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+		called = true
+		return streaming.NewFileStreamingService(opts, keys, marshaller)
+	}
+	// names are case insensitive and registrations are global, so the name is unique across test runs
+	name := fmt.Sprintf("custom%d", len(names))
+	require.NoError(t, streaming.RegisterServiceConstructor(name, custom))
+	require.Contains(t, streaming.RegisteredServiceNames(), name)
+
+	constructor, err := streaming.NewServiceConstructor(strings.ToUpper(name))
+	require.NoError(t, err)
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.NoError(t, err)
+
+	// the socket of the sink is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	_, err = constructor(grpcAppOptions{socket: "sink.sock", backPressure: "retry"}, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	serv, err := constructor(grpcAppOptions{socket: "sink.sock", backPressure: "halt"}, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+	require.NoError(t, serv.Close())
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := testutil.MakeTestEncodingConfig()
@@ -99,3 +150,23 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return nil
 	}
 }
+
+type grpcAppOptions struct {
+	socket       string
+	backPressure string
+}
+
+func (ao grpcAppOptions) Get(o string) interface{} {
+	switch o {
+	case "streamers.grpc.socket":
+		return ao.socket
+	case "streamers.grpc.back_pressure":
+		return ao.backPressure
+	case "streamers.grpc.buffer_size":
+		return 10
+	case "streamers.grpc.push_timeout":
+		return "1s"
+	default:
+		return nil
+	}
+}
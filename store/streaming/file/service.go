@@ -246,6 +246,53 @@ func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
 	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
 }
 
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the received Commit response and the resulting state changes out to a file as described
+// in the above the naming schema. The state changes of a block are written to the stores when it is
+// committed, so they are all found in this file.
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) (rerr error) {
+	// generate the new file
+	dstFile, err := fss.openCommitFile()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := dstFile.Close()
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+
+	// write res to file
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	if err != nil {
+		return err
+	}
+	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
+		return err
+	}
+	// write all state changes cached for this stage to file
+	fss.stateCacheLock.Lock()
+	defer fss.stateCacheLock.Unlock()
+	for _, stateChange := range fss.stateCache {
+		if _, err = dstFile.Write(stateChange); err != nil {
+			fss.stateCache = nil
+			return err
+		}
+	}
+	// reset cache
+	fss.stateCache = nil
+	return nil
+}
+
+func (fss *StreamingService) openCommitFile() (*os.File, error) {
+	fileName := fmt.Sprintf("block-%d-commit", fss.currentBlockNumber)
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+}
+
 // Stream satisfies the baseapp.StreamingService interface
 // It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
 // and caches them in the order they were received
@@ -0,0 +1,354 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBufferSize is the number of messages buffered when no buffer size is configured
+	DefaultBufferSize = 1000
+	// DefaultPushTimeout is the timeout of a single push when no timeout is configured
+	DefaultPushTimeout = 5 * time.Second
+
+	retryInterval = time.Second
+)
+
+// BackPressure defines what the StreamingService does when the sink does not keep up with the node,
+// either because a push fails or because the buffer of messages waiting to be pushed is full
+type BackPressure string
+
+const (
+	// BackPressureBlock retries failed pushes until they succeed and blocks the node while the buffer is full,
+	// so that no message is ever lost
+	BackPressureBlock BackPressure = "block"
+	// BackPressureDrop drops the messages which cannot be buffered or pushed and reports them through the
+	// errors returned to the BaseApp, which logs them
+	BackPressureDrop BackPressure = "drop"
+	// BackPressureHalt halts the node by panicking as soon as a message cannot be buffered or pushed
+	BackPressureHalt BackPressure = "halt"
+)
+
+// BackPressureFromString returns the BackPressure corresponding to the provided name, BackPressureBlock
+// being the default when the name is empty
+func BackPressureFromString(name string) (BackPressure, error) {
+	switch bp := BackPressure(strings.ToLower(name)); bp {
+	case "":
+		return BackPressureBlock, nil
+	case BackPressureBlock, BackPressureDrop, BackPressureHalt:
+		return bp, nil
+	default:
+		return "", fmt.Errorf("unrecognized back pressure %s, expected one of %s, %s or %s",
+			name, BackPressureBlock, BackPressureDrop, BackPressureHalt)
+	}
+}
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that pushes the ABCI requests and
+// responses along with the state changes to an external process, through the StreamingSink gRPC service
+// it serves over a Unix socket
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	conn               *gogrpc.ClientConn                       // connection to the sink
+	client             types.StreamingSinkClient                // client of the sink
+	backPressure       BackPressure                             // what to do when the sink does not keep up
+	pushTimeout        time.Duration                            // timeout of a single push
+	retryInterval      time.Duration                            // interval between two attempts of a push with BackPressureBlock
+	queue              chan *types.StreamMessage                // buffer of the messages waiting to be pushed
+	currentBlockNumber int64                                    // the current block number
+	errLock            *sync.Mutex                              // mutex for the delivery errors below
+	dropped            int                                      // number of messages dropped since the last report, with BackPressureDrop
+	dropErr            error                                    // last error which caused a message to be dropped, with BackPressureDrop
+	haltErr            error                                    // error which requires the node to halt, with BackPressureHalt
+	closeErr           error                                    // error encountered while pushing the buffered messages on Close
+	started            bool                                     // whether Stream has been called
+	quitChan           chan struct{}                            // channel to synchronize closure
+	doneChan           chan struct{}                            // closed once the push loop has returned
+	closeOnce          *sync.Once                               // guards the closure of quitChan
+}
+
+// NewStreamingService creates a new StreamingService pushing the state changes of the provided storeKeys
+// to the sink listening on the provided Unix socket. A zero bufferSize or pushTimeout means the default.
+func NewStreamingService(socket string, storeKeys []types.StoreKey, backPressure BackPressure, bufferSize int, pushTimeout time.Duration) (*StreamingService, error) {
+	if socket == "" {
+		return nil, errors.New("the socket of the streaming sink cannot be empty")
+	}
+	if _, err := BackPressureFromString(string(backPressure)); err != nil {
+		return nil, err
+	}
+	if bufferSize < 0 {
+		return nil, fmt.Errorf("the buffer size cannot be negative, got %d", bufferSize)
+	}
+	if bufferSize == 0 {
+		bufferSize = DefaultBufferSize
+	}
+	if pushTimeout < 0 {
+		return nil, fmt.Errorf("the push timeout cannot be negative, got %s", pushTimeout)
+	}
+	if pushTimeout == 0 {
+		pushTimeout = DefaultPushTimeout
+	}
+
+	// the connection is established lazily, the sink does not need to be up when the node starts
+	conn, err := gogrpc.Dial(
+		socket,
+		gogrpc.WithTransportCredentials(insecure.NewCredentials()),
+		gogrpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gss := &StreamingService{
+		conn:          conn,
+		client:        types.NewStreamingSinkClient(conn),
+		backPressure:  backPressure,
+		pushTimeout:   pushTimeout,
+		retryInterval: retryInterval,
+		queue:         make(chan *types.StreamMessage, bufferSize),
+		errLock:       new(sync.Mutex),
+		quitChan:      make(chan struct{}),
+		doneChan:      make(chan struct{}),
+		closeOnce:     new(sync.Once),
+	}
+	// the state changes are received through ListenCommit, so no WriteListener is needed on the exposed stores
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = nil
+	}
+	gss.listeners = listeners
+	return gss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received BeginBlock request and response to the sink
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlockNumber = req.GetHeader().Height
+	return gss.enqueue(&types.StreamMessage{
+		BlockHeight:        gss.currentBlockNumber,
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It pushes the received DeliverTx request and response to the sink
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return gss.enqueue(&types.StreamMessage{
+		BlockHeight:       gss.currentBlockNumber,
+		RequestDeliverTx:  &req,
+		ResponseDeliverTx: &res,
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received EndBlock request and response to the sink
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return gss.enqueue(&types.StreamMessage{
+		BlockHeight:      gss.currentBlockNumber,
+		RequestEndBlock:  &req,
+		ResponseEndBlock: &res,
+	})
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It pushes the received Commit response and the state changes of the block to the sink
+func (gss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	return gss.enqueue(&types.StreamMessage{
+		BlockHeight:    gss.currentBlockNumber,
+		ResponseCommit: &res,
+		StateChanges:   changeSet,
+	})
+}
+
+// enqueue buffers the message to be pushed by the push loop, applying the back pressure when the buffer is full.
+// With BackPressureDrop, it reports the messages dropped since the last call by returning an error.
+func (gss *StreamingService) enqueue(msg *types.StreamMessage) error {
+	if err := gss.haltError(); err != nil {
+		panic(fmt.Errorf("halting node, failed to push streamed data of block %d: %w", msg.BlockHeight, err))
+	}
+
+	select {
+	case <-gss.quitChan:
+		return closedError(msg)
+	default:
+	}
+
+	if gss.backPressure == BackPressureBlock {
+		select {
+		case gss.queue <- msg:
+			return nil
+		case <-gss.quitChan:
+			return closedError(msg)
+		}
+	}
+
+	select {
+	case gss.queue <- msg:
+	default:
+		err := fmt.Errorf("the buffer of %d messages is full", cap(gss.queue))
+		if gss.backPressure == BackPressureHalt {
+			panic(fmt.Errorf("halting node, failed to push streamed data of block %d: %w", msg.BlockHeight, err))
+		}
+		gss.recordDrop(err)
+	}
+	return gss.takeDropErr()
+}
+
+func closedError(msg *types.StreamMessage) error {
+	return fmt.Errorf("streaming service closed, cannot push streamed data of block %d", msg.BlockHeight)
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up the goroutine which pushes the buffered messages to the sink, one at a time and in order
+// returns an error if it is called twice
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if gss.started {
+		return errors.New("`Stream` has already been called")
+	}
+	gss.started = true
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(gss.doneChan)
+		for {
+			select {
+			case <-gss.quitChan:
+				gss.flush()
+				return
+			case msg := <-gss.queue:
+				if !gss.deliver(msg) {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// deliver pushes the message to the sink, applying the back pressure if the push fails.
+// It returns false if the push loop must stop.
+func (gss *StreamingService) deliver(msg *types.StreamMessage) bool {
+	for {
+		err := gss.push(msg)
+		if err == nil {
+			return true
+		}
+
+		switch gss.backPressure {
+		case BackPressureDrop:
+			gss.recordDrop(err)
+			return true
+		case BackPressureHalt:
+			gss.setHaltErr(err)
+			return false
+		}
+
+		select {
+		case <-gss.quitChan:
+			// try one last time along with the rest of the buffered messages
+			gss.flush(msg)
+			return false
+		case <-time.After(gss.retryInterval):
+		}
+	}
+}
+
+// flush makes a single attempt to push the provided messages and then the buffered ones, stopping at the
+// first failure, which is reported by Close
+func (gss *StreamingService) flush(pending ...*types.StreamMessage) {
+	for {
+		var msg *types.StreamMessage
+		if len(pending) > 0 {
+			msg, pending = pending[0], pending[1:]
+		} else {
+			select {
+			case msg = <-gss.queue:
+			default:
+				return
+			}
+		}
+		if err := gss.push(msg); err != nil {
+			gss.errLock.Lock()
+			gss.closeErr = fmt.Errorf("failed to push %d buffered messages on close: %w", len(pending)+len(gss.queue)+1, err)
+			gss.errLock.Unlock()
+			return
+		}
+	}
+}
+
+func (gss *StreamingService) push(msg *types.StreamMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gss.pushTimeout)
+	defer cancel()
+	_, err := gss.client.Push(ctx, msg)
+	return err
+}
+
+func (gss *StreamingService) recordDrop(err error) {
+	gss.errLock.Lock()
+	defer gss.errLock.Unlock()
+	gss.dropped++
+	gss.dropErr = err
+}
+
+func (gss *StreamingService) takeDropErr() error {
+	gss.errLock.Lock()
+	defer gss.errLock.Unlock()
+	if gss.dropped == 0 {
+		return nil
+	}
+	err := fmt.Errorf("dropped %d streamed messages: %w", gss.dropped, gss.dropErr)
+	gss.dropped, gss.dropErr = 0, nil
+	return err
+}
+
+func (gss *StreamingService) setHaltErr(err error) {
+	gss.errLock.Lock()
+	defer gss.errLock.Unlock()
+	gss.haltErr = err
+}
+
+func (gss *StreamingService) haltError() error {
+	gss.errLock.Lock()
+	defer gss.errLock.Unlock()
+	return gss.haltErr
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It makes a last attempt to push the buffered messages before closing the connection to the sink
+func (gss *StreamingService) Close() error {
+	gss.closeOnce.Do(func() { close(gss.quitChan) })
+	if gss.started {
+		<-gss.doneChan
+	}
+	gss.errLock.Lock()
+	closeErr := gss.closeErr
+	gss.errLock.Unlock()
+	if err := gss.conn.Close(); err != nil && closeErr == nil {
+		closeErr = err
+	}
+	return closeErr
+}
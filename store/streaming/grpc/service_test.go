@@ -0,0 +1,242 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	emptyContext  = sdk.Context{}
+
+	testBeginBlockReq = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testBeginBlockRes = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "testEventType1"}}}
+	testDeliverTxReq  = abci.RequestDeliverTx{Tx: []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}}
+	testDeliverTxRes  = abci.ResponseDeliverTx{Code: 1, Codespace: "mockCodeSpace", Log: "mockLog"}
+	testEndBlockReq   = abci.RequestEndBlock{Height: 1}
+	testEndBlockRes   = abci.ResponseEndBlock{Events: []abci.Event{}}
+	testCommitRes     = abci.ResponseCommit{Data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}}
+	testChangeSet     = []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey2.Name(), Delete: true, Key: []byte("key2")},
+	}
+)
+
+// testSink is a StreamingSink recording the messages it receives
+type testSink struct {
+	types.UnimplementedStreamingSinkServer
+
+	mtx      sync.Mutex
+	fail     bool
+	received []*types.StreamMessage
+}
+
+func (s *testSink) Push(_ context.Context, msg *types.StreamMessage) (*types.PushResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.fail {
+		return nil, errors.New("sink unavailable")
+	}
+	s.received = append(s.received, msg)
+	return &types.PushResponse{}, nil
+}
+
+func (s *testSink) setFail(fail bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.fail = fail
+}
+
+func (s *testSink) messages() []*types.StreamMessage {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]*types.StreamMessage(nil), s.received...)
+}
+
+// startSink serves a testSink on a Unix socket in a temporary directory and returns the socket
+func startSink(t *testing.T) (*testSink, string) {
+	socket := filepath.Join(t.TempDir(), "sink.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	sink := &testSink{}
+	server := gogrpc.NewServer()
+	types.RegisterStreamingSinkServer(server, sink)
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	return sink, socket
+}
+
+func newTestStreamingService(t *testing.T, socket string, backPressure BackPressure, bufferSize int) *StreamingService {
+	gss, err := NewStreamingService(socket, []types.StoreKey{mockStoreKey1, mockStoreKey2}, backPressure, bufferSize, time.Second)
+	require.NoError(t, err)
+	gss.retryInterval = 10 * time.Millisecond
+	return gss
+}
+
+func TestBackPressureFromString(t *testing.T) {
+	for name, expected := range map[string]BackPressure{
+		"":      BackPressureBlock,
+		"block": BackPressureBlock,
+		"Drop":  BackPressureDrop,
+		"halt":  BackPressureHalt,
+	} {
+		bp, err := BackPressureFromString(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, bp)
+	}
+
+	_, err := BackPressureFromString("retry")
+	require.Error(t, err)
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := NewStreamingService("", nil, BackPressureBlock, 0, 0)
+	require.Error(t, err)
+	_, err = NewStreamingService("sink.sock", nil, "retry", 0, 0)
+	require.Error(t, err)
+	_, err = NewStreamingService("sink.sock", nil, BackPressureBlock, -1, 0)
+	require.Error(t, err)
+
+	gss, err := NewStreamingService("sink.sock", []types.StoreKey{mockStoreKey1, mockStoreKey2}, BackPressureBlock, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultBufferSize, cap(gss.queue))
+	require.Equal(t, DefaultPushTimeout, gss.pushTimeout)
+	for _, key := range []types.StoreKey{mockStoreKey1, mockStoreKey2} {
+		_, ok := gss.Listeners()[key]
+		require.True(t, ok)
+	}
+	require.NoError(t, gss.Close())
+}
+
+func TestStreamingService_Block(t *testing.T) {
+	sink, socket := startSink(t)
+	sink.setFail(true)
+
+	gss := newTestStreamingService(t, socket, BackPressureBlock, 0)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, gss.Stream(wg))
+	require.Error(t, gss.Stream(wg))
+
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, gss.ListenDeliverTx(emptyContext, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes))
+	require.NoError(t, gss.ListenCommit(emptyContext, testCommitRes, testChangeSet))
+
+	// the failed pushes are retried until the sink recovers
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, sink.messages())
+	sink.setFail(false)
+	require.Eventually(t, func() bool { return len(sink.messages()) == 4 }, 5*time.Second, 10*time.Millisecond)
+
+	received := sink.messages()
+	require.Equal(t, int64(1), received[0].BlockHeight)
+	require.Equal(t, testBeginBlockReq, *received[0].RequestBeginBlock)
+	require.Equal(t, testBeginBlockRes, *received[0].ResponseBeginBlock)
+	require.Empty(t, received[0].StateChanges)
+
+	require.Equal(t, int64(1), received[1].BlockHeight)
+	require.Equal(t, testDeliverTxReq.Tx, received[1].RequestDeliverTx.Tx)
+	require.Equal(t, testDeliverTxRes.Log, received[1].ResponseDeliverTx.Log)
+
+	require.Equal(t, int64(1), received[2].BlockHeight)
+	require.Equal(t, testEndBlockReq, *received[2].RequestEndBlock)
+
+	require.Equal(t, int64(1), received[3].BlockHeight)
+	require.Equal(t, testCommitRes.Data, received[3].ResponseCommit.Data)
+	require.Equal(t, testChangeSet, received[3].StateChanges)
+
+	require.NoError(t, gss.Close())
+	wg.Wait()
+}
+
+func TestStreamingService_BlockFlushOnClose(t *testing.T) {
+	sink, socket := startSink(t)
+	gss := newTestStreamingService(t, socket, BackPressureBlock, 0)
+
+	// the messages are buffered until the push loop starts
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes))
+
+	wg := new(sync.WaitGroup)
+	gss.closeOnce.Do(func() { close(gss.quitChan) })
+	require.NoError(t, gss.Stream(wg))
+	require.NoError(t, gss.Close())
+	wg.Wait()
+	require.Len(t, sink.messages(), 2)
+
+	// once closed, no message is accepted anymore
+	require.Error(t, gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes))
+}
+
+func TestStreamingService_Drop(t *testing.T) {
+	sink, socket := startSink(t)
+	sink.setFail(true)
+
+	gss := newTestStreamingService(t, socket, BackPressureDrop, 1)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, gss.Stream(wg))
+
+	// the failed push is dropped and reported by the next message
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.Eventually(t, func() bool {
+		gss.errLock.Lock()
+		defer gss.errLock.Unlock()
+		return gss.dropped == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	err := gss.ListenDeliverTx(emptyContext, testDeliverTxReq, testDeliverTxRes)
+	require.ErrorContains(t, err, "dropped 1 streamed messages")
+	require.ErrorContains(t, err, "sink unavailable")
+
+	// the node never waits for the sink
+	require.NoError(t, gss.Close())
+	wg.Wait()
+	require.Empty(t, sink.messages())
+
+	// a full buffer drops the message
+	gss = newTestStreamingService(t, socket, BackPressureDrop, 1)
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.ErrorContains(t, gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes), "buffer of 1 messages is full")
+	require.NoError(t, gss.Close())
+}
+
+func TestStreamingService_Halt(t *testing.T) {
+	sink, socket := startSink(t)
+	sink.setFail(true)
+
+	gss := newTestStreamingService(t, socket, BackPressureHalt, 1)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, gss.Stream(wg))
+
+	// the failed push halts the node on the next message
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.Eventually(t, func() bool { return gss.haltError() != nil }, 5*time.Second, 10*time.Millisecond)
+	require.Panics(t, func() {
+		gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes) //nolint:errcheck
+	})
+	wg.Wait()
+	require.NoError(t, gss.Close())
+
+	// a full buffer halts the node
+	gss = newTestStreamingService(t, socket, BackPressureHalt, 1)
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.Panics(t, func() {
+		gss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes) //nolint:errcheck
+	})
+	require.NoError(t, gss.Close())
+}
@@ -64,3 +64,18 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestMemoryListener(t *testing.T) {
+	ml := NewMemoryListener()
+	require.Empty(t, ml.PopStateCache())
+
+	testStoreKey := NewKVStoreKey("test_key")
+	require.NoError(t, ml.OnWrite(testStoreKey, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, ml.OnWrite(testStoreKey, []byte("key2"), nil, true))
+
+	require.Equal(t, []*StoreKVPair{
+		{StoreKey: "test_key", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "test_key", Delete: true, Key: []byte("key2")},
+	}, ml.PopStateCache())
+	require.Empty(t, ml.PopStateCache())
+}
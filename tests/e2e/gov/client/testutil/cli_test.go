@@ -24,8 +24,10 @@ func TestIntegrationTestSuite(t *testing.T) {
 	genesisState := v1.DefaultGenesisState()
 	maxDepPeriod := time.Duration(15) * time.Second
 	votingPeriod := time.Duration(5) * time.Second
+	expeditedVotingPeriod := time.Duration(2) * time.Second
 	genesisState.Params.MaxDepositPeriod = &maxDepPeriod
 	genesisState.Params.VotingPeriod = &votingPeriod
+	genesisState.Params.ExpeditedVotingPeriod = &expeditedVotingPeriod
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
 	cfg.GenesisState["gov"] = bz
@@ -28,7 +28,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"cosmossdk.io/simapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
@@ -122,6 +124,60 @@ func TestExportCmd_Height(t *testing.T) {
 	}
 }
 
+func TestExportCmd_Streamed(t *testing.T) {
+	testCases := []struct {
+		name   string
+		flag   string
+		output string
+	}{
+		{"directory", server.FlagOutputDir, "export"},
+		{"tarball", server.FlagOutputTarball, "export.tar"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			app, ctx, _, cmd := setupApp(t, tempDir)
+
+			output := &bytes.Buffer{}
+			cmd.SetOut(output)
+			outputPath := path.Join(tempDir, tc.output)
+			cmd.SetArgs([]string{
+				fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir),
+				fmt.Sprintf("--%s=%s", tc.flag, outputPath),
+			})
+			require.NoError(t, cmd.ExecuteContext(ctx))
+			require.Empty(t, output.String())
+
+			streamedGenesis, err := genutil.NewStreamedGenesisReader(outputPath)
+			require.NoError(t, err)
+			exportedGenDoc, err := streamedGenesis.GenesisDoc()
+			require.NoError(t, err)
+			require.Equal(t, int64(2), exportedGenDoc.InitialHeight)
+			require.Empty(t, exportedGenDoc.AppState)
+			require.NotEmpty(t, exportedGenDoc.Validators)
+
+			// initialize a new chain from the streamed genesis
+			newApp := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+				flags.FlagHome:             t.TempDir(),
+				server.FlagStreamedGenesis: outputPath,
+			})
+			res := newApp.InitChain(abci.RequestInitChain{
+				ChainId:         exportedGenDoc.ChainID,
+				InitialHeight:   exportedGenDoc.InitialHeight,
+				ConsensusParams: simtestutil.DefaultConsensusParams,
+			})
+			require.NotEmpty(t, res.Validators)
+			newApp.Commit()
+
+			sdkCtx := app.NewContext(true, tmproto.Header{})
+			newCtx := newApp.NewContext(true, tmproto.Header{})
+			require.Equal(t, app.BankKeeper.GetSupply(sdkCtx, sdk.DefaultBondDenom), newApp.BankKeeper.GetSupply(newCtx, sdk.DefaultBondDenom))
+			require.Equal(t, len(app.StakingKeeper.GetAllValidators(sdkCtx)), len(newApp.StakingKeeper.GetAllValidators(newCtx)))
+		})
+	}
+}
+
 func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *tmtypes.GenesisDoc, *cobra.Command) {
 	t.Helper()
 
@@ -157,7 +213,7 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 	app.Commit()
 
 	cmd := server.ExportCmd(
-		func(_ log.Logger, _ dbm.DB, _ io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string, appOptions types.AppOptions) (types.ExportedApp, error) {
+		func(_ log.Logger, _ dbm.DB, _ io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string, appOptions types.AppOptions, genesisTarget module.GenesisTarget) (types.ExportedApp, error) {
 			var simApp *simapp.SimApp
 			if height != -1 {
 				simApp = simapp.NewSimApp(logger, db, nil, false, appOptions)
@@ -169,7 +225,7 @@ func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *t
 				simApp = simapp.NewSimApp(logger, db, nil, true, appOptions)
 			}
 
-			return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+			return simApp.ExportAppStateAndValidatorsTo(forZeroHeight, jailAllowedAddrs, genesisTarget)
 		}, tempDir)
 
 	ctx := context.Background()
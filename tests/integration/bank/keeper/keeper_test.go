@@ -1692,6 +1692,46 @@ func (suite *IntegrationTestSuite) TestSetParams() {
 	})
 }
 
+// TestDenomPolicyBondDenomPaused checks that pausing and capping the bond denom
+// doesn't halt the chain, since the protocol flows are not subject to the
+// DenomPolicy of the coins they move.
+func TestDenomPolicyBondDenomPaused(t *testing.T) {
+	app := simapp.Setup(t, false)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	ctx := app.BaseApp.NewContext(false, header)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fees))
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+	require.NoError(t, app.BankKeeper.SetDenomPolicy(ctx, types.NewDenomPolicy(bondDenom, supply.Amount, nil, nil, true)))
+
+	// user transfers are paused
+	delegation := app.StakingKeeper.GetAllDelegations(ctx)[0]
+	delAddr := delegation.GetDelegatorAddr()
+	err := app.BankKeeper.SendCoins(ctx, delAddr, authtypes.NewModuleAddress(holder), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)))
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// unbonding moves the tokens from the bonded to the not bonded pool
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, delegation.GetValidatorAddr(), delegation.Shares.QuoInt64(2))
+	require.NoError(t, err)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the inflation is minted beyond the supply cap, and distributed with the fees
+	for i := 0; i < 3; i++ {
+		header = tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	ctx = app.BaseApp.NewContext(true, header)
+	require.True(t, app.BankKeeper.IsDenomPaused(ctx, bondDenom))
+	require.True(t, app.BankKeeper.GetSupply(ctx, bondDenom).Amount.GT(supply.Amount))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)).IsZero())
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -4,7 +4,10 @@ import (
 	gocontext "context"
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -50,7 +53,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -161,7 +164,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -231,6 +234,66 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	govAcct := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	govBalance := app.BankKeeper.GetAllBalances(ctx, govAcct)
+	addrBalance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+
+	testCases := []struct {
+		msg    string
+		amount int64
+		expErr string
+	}{
+		{
+			"send executed",
+			1000,
+			"",
+		},
+		{
+			"send failed on execution",
+			1000000,
+			"insufficient funds",
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			send := banktypes.NewMsgSend(govAcct, addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(testCase.amount))))
+			req, err := v1.NewQuerySimulateProposalRequest([]sdk.Msg{send})
+			suite.Require().NoError(err)
+
+			res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+			suite.Require().NoError(err)
+			suite.Require().Positive(res.GasUsed)
+
+			if testCase.expErr == "" {
+				suite.Require().Empty(res.Error)
+				suite.Require().Len(res.MsgResponses, 1)
+				suite.Require().True(containsEventType(res.Events, banktypes.EventTypeTransfer))
+			} else {
+				suite.Require().Contains(res.Error, testCase.expErr)
+				suite.Require().Empty(res.MsgResponses)
+				suite.Require().Empty(res.Events)
+			}
+
+			// nothing is persisted
+			suite.Require().Equal(govBalance, app.BankKeeper.GetAllBalances(ctx, govAcct))
+			suite.Require().Equal(addrBalance, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+		})
+	}
+}
+
+func containsEventType(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 func v1TallyToV1Beta1Tally(t v1.TallyResult) v1beta1.TallyResult {
 	yes, _ := sdk.NewIntFromString(t.YesCount)
 	no, _ := sdk.NewIntFromString(t.NoCount)
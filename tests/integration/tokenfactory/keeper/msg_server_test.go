@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"cosmossdk.io/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestMsgServer(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	admin, other := addrs[0], addrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(fee)))
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the denom creation fee is sent to the community pool
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+	require.Equal(t, "factory/"+admin.String()+"/bitcoin", denom)
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, admin, bondDenom).Amount)
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+
+	_, err = msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// coins are minted to the admin by default
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 500), nil))
+	require.NoError(t, err)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 200), other))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, admin, denom).Amount)
+	require.Equal(t, sdk.NewInt(200), app.BankKeeper.GetBalance(ctx, other, denom).Amount)
+	require.Equal(t, sdk.NewInt(700), app.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(other, sdk.NewInt64Coin(denom, 200), nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the module account holds no coins once the minted ones are burnt
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 300)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200), app.BankKeeper.GetBalance(ctx, admin, denom).Amount)
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	// the new admin administers the denom
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(admin, denom, other))
+	require.NoError(t, err)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 100)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(other, sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(300), app.BankKeeper.GetSupply(ctx, denom).Amount)
+}
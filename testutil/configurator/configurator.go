@@ -6,6 +6,7 @@ import (
 	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
+	epochingmodulev1 "cosmossdk.io/api/cosmos/epoching/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
 	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
@@ -22,6 +23,7 @@ import (
 var beginBlockOrder = []string{
 	"upgrade",
 	"capability",
+	"epoching",
 	"mint",
 	"distribution",
 	"slashing",
@@ -43,6 +45,7 @@ var beginBlockOrder = []string{
 var endBlockersOrder = []string{
 	"crisis",
 	"gov",
+	"epoching",
 	"staking",
 	"capability",
 	"auth",
@@ -67,6 +70,7 @@ var initGenesisOrder = []string{
 	"bank",
 	"distribution",
 	"staking",
+	"epoching",
 	"slashing",
 	"gov",
 	"mint",
@@ -144,6 +148,15 @@ func StakingModule() ModuleOption {
 	}
 }
 
+func EpochingModule() ModuleOption {
+	return func(config *appConfig) {
+		config.moduleConfigs["epoching"] = &appv1alpha1.ModuleConfig{
+			Name:   "epoching",
+			Config: appconfig.WrapAny(&epochingmodulev1.Module{}),
+		}
+	}
+}
+
 func SlashingModule() ModuleOption {
 	return func(config *appConfig) {
 		config.moduleConfigs["slashing"] = &appv1alpha1.ModuleConfig{
@@ -0,0 +1,107 @@
+// Package textual implements SIGN_MODE_TEXTUAL, as specified in ADR-050, in
+// which signers sign over a human-readable rendering of transactions, so that
+// devices such as hardware wallets can display what is being signed.
+package textual
+
+import (
+	"bytes"
+	"context"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/tx/textual/internal/cbor"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+// SignerData is the data of a signer of a transaction which is signed over
+// but not part of the transaction itself.
+type SignerData struct {
+	// Address is the address of the signer.
+	Address string
+
+	// ChainID is the ID of the chain the transaction is targeted at.
+	ChainID string
+
+	// AccountNumber is the account number of the signer.
+	AccountNumber uint64
+
+	// Sequence is the account sequence of the signer.
+	Sequence uint64
+
+	// PubKey is the public key of the signer, it may be nil.
+	PubKey *anypb.Any
+}
+
+// TxData is the transaction data signed over. BodyBytes and AuthInfoBytes
+// are the raw bytes Body and AuthInfo were decoded from, they are hashed into
+// the rendering so that signatures cover the exact transaction bytes.
+type TxData struct {
+	Body          *txv1beta1.TxBody
+	AuthInfo      *txv1beta1.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// SignModeHandler renders transactions into SIGN_MODE_TEXTUAL screens and
+// returns their sign bytes.
+type SignModeHandler struct {
+	tr valuerenderer.Textual
+}
+
+// NewSignModeHandler returns a SignModeHandler rendering values with the
+// given Textual, which also resolves the types of Any values such as
+// transaction messages.
+func NewSignModeHandler(t valuerenderer.Textual) SignModeHandler {
+	return SignModeHandler{tr: t}
+}
+
+// GetScreens returns the screens a transaction is rendered into for the given
+// signer.
+func (h SignModeHandler) GetScreens(ctx context.Context, signerData SignerData, txData TxData) ([]valuerenderer.Screen, error) {
+	return h.renderTx(ctx, signerData, txData)
+}
+
+// GetSignBytes returns the bytes signed over by the given signer, i.e. the
+// CBOR encoding of the screens the transaction is rendered into.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData SignerData, txData TxData) ([]byte, error) {
+	screens, err := h.GetScreens(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := encodeScreens(screens).Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+const (
+	screenTextKey   = 1
+	screenIndentKey = 2
+	screenExpertKey = 3
+)
+
+// encodeScreens returns the CBOR representation of screens, an array of
+// maps. The text, indentation and expert flag of a screen are keyed by 1, 2
+// and 3 respectively, and omitted when they hold their default value.
+func encodeScreens(screens []valuerenderer.Screen) cbor.Array {
+	arr := cbor.NewArray()
+	for _, screen := range screens {
+		m := cbor.NewMap()
+		if screen.Text != "" {
+			m = m.Add(cbor.NewUint(screenTextKey), cbor.NewText(screen.Text))
+		}
+		if screen.Indent > 0 {
+			m = m.Add(cbor.NewUint(screenIndentKey), cbor.NewUint(uint64(screen.Indent)))
+		}
+		if screen.Expert {
+			m = m.Add(cbor.NewUint(screenExpertKey), cbor.NewBool(true))
+		}
+		arr = arr.Append(m)
+	}
+
+	return arr
+}
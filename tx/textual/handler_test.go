@@ -0,0 +1,172 @@
+package textual_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	secp256k1v1 "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	distributionv1beta1 "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/tx/textual"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+const (
+	delegator = "cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs"
+	validator = "cosmosvaloper1ulav3hsenupswqfkw2y3sup5kgtqwnvqs3w3wh"
+)
+
+func makeTxData(t *testing.T) textual.TxData {
+	msg := newAny(t, &distributionv1beta1.MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+	})
+
+	body := &txv1beta1.TxBody{
+		Messages:      []*anypb.Any{msg},
+		Memo:          "a memo",
+		TimeoutHeight: 1000000,
+	}
+	authInfo := &txv1beta1.AuthInfo{
+		Fee: &txv1beta1.Fee{
+			Amount:   []*basev1beta1.Coin{{Denom: "uatom", Amount: "2000"}},
+			GasLimit: 200000,
+			Granter:  validator,
+		},
+	}
+
+	bodyBz, err := proto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBz, err := proto.Marshal(authInfo)
+	require.NoError(t, err)
+
+	return textual.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+}
+
+func makeSignerData(t *testing.T) textual.SignerData {
+	return textual.SignerData{
+		Address:       delegator,
+		ChainID:       "my-chain",
+		AccountNumber: 12345,
+		Sequence:      3,
+		PubKey:        newAny(t, &secp256k1v1.PubKey{Key: []byte{2, 1, 2, 3}}),
+	}
+}
+
+// newAny packs a message into an Any with the SDK type URL convention.
+func newAny(t *testing.T, msg proto.Message) *anypb.Any {
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	return &anypb.Any{
+		TypeUrl: "/" + string(msg.ProtoReflect().Descriptor().FullName()),
+		Value:   bz,
+	}
+}
+
+// atomMetadataQuerier returns the metadata of the atom denom.
+func atomMetadataQuerier(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	if denom != "uatom" && denom != "atom" {
+		return nil, nil
+	}
+
+	return &bankv1beta1.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*bankv1beta1.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}, nil
+}
+
+func TestGetScreens(t *testing.T) {
+	handler := textual.NewSignModeHandler(valuerenderer.NewTextual(atomMetadataQuerier))
+	txData := makeTxData(t)
+
+	screens, err := handler.GetScreens(context.Background(), makeSignerData(t), txData)
+	require.NoError(t, err)
+
+	// The hash is checked separately as it depends on the encoding of the
+	// transaction.
+	require.NotEmpty(t, screens)
+	hashScreen := screens[len(screens)-1]
+	require.True(t, hashScreen.Expert)
+	require.Regexp(t, "^Hash of raw bytes: [0-9A-F]{64}$", hashScreen.Text)
+
+	require.Equal(t, []valuerenderer.Screen{
+		{Text: "Chain id: my-chain"},
+		{Text: "Account number: 12'345"},
+		{Text: "Sequence: 3"},
+		{Text: "Address: " + delegator},
+		{Text: "Public key: /cosmos.crypto.secp256k1.PubKey", Expert: true},
+		{Text: "Key: 02010203", Indent: 1, Expert: true},
+		{Text: "This transaction has 1 Message"},
+		{Text: "Message (1/1): /cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward", Indent: 1},
+		{Text: "Delegator address: " + delegator, Indent: 2},
+		{Text: "Validator address: " + validator, Indent: 2},
+		{Text: "End of transaction messages"},
+		{Text: "Memo: a memo"},
+		{Text: "Fees: 0.002 atom"},
+		{Text: "Fee granter: " + validator, Expert: true},
+		{Text: "Gas limit: 200'000", Expert: true},
+		{Text: "Timeout height: 1'000'000", Expert: true},
+	}, screens[:len(screens)-1])
+}
+
+func TestGetScreensUnresolvableMessage(t *testing.T) {
+	tr := valuerenderer.NewTextual(nil)
+	tr.SetTypeResolver(new(protoregistry.Types))
+	handler := textual.NewSignModeHandler(tr)
+
+	_, err := handler.GetScreens(context.Background(), makeSignerData(t), makeTxData(t))
+	require.ErrorContains(t, err, "unable to resolve type URL")
+}
+
+func TestGetSignBytes(t *testing.T) {
+	handler := textual.NewSignModeHandler(valuerenderer.NewTextual(nil))
+	ctx := context.Background()
+	signerData, txData := makeSignerData(t), makeTxData(t)
+
+	signBytes, err := handler.GetSignBytes(ctx, signerData, txData)
+	require.NoError(t, err)
+
+	// The sign bytes are a CBOR array of screens, starting with the chain ID
+	// and account number ones.
+	require.Equal(t,
+		"91"+ // array of 17 screens
+			"a1"+"01"+"72"+hex.EncodeToString([]byte("Chain id: my-chain"))+
+			"a1"+"01"+"76"+hex.EncodeToString([]byte("Account number: 12'345")),
+		hex.EncodeToString(signBytes[:1+2+1+18+2+1+22]),
+	)
+
+	// Sign bytes are deterministic.
+	signBytes2, err := handler.GetSignBytes(ctx, signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytes2)
+
+	// They change with the signer data and the raw transaction bytes.
+	signerData.Sequence++
+	signBytes2, err = handler.GetSignBytes(ctx, signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, signBytes2)
+
+	signerData = makeSignerData(t)
+	txData.AuthInfoBytes = append(txData.AuthInfoBytes, 0)
+	signBytes2, err = handler.GetSignBytes(ctx, signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, signBytes2)
+}
@@ -0,0 +1,213 @@
+// Package cbor implements the subset of CBOR (RFC 8949) used to encode
+// SIGN_MODE_TEXTUAL screens. Only unsigned integers, text strings, booleans,
+// arrays and maps are supported, and encoding always follows the core
+// deterministic encoding requirements of RFC 8949 section 4.2.1: integer
+// arguments use their shortest form, lengths are definite and map entries
+// are sorted by the bytewise order of their encoded keys.
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	majorUint   byte = 0
+	majorText   byte = 3
+	majorArray  byte = 4
+	majorMap    byte = 5
+	majorSimple byte = 7
+
+	simpleFalse byte = 20
+	simpleTrue  byte = 21
+)
+
+// Cbor is a CBOR data item.
+type Cbor interface {
+	// Encode writes the deterministic encoding of the data item.
+	Encode(w io.Writer) error
+}
+
+// Uint is a CBOR unsigned integer.
+type Uint uint64
+
+var _ Cbor = Uint(0)
+
+// NewUint returns a CBOR unsigned integer.
+func NewUint(n uint64) Uint {
+	return Uint(n)
+}
+
+// Encode implements the Cbor interface.
+func (n Uint) Encode(w io.Writer) error {
+	return encodeHead(w, majorUint, uint64(n))
+}
+
+// Text is a CBOR text string.
+type Text string
+
+var _ Cbor = Text("")
+
+// NewText returns a CBOR text string.
+func NewText(s string) Text {
+	return Text(s)
+}
+
+// Encode implements the Cbor interface.
+func (s Text) Encode(w io.Writer) error {
+	if err := encodeHead(w, majorText, uint64(len(s))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, string(s))
+	return err
+}
+
+// Bool is a CBOR boolean.
+type Bool bool
+
+var _ Cbor = Bool(false)
+
+// NewBool returns a CBOR boolean.
+func NewBool(b bool) Bool {
+	return Bool(b)
+}
+
+// Encode implements the Cbor interface.
+func (b Bool) Encode(w io.Writer) error {
+	value := simpleFalse
+	if b {
+		value = simpleTrue
+	}
+	return encodeHead(w, majorSimple, uint64(value))
+}
+
+// Array is a CBOR array.
+type Array struct {
+	elts []Cbor
+}
+
+var _ Cbor = Array{}
+
+// NewArray returns a CBOR array of the given elements.
+func NewArray(elts ...Cbor) Array {
+	return Array{elts: elts}
+}
+
+// Append returns an array with the given element appended.
+func (a Array) Append(c Cbor) Array {
+	a.elts = append(a.elts, c)
+	return a
+}
+
+// Encode implements the Cbor interface.
+func (a Array) Encode(w io.Writer) error {
+	if err := encodeHead(w, majorArray, uint64(len(a.elts))); err != nil {
+		return err
+	}
+	for _, elt := range a.elts {
+		if err := elt.Encode(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Entry is a key-value entry of a CBOR map.
+type Entry struct {
+	key Cbor
+	val Cbor
+}
+
+// NewEntry returns a map entry.
+func NewEntry(key, val Cbor) Entry {
+	return Entry{key: key, val: val}
+}
+
+// Map is a CBOR map.
+type Map struct {
+	entries []Entry
+}
+
+var _ Cbor = Map{}
+
+// NewMap returns a CBOR map of the given entries.
+func NewMap(entries ...Entry) Map {
+	return Map{entries: entries}
+}
+
+// Add returns a map with the given entry added.
+func (m Map) Add(key, val Cbor) Map {
+	m.entries = append(m.entries, NewEntry(key, val))
+	return m
+}
+
+// Encode implements the Cbor interface. It fails if the map has duplicate
+// keys.
+func (m Map) Encode(w io.Writer) error {
+	type encodedEntry struct {
+		key []byte
+		val Cbor
+	}
+
+	entries := make([]encodedEntry, len(m.entries))
+	for i, entry := range m.entries {
+		var buf bytes.Buffer
+		if err := entry.key.Encode(&buf); err != nil {
+			return err
+		}
+		entries[i] = encodedEntry{key: buf.Bytes(), val: entry.val}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	for i := 1; i < len(entries); i++ {
+		if bytes.Equal(entries[i-1].key, entries[i].key) {
+			return fmt.Errorf("duplicate map key %x", entries[i].key)
+		}
+	}
+
+	if err := encodeHead(w, majorMap, uint64(len(entries))); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if _, err := w.Write(entry.key); err != nil {
+			return err
+		}
+		if err := entry.val.Encode(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeHead writes the initial byte of a data item of the given major type
+// and its argument in the shortest form.
+func encodeHead(w io.Writer, major byte, arg uint64) error {
+	var head []byte
+	switch {
+	case arg < 24:
+		head = []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		head = []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		head = make([]byte, 3)
+		head[0] = major<<5 | 25
+		binary.BigEndian.PutUint16(head[1:], uint16(arg))
+	case arg <= 0xffffffff:
+		head = make([]byte, 5)
+		head[0] = major<<5 | 26
+		binary.BigEndian.PutUint32(head[1:], uint32(arg))
+	default:
+		head = make([]byte, 9)
+		head[0] = major<<5 | 27
+		binary.BigEndian.PutUint64(head[1:], arg)
+	}
+
+	_, err := w.Write(head)
+	return err
+}
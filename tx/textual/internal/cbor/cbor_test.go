@@ -0,0 +1,74 @@
+package cbor_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tx/textual/internal/cbor"
+)
+
+// Test vectors are taken from RFC 8949 appendix A.
+func TestEncode(t *testing.T) {
+	testcases := []struct {
+		name     string
+		cb       cbor.Cbor
+		encoding string
+	}{
+		{"uint 0", cbor.NewUint(0), "00"},
+		{"uint 23", cbor.NewUint(23), "17"},
+		{"uint 24", cbor.NewUint(24), "1818"},
+		{"uint 100", cbor.NewUint(100), "1864"},
+		{"uint 1000", cbor.NewUint(1000), "1903e8"},
+		{"uint 1000000", cbor.NewUint(1000000), "1a000f4240"},
+		{"uint 1000000000000", cbor.NewUint(1000000000000), "1b000000e8d4a51000"},
+		{"uint max", cbor.NewUint(18446744073709551615), "1bffffffffffffffff"},
+		{"text empty", cbor.NewText(""), "60"},
+		{"text a", cbor.NewText("a"), "6161"},
+		{"text IETF", cbor.NewText("IETF"), "6449455446"},
+		{"text unicode", cbor.NewText("ü"), "62c3bc"},
+		{"false", cbor.NewBool(false), "f4"},
+		{"true", cbor.NewBool(true), "f5"},
+		{"array empty", cbor.NewArray(), "80"},
+		{"array", cbor.NewArray(cbor.NewUint(1), cbor.NewUint(2), cbor.NewUint(3)), "83010203"},
+		{
+			"array nested",
+			cbor.NewArray(cbor.NewUint(1), cbor.NewArray(cbor.NewUint(2), cbor.NewUint(3))),
+			"8201820203",
+		},
+		{"map empty", cbor.NewMap(), "a0"},
+		{
+			"map",
+			cbor.NewMap(cbor.NewEntry(cbor.NewUint(1), cbor.NewUint(2)), cbor.NewEntry(cbor.NewUint(3), cbor.NewUint(4))),
+			"a201020304",
+		},
+		{
+			"map sorted",
+			cbor.NewMap().Add(cbor.NewUint(3), cbor.NewUint(4)).Add(cbor.NewUint(1), cbor.NewUint(2)),
+			"a201020304",
+		},
+		{
+			"map text keys",
+			cbor.NewMap(cbor.NewEntry(cbor.NewText("a"), cbor.NewUint(1)), cbor.NewEntry(cbor.NewText("b"), cbor.NewArray(cbor.NewUint(2), cbor.NewUint(3)))),
+			"a26161016162820203",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tc.cb.Encode(&buf)
+			require.NoError(t, err)
+			require.Equal(t, tc.encoding, hex.EncodeToString(buf.Bytes()))
+		})
+	}
+}
+
+func TestEncodeDuplicateMapKeys(t *testing.T) {
+	m := cbor.NewMap().Add(cbor.NewUint(1), cbor.NewUint(2)).Add(cbor.NewUint(1), cbor.NewUint(3))
+	err := m.Encode(new(bytes.Buffer))
+	require.ErrorContains(t, err, "duplicate map key")
+}
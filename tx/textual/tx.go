@@ -0,0 +1,224 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+// renderTx renders a transaction into screens for the given signer:
+//
+//	Chain id: <string>
+//	Account number: <uint64>
+//	Sequence: <uint64>
+//	Address: <string>
+//	*Public key: <Any>
+//	This transaction has <int> Message(s)
+//	> Message (<int>/<int>): <Any>
+//	End of transaction messages
+//	Memo: <string>
+//	Fees: <coins>
+//	*Fee payer: <string>
+//	*Fee granter: <string>
+//	Tip: <coins>
+//	*Tipper: <string>
+//	*Gas limit: <uint64>
+//	*Timeout height: <uint64>
+//	*Extension option (<int>/<int>): <Any>
+//	*Non critical extension option (<int>/<int>): <Any>
+//	*Hash of raw bytes: <hex>
+//
+// where '*' marks expert screens and '>' indentation. Screens of values
+// which are not set are omitted. The hash covers the raw body and auth info
+// bytes, and thus the parts of the transaction not rendered, e.g. the other
+// signers.
+func (h SignModeHandler) renderTx(ctx context.Context, signerData SignerData, txData TxData) ([]valuerenderer.Screen, error) {
+	body, authInfo := txData.Body, txData.AuthInfo
+	if body == nil || authInfo == nil {
+		return nil, fmt.Errorf("expected non-nil transaction body and auth info")
+	}
+
+	var screens []valuerenderer.Screen
+	add := func(s []valuerenderer.Screen, err error) error {
+		if err != nil {
+			return err
+		}
+		screens = append(screens, s...)
+		return nil
+	}
+
+	signDoc := (&txv1beta1.SignDoc{
+		ChainId:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+	}).ProtoReflect()
+	signerInfo := (&txv1beta1.SignerInfo{Sequence: signerData.Sequence}).ProtoReflect()
+
+	if err := add(h.formatField(ctx, signDoc, "chain_id", "Chain id", false)); err != nil {
+		return nil, err
+	}
+	if err := add(h.formatField(ctx, signDoc, "account_number", "Account number", false)); err != nil {
+		return nil, err
+	}
+	if err := add(h.formatField(ctx, signerInfo, "sequence", "Sequence", false)); err != nil {
+		return nil, err
+	}
+	screens = append(screens, valuerenderer.Screen{Text: fmt.Sprintf("Address: %s", signerData.Address)})
+	if signerData.PubKey != nil {
+		if err := add(h.formatAny(ctx, signerData.PubKey, "Public key", 0, true)); err != nil {
+			return nil, err
+		}
+	}
+
+	n := len(body.Messages)
+	plural := "s"
+	if n == 1 {
+		plural = ""
+	}
+	screens = append(screens, valuerenderer.Screen{Text: fmt.Sprintf("This transaction has %d Message%s", n, plural)})
+	for i, msg := range body.Messages {
+		if err := add(h.formatAny(ctx, msg, fmt.Sprintf("Message (%d/%d)", i+1, n), 1, false)); err != nil {
+			return nil, err
+		}
+	}
+	screens = append(screens, valuerenderer.Screen{Text: "End of transaction messages"})
+
+	bodyMsg := body.ProtoReflect()
+	if body.Memo != "" {
+		if err := add(h.formatField(ctx, bodyMsg, "memo", "Memo", false)); err != nil {
+			return nil, err
+		}
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		if len(fee.Amount) > 0 {
+			if err := add(h.formatField(ctx, fee.ProtoReflect(), "amount", "Fees", false)); err != nil {
+				return nil, err
+			}
+		}
+
+		feeMsg := fee.ProtoReflect()
+		if fee.Payer != "" {
+			if err := add(h.formatField(ctx, feeMsg, "payer", "Fee payer", true)); err != nil {
+				return nil, err
+			}
+		}
+		if fee.Granter != "" {
+			if err := add(h.formatField(ctx, feeMsg, "granter", "Fee granter", true)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		if len(tip.Amount) > 0 {
+			if err := add(h.formatField(ctx, tip.ProtoReflect(), "amount", "Tip", false)); err != nil {
+				return nil, err
+			}
+		}
+		if tip.Tipper != "" {
+			if err := add(h.formatField(ctx, tip.ProtoReflect(), "tipper", "Tipper", true)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if fee := authInfo.Fee; fee != nil && fee.GasLimit != 0 {
+		if err := add(h.formatField(ctx, fee.ProtoReflect(), "gas_limit", "Gas limit", true)); err != nil {
+			return nil, err
+		}
+	}
+
+	if body.TimeoutHeight != 0 {
+		if err := add(h.formatField(ctx, bodyMsg, "timeout_height", "Timeout height", true)); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, opt := range body.ExtensionOptions {
+		title := fmt.Sprintf("Extension option (%d/%d)", i+1, len(body.ExtensionOptions))
+		if err := add(h.formatAny(ctx, opt, title, 0, true)); err != nil {
+			return nil, err
+		}
+	}
+	for i, opt := range body.NonCriticalExtensionOptions {
+		title := fmt.Sprintf("Non critical extension option (%d/%d)", i+1, len(body.NonCriticalExtensionOptions))
+		if err := add(h.formatAny(ctx, opt, title, 0, true)); err != nil {
+			return nil, err
+		}
+	}
+
+	screens = append(screens, valuerenderer.Screen{
+		Text:   fmt.Sprintf("Hash of raw bytes: %s", hashRawBytes(txData.BodyBytes, txData.AuthInfoBytes)),
+		Expert: true,
+	})
+
+	return screens, nil
+}
+
+// formatField renders the field of a message with the given name into
+// screens, the first of them being prefixed with the title.
+func (h SignModeHandler) formatField(ctx context.Context, msg protoreflect.Message, name protoreflect.Name, title string, expert bool) ([]valuerenderer.Screen, error) {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil {
+		return nil, fmt.Errorf("field %s not found in %s", name, msg.Descriptor().FullName())
+	}
+
+	vr, err := h.tr.GetValueRenderer(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	screens, err := vr.Format(ctx, msg.Get(fd))
+	if err != nil {
+		return nil, err
+	}
+	if len(screens) == 0 {
+		return nil, fmt.Errorf("got no screens for field %s", fd.FullName())
+	}
+
+	screens[0].Text = fmt.Sprintf("%s: %s", title, screens[0].Text)
+	for i := range screens {
+		screens[i].Expert = screens[i].Expert || expert
+	}
+
+	return screens, nil
+}
+
+// formatAny renders an Any into a screen with the given title and its type
+// URL, followed by the screens of the fields of the packed message.
+func (h SignModeHandler) formatAny(ctx context.Context, any *anypb.Any, title string, indent int, expert bool) ([]valuerenderer.Screen, error) {
+	vr := valuerenderer.NewAnyValueRenderer(h.tr)
+	screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(any.ProtoReflect()))
+	if err != nil {
+		return nil, err
+	}
+
+	screens[0].Text = fmt.Sprintf("%s: %s", title, screens[0].Text)
+	for i := range screens {
+		screens[i].Indent += indent
+		screens[i].Expert = screens[i].Expert || expert
+	}
+
+	return screens, nil
+}
+
+// hashRawBytes returns the hex encoded SHA-256 hash of the raw body and auth
+// info bytes of a transaction, the body being prefixed with its length so
+// that the boundary between both is unambiguous.
+func hashRawBytes(bodyBz, authInfoBz []byte) string {
+	h := sha256.New()
+	var length [binary.MaxVarintLen64]byte
+	h.Write(length[:binary.PutUvarint(length[:], uint64(len(bodyBz)))])
+	h.Write(bodyBz)
+	h.Write(authInfoBz)
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+}
@@ -0,0 +1,107 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// anyValueRenderer is the ValueRenderer for Any messages. An Any is rendered
+// as its type URL, followed by the screens of the packed message indented
+// once. For messages rendered by the default message renderer, the header
+// screen is replaced by the type URL, which already names the message:
+//
+//	/cosmos.bank.v1beta1.MsgSend
+//	> From address: cosmos1...
+type anyValueRenderer struct {
+	tr Textual
+}
+
+var _ ValueRenderer = anyValueRenderer{}
+
+// NewAnyValueRenderer returns a ValueRenderer for Any messages, resolving the
+// types of the packed messages with the type resolver of the given Textual.
+func NewAnyValueRenderer(t Textual) ValueRenderer {
+	return anyValueRenderer{tr: t}
+}
+
+func (vr anyValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	// The Any may be a dynamic message, its fields are thus read by name.
+	anyMsg := v.Message()
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	value := anyMsg.Get(fields.ByName("value")).Bytes()
+
+	msgType, err := vr.tr.resolver().FindMessageByURL(typeURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve type URL %s: %w", typeURL, err)
+	}
+
+	msg := msgType.New()
+	if err := proto.Unmarshal(value, msg.Interface()); err != nil {
+		return nil, err
+	}
+
+	mvr := vr.tr.getMessageValueRenderer(msg.Descriptor())
+	subscreens, err := mvr.Format(ctx, protoreflect.ValueOfMessage(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	screens := []Screen{{Text: typeURL}}
+	if _, ok := mvr.(messageValueRenderer); ok {
+		// The fields of the message are already indented.
+		return append(screens, subscreens[1:]...), nil
+	}
+	for _, subscreen := range subscreens {
+		subscreen.Indent++
+		screens = append(screens, subscreen)
+	}
+
+	return screens, nil
+}
+
+func (vr anyValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || screens[0].Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected a type URL screen")
+	}
+
+	typeURL := screens[0].Text
+	msgType, err := vr.tr.resolver().FindMessageByURL(typeURL)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("unable to resolve type URL %s: %w", typeURL, err)
+	}
+
+	md := msgType.Descriptor()
+	mvr := vr.tr.getMessageValueRenderer(md)
+
+	var subscreens []Screen
+	_, isMsgRenderer := mvr.(messageValueRenderer)
+	if isMsgRenderer {
+		subscreens = append(subscreens, Screen{Text: messageHeader(md)})
+	}
+	for _, subscreen := range screens[1:] {
+		if subscreen.Indent < 1 {
+			return protoreflect.Value{}, fmt.Errorf("expected indented screens for the message packed in %s", typeURL)
+		}
+		if !isMsgRenderer {
+			subscreen.Indent--
+		}
+		subscreens = append(subscreens, subscreen)
+	}
+
+	msg, err := mvr.Parse(ctx, subscreens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	value, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Message().Interface())
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfMessage((&anypb.Any{TypeUrl: typeURL, Value: value}).ProtoReflect()), nil
+}
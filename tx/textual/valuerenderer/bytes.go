@@ -3,7 +3,6 @@ package valuerenderer
 import (
 	"context"
 	"encoding/hex"
-	"io"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -14,18 +13,17 @@ type bytesValueRenderer struct{}
 
 var _ ValueRenderer = bytesValueRenderer{}
 
-func (vr bytesValueRenderer) Format(ctx context.Context, v protoreflect.Value, w io.Writer) error {
-	_, err := io.WriteString(w, strings.ToUpper(hex.EncodeToString(v.Bytes())))
-	return err
+func (vr bytesValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strings.ToUpper(hex.EncodeToString(v.Bytes()))}}, nil
 }
 
-func (vr bytesValueRenderer) Parse(_ context.Context, r io.Reader) (protoreflect.Value, error) {
-	formatted, err := io.ReadAll(r)
+func (vr bytesValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	formatted, err := singleScreenText(screens)
 	if err != nil {
 		return protoreflect.ValueOfBytes([]byte{}), err
 	}
 
-	data, err := hex.DecodeString(string(formatted))
+	data, err := hex.DecodeString(formatted)
 	if err != nil {
 		return protoreflect.ValueOfBytes([]byte{}), err
 	}
@@ -0,0 +1,261 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// coinValueRenderer implements ValueRenderer for Coin messages. A coin is
+// rendered as its amount followed by its denom, e.g. "1.5 atom". When the
+// denom has metadata, the amount is converted to the display denom, otherwise
+// it is rendered in the denom of the coin, e.g. "1'500'000 uatom".
+type coinValueRenderer struct {
+	m *coinMetadataResolver
+}
+
+var _ ValueRenderer = coinValueRenderer{}
+
+func (vr coinValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	formatted, err := formatCoin(ctx, vr.m, v.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatted}}, nil
+}
+
+// Parse implements the ValueRenderer interface. Coins rendered in their
+// display denom are parsed back in the base denom of their metadata.
+func (vr coinValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	coin, err := parseCoin(ctx, vr.m, text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfMessage(coin.ProtoReflect()), nil
+}
+
+// coinsValueRenderer implements ValueRenderer for repeated Coin fields. Coins
+// are rendered as a comma separated list of coins, each of them being
+// rendered as by coinValueRenderer, or as "zero" if the list is empty.
+type coinsValueRenderer struct {
+	m *coinMetadataResolver
+}
+
+var _ ValueRenderer = coinsValueRenderer{}
+
+// emptyCoins is the rendering of an empty list of coins.
+const emptyCoins = "zero"
+
+func (vr coinsValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	list := v.List()
+	if list.Len() == 0 {
+		return []Screen{{Text: emptyCoins}}, nil
+	}
+
+	formatted := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		var err error
+		formatted[i], err = formatCoin(ctx, vr.m, list.Get(i).Message())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []Screen{{Text: strings.Join(formatted, ", ")}}, nil
+}
+
+func (vr coinsValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	list := &valueList{}
+	if text == emptyCoins {
+		return protoreflect.ValueOfList(list), nil
+	}
+
+	for _, formatted := range strings.Split(text, ", ") {
+		coin, err := parseCoin(ctx, vr.m, formatted)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+
+		list.Append(protoreflect.ValueOfMessage(coin.ProtoReflect()))
+	}
+
+	return protoreflect.ValueOfList(list), nil
+}
+
+// formatCoin renders a Coin message, which may be a dynamic message.
+func formatCoin(ctx context.Context, m *coinMetadataResolver, coin protoreflect.Message) (string, error) {
+	fields := coin.Descriptor().Fields()
+	denom := coin.Get(fields.ByName("denom")).String()
+	amount := coin.Get(fields.ByName("amount")).String()
+
+	metadata, err := m.byBaseDenom(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata != nil {
+		coinExp, found1 := denomExponent(metadata, denom)
+		displayExp, found2 := denomExponent(metadata, metadata.Display)
+		if found1 && found2 {
+			amount, err = shiftDecimalPoint(amount, int(displayExp)-int(coinExp))
+			if err != nil {
+				return "", err
+			}
+			denom = metadata.Display
+			m.storeDisplayDenom(metadata)
+		}
+	}
+
+	formatted, err := formatDecimal(amount)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s %s", formatted, denom), nil
+}
+
+// parseCoin is the inverse of formatCoin. A coin rendered in a display denom
+// is parsed back in the base denom of its metadata, as resolved by the
+// coinMetadataResolver.
+func parseCoin(ctx context.Context, m *coinMetadataResolver, text string) (*basev1beta1.Coin, error) {
+	i := strings.LastIndexByte(text, ' ')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid coin %q: expected an amount and a denom", text)
+	}
+
+	amount, err := parseDecimal(text[:i])
+	if err != nil {
+		return nil, err
+	}
+	denom := text[i+1:]
+
+	metadata, err := m.byDisplayDenom(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata != nil && denom == metadata.Display {
+		baseExp, found1 := denomExponent(metadata, metadata.Base)
+		displayExp, found2 := denomExponent(metadata, metadata.Display)
+		if found1 && found2 {
+			amount, err = shiftDecimalPoint(amount, int(baseExp)-int(displayExp))
+			if err != nil {
+				return nil, err
+			}
+			denom = metadata.Base
+		}
+	}
+
+	if strings.Contains(amount, ".") {
+		return nil, fmt.Errorf("invalid coin %q: amount is not an integer in denom %s", text, denom)
+	}
+
+	return &basev1beta1.Coin{Denom: denom, Amount: amount}, nil
+}
+
+// coinMetadataResolver queries the denom metadata of coins. The metadata being
+// keyed by base denom, it keeps the metadata of the coins it formatted by
+// display denom, for them to be parsed back in their base denom.
+type coinMetadataResolver struct {
+	q CoinMetadataQueryFn
+	// displayDenoms maps display denoms to their *bankv1beta1.Metadata.
+	displayDenoms sync.Map
+}
+
+// byBaseDenom returns the metadata of the given base denom, or nil if there
+// is no querier or the denom has no metadata.
+func (m *coinMetadataResolver) byBaseDenom(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	if m == nil || m.q == nil {
+		return nil, nil
+	}
+
+	return m.q(ctx, denom)
+}
+
+// byDisplayDenom returns the metadata of the given display denom, as stored
+// when formatting a coin of its base denom. Otherwise the denom is queried as
+// a base denom, which finds the metadata whose base and display denoms are
+// the same.
+func (m *coinMetadataResolver) byDisplayDenom(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	if metadata, found := m.displayDenoms.Load(denom); found {
+		return metadata.(*bankv1beta1.Metadata), nil
+	}
+
+	return m.byBaseDenom(ctx, denom)
+}
+
+// storeDisplayDenom stores the metadata of a coin formatted in its display
+// denom, replacing any previous metadata of the display denom.
+func (m *coinMetadataResolver) storeDisplayDenom(metadata *bankv1beta1.Metadata) {
+	m.displayDenoms.Store(metadata.Display, metadata)
+}
+
+// denomExponent returns the exponent of the given denom unit of the metadata,
+// the unit being matched by its denom or one of its aliases.
+func denomExponent(metadata *bankv1beta1.Metadata, denom string) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// shiftDecimalPoint moves the decimal point of a non-negative decimal n places
+// to the left, i.e. divides it by 10^n, or -n places to the right if n is
+// negative. This function operates with string manipulation so that no
+// precision is lost.
+func shiftDecimalPoint(v string, n int) (string, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) > 2 || !hasOnlyDigits(parts[0]) || (len(parts) == 2 && !hasOnlyDigits(parts[1])) {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	digits := strings.Join(parts, "")
+	point := len(parts[0]) - n
+	if point <= 0 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	intPart := strings.TrimLeft(digits[:point], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	decPart := strings.TrimRight(digits[point:], "0")
+	if decPart == "" {
+		return intPart, nil
+	}
+
+	return intPart + "." + decPart, nil
+}
@@ -0,0 +1,151 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"testing"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/tx/textual/internal/testpb"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+var coinMetadata = map[string]*bankv1beta1.Metadata{
+	"uatom": {
+		Base:    "uatom",
+		Display: "ATOM",
+		DenomUnits: []*bankv1beta1.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "ATOM", Exponent: 6},
+		},
+	},
+	// The display denom unit of this metadata is missing.
+	"ubad": {
+		Base:       "ubad",
+		Display:    "BAD",
+		DenomUnits: []*bankv1beta1.DenomUnit{{Denom: "ubad", Exponent: 0}},
+	},
+}
+
+// queryCoinMetadata is a CoinMetadataQueryFn returning coinMetadata by base
+// denom, as x/bank does.
+func queryCoinMetadata(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	return coinMetadata[denom], nil
+}
+
+func TestCoinValueRenderer(t *testing.T) {
+	testcases := []struct {
+		name     string
+		coin     *basev1beta1.Coin
+		text     string
+		parseErr bool
+	}{
+		{"display denom", &basev1beta1.Coin{Denom: "uatom", Amount: "1500000"}, "1.5 ATOM", false},
+		{"large amount", &basev1beta1.Coin{Denom: "uatom", Amount: "1234567000000"}, "1'234'567 ATOM", false},
+		{"small amount", &basev1beta1.Coin{Denom: "uatom", Amount: "1"}, "0.000001 ATOM", false},
+		{"zero", &basev1beta1.Coin{Denom: "uatom", Amount: "0"}, "0 ATOM", false},
+		{"no metadata", &basev1beta1.Coin{Denom: "stake", Amount: "1500000"}, "1'500'000 stake", false},
+		{"no display unit", &basev1beta1.Coin{Denom: "ubad", Amount: "1000"}, "1'000 ubad", false},
+		{"fraction of base denom", nil, "0.0000001 ATOM", true},
+		{"fraction without metadata", nil, "1.5 stake", true},
+		{"no denom", nil, "1500000", true},
+		{"bad separators", nil, "1'50'000 stake", true},
+	}
+
+	coinField := (&testpb.A{}).ProtoReflect().Descriptor().Fields().ByName("COIN")
+	vr, err := valuerenderer.NewTextual(queryCoinMetadata).GetValueRenderer(coinField)
+	require.NoError(t, err)
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			screens := []valuerenderer.Screen{{Text: tc.text}}
+			if tc.coin != nil {
+				var err error
+				screens, err = vr.Format(ctx, protoreflect.ValueOfMessage(tc.coin.ProtoReflect()))
+				require.NoError(t, err)
+				require.Equal(t, []valuerenderer.Screen{{Text: tc.text}}, screens)
+			}
+
+			v, err := vr.Parse(ctx, screens)
+			if tc.parseErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tc.coin, v.Message().Interface()), "got %v", v.Message().Interface())
+		})
+	}
+}
+
+func TestCoinValueRendererDisplayDenom(t *testing.T) {
+	ctx := context.Background()
+	coinField := (&testpb.A{}).ProtoReflect().Descriptor().Fields().ByName("COIN")
+	vr, err := valuerenderer.NewTextual(queryCoinMetadata).GetValueRenderer(coinField)
+	require.NoError(t, err)
+
+	// the display denom is unknown until a coin is formatted in it, since the
+	// metadata are queried by base denom
+	screens := []valuerenderer.Screen{{Text: "1.5 ATOM"}}
+	_, err = vr.Parse(ctx, screens)
+	require.Error(t, err)
+
+	coin := &basev1beta1.Coin{Denom: "uatom", Amount: "1500000"}
+	formatted, err := vr.Format(ctx, protoreflect.ValueOfMessage(coin.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, screens, formatted)
+
+	v, err := vr.Parse(ctx, screens)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(coin, v.Message().Interface()), "got %v", v.Message().Interface())
+
+	// coins in a denom without metadata are parsed in that denom
+	v, err = vr.Parse(ctx, []valuerenderer.Screen{{Text: "2 stake"}})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&basev1beta1.Coin{Denom: "stake", Amount: "2"}, v.Message().Interface()))
+}
+
+func TestCoinsValueRenderer(t *testing.T) {
+	testcases := []struct {
+		name  string
+		coins []*basev1beta1.Coin
+		text  string
+	}{
+		{"empty", nil, "zero"},
+		{"single", []*basev1beta1.Coin{{Denom: "uatom", Amount: "2000"}}, "0.002 ATOM"},
+		{
+			"multiple",
+			[]*basev1beta1.Coin{{Denom: "stake", Amount: "1000"}, {Denom: "uatom", Amount: "3000000"}},
+			"1'000 stake, 3 ATOM",
+		},
+	}
+
+	coinsField := (&testpb.A{}).ProtoReflect().Descriptor().Fields().ByName("COINS")
+	vr, err := valuerenderer.NewTextual(queryCoinMetadata).GetValueRenderer(coinsField)
+	require.NoError(t, err)
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := &testpb.A{COINS: tc.coins}
+
+			screens, err := vr.Format(ctx, a.ProtoReflect().Get(coinsField))
+			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc.text}}, screens)
+
+			v, err := vr.Parse(ctx, screens)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.coins), v.List().Len())
+			for i, coin := range tc.coins {
+				require.True(t, proto.Equal(coin, v.List().Get(i).Message().Interface()))
+			}
+		})
+	}
+}
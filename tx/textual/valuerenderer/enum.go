@@ -0,0 +1,45 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumValueRenderer implements ValueRenderer for enums, which are rendered
+// as the name of their value, e.g. "VOTE_OPTION_YES".
+type enumValueRenderer struct {
+	ed protoreflect.EnumDescriptor
+}
+
+var _ ValueRenderer = enumValueRenderer{}
+
+// NewEnumValueRenderer returns a ValueRenderer for the values of the given
+// enum.
+func NewEnumValueRenderer(ed protoreflect.EnumDescriptor) ValueRenderer {
+	return enumValueRenderer{ed: ed}
+}
+
+func (vr enumValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	evd := vr.ed.Values().ByNumber(v.Enum())
+	if evd == nil {
+		return nil, fmt.Errorf("cannot format value %d of enum %s", v.Enum(), vr.ed.FullName())
+	}
+
+	return []Screen{{Text: string(evd.Name())}}, nil
+}
+
+func (vr enumValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	evd := vr.ed.Values().ByName(protoreflect.Name(text))
+	if evd == nil {
+		return protoreflect.Value{}, fmt.Errorf("%q is not a value of enum %s", text, vr.ed.FullName())
+	}
+
+	return protoreflect.ValueOfEnum(evd.Number()), nil
+}
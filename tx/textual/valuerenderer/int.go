@@ -3,28 +3,56 @@ package valuerenderer
 import (
 	"context"
 	"fmt"
-	"io"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-type intValueRenderer struct{}
+// intValueRenderer implements ValueRenderer for integers of the given kind,
+// integers encoded as strings, e.g. sdk.Int, having no kind.
+type intValueRenderer struct {
+	kind protoreflect.Kind
+}
 
 var _ ValueRenderer = intValueRenderer{}
 
-func (vr intValueRenderer) Format(_ context.Context, v protoreflect.Value, w io.Writer) error {
+func (vr intValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
 	formatted, err := formatInteger(v.String())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = io.WriteString(w, formatted)
-	return err
+	return []Screen{{Text: formatted}}, nil
 }
 
-func (vr intValueRenderer) Parse(_ context.Context, r io.Reader) (protoreflect.Value, error) {
-	panic("implement me")
+func (vr intValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	v, err := parseInteger(text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	switch vr.kind {
+	case protoreflect.Uint32Kind:
+		i, err := strconv.ParseUint(v, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(i)), err
+	case protoreflect.Uint64Kind:
+		i, err := strconv.ParseUint(v, 10, 64)
+		return protoreflect.ValueOfUint64(i), err
+	case protoreflect.Int32Kind:
+		i, err := strconv.ParseInt(v, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return protoreflect.ValueOfInt64(i), err
+	default:
+		return protoreflect.ValueOfString(v), nil
+	}
 }
 
 func hasOnlyDigits(s string) bool {
@@ -65,3 +93,23 @@ func formatInteger(v string) (string, error) {
 
 	return sign + v, nil
 }
+
+// parseInteger is the inverse of formatInteger, it removes the thousand
+// separators of a value-rendered integer.
+func parseInteger(v string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign = "-"
+		v = v[1:]
+	}
+
+	// Thousand separators are only valid between groups of three digits.
+	groups := strings.Split(v, thousandSeparator)
+	for i, group := range groups {
+		if !hasOnlyDigits(group) || (i > 0 && len(group) != 3) || (len(groups) > 1 && len(groups[0]) > 3) {
+			return "", fmt.Errorf("invalid integer %q", sign+v)
+		}
+	}
+
+	return sign + strings.Join(groups, ""), nil
+}
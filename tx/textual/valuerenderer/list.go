@@ -0,0 +1,46 @@
+package valuerenderer
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// valueList is the protoreflect.List returned when parsing repeated fields.
+// It is not bound to a message, its values being appended to the list of the
+// field by the renderer of the message.
+type valueList struct {
+	values []protoreflect.Value
+}
+
+var _ protoreflect.List = (*valueList)(nil)
+
+func (l *valueList) Len() int {
+	return len(l.values)
+}
+
+func (l *valueList) Get(i int) protoreflect.Value {
+	return l.values[i]
+}
+
+func (l *valueList) Set(i int, v protoreflect.Value) {
+	l.values[i] = v
+}
+
+func (l *valueList) Append(v protoreflect.Value) {
+	l.values = append(l.values, v)
+}
+
+func (l *valueList) AppendMutable() protoreflect.Value {
+	panic("valueList does not support mutable values")
+}
+
+func (l *valueList) Truncate(n int) {
+	l.values = l.values[:n]
+}
+
+func (l *valueList) NewElement() protoreflect.Value {
+	panic("valueList does not support creating elements")
+}
+
+func (l *valueList) IsValid() bool {
+	return true
+}
@@ -0,0 +1,168 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// messageValueRenderer is the default ValueRenderer for messages which have
+// no dedicated one. A message is rendered as a header screen followed by one
+// screen per populated field, in field declaration order, each screen of a
+// field being indented once more than the message itself.
+type messageValueRenderer struct {
+	tr      Textual
+	msgDesc protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = messageValueRenderer{}
+
+// NewMessageValueRenderer returns a ValueRenderer for the messages of the
+// given type, rendering their fields with the value renderers of the given
+// Textual.
+func NewMessageValueRenderer(t Textual, msgDesc protoreflect.MessageDescriptor) ValueRenderer {
+	return messageValueRenderer{tr: t, msgDesc: msgDesc}
+}
+
+func (mr messageValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+	if msg.Descriptor().FullName() != mr.msgDesc.FullName() {
+		return nil, fmt.Errorf("expected %s, got %s", mr.msgDesc.FullName(), msg.Descriptor().FullName())
+	}
+
+	screens := []Screen{{Text: messageHeader(mr.msgDesc)}}
+
+	fields := mr.msgDesc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !msg.Has(fd) {
+			continue
+		}
+
+		vr, err := mr.tr.GetValueRenderer(fd)
+		if err != nil {
+			return nil, err
+		}
+
+		subscreens, err := vr.Format(ctx, msg.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		if len(subscreens) == 0 {
+			return nil, fmt.Errorf("got no screens for field %s", fd.FullName())
+		}
+
+		// The field name is prepended to the first screen of its value.
+		first := subscreens[0]
+		screens = append(screens, Screen{
+			Text:   fmt.Sprintf("%s: %s", formatFieldName(fd.Name()), first.Text),
+			Indent: first.Indent + 1,
+			Expert: first.Expert,
+		})
+		for _, subscreen := range subscreens[1:] {
+			subscreen.Indent++
+			screens = append(screens, subscreen)
+		}
+	}
+
+	return screens, nil
+}
+
+func (mr messageValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || screens[0].Indent != 0 || screens[0].Text != messageHeader(mr.msgDesc) {
+		return protoreflect.Value{}, fmt.Errorf("expected %q as the first screen", messageHeader(mr.msgDesc))
+	}
+
+	msg := mr.tr.newMessage(mr.msgDesc)
+	fields := mr.msgDesc.Fields()
+	nextField := 0
+	for i := 1; i < len(screens); {
+		if screens[i].Indent != 1 {
+			return protoreflect.Value{}, fmt.Errorf("unexpected indentation of screen %q", screens[i].Text)
+		}
+
+		// Fields are rendered in declaration order, the field of the screen
+		// is thus one of those following the previous field.
+		var fd protoreflect.FieldDescriptor
+		var prefix string
+		for ; nextField < fields.Len(); nextField++ {
+			f := fields.Get(nextField)
+			prefix = formatFieldName(f.Name()) + ": "
+			if strings.HasPrefix(screens[i].Text, prefix) {
+				fd = f
+				nextField++
+				break
+			}
+		}
+		if fd == nil {
+			return protoreflect.Value{}, fmt.Errorf("screen %q does not match a field of %s", screens[i].Text, mr.msgDesc.FullName())
+		}
+
+		vr, err := mr.tr.GetValueRenderer(fd)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+
+		// The screens of the field are those up to the next screen which is
+		// not indented more than the field, or up to the end screen of the
+		// field for repeated fields.
+		end := i + 1
+		if _, ok := vr.(repeatedValueRenderer); ok {
+			endText := fmt.Sprintf("End of %s", formatFieldName(fd.Name()))
+			for end < len(screens) && (screens[end].Indent != 1 || screens[end].Text != endText) {
+				end++
+			}
+			if end == len(screens) {
+				return protoreflect.Value{}, fmt.Errorf("expected %q screen", endText)
+			}
+			end++
+		} else {
+			for end < len(screens) && screens[end].Indent > 1 {
+				end++
+			}
+		}
+
+		subscreens := make([]Screen, end-i)
+		for j, screen := range screens[i:end] {
+			screen.Indent--
+			subscreens[j] = screen
+		}
+		subscreens[0].Text = strings.TrimPrefix(subscreens[0].Text, prefix)
+
+		v, err := vr.Parse(ctx, subscreens)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+
+		if fd.IsList() {
+			list, parsed := msg.Mutable(fd).List(), v.List()
+			for j := 0; j < parsed.Len(); j++ {
+				list.Append(parsed.Get(j))
+			}
+		} else {
+			msg.Set(fd, v)
+		}
+
+		i = end
+	}
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// messageHeader returns the header screen text of the messages of the given
+// type.
+func messageHeader(md protoreflect.MessageDescriptor) string {
+	return fmt.Sprintf("%s object", md.Name())
+}
+
+// formatFieldName turns a field name into sentence case, e.g. from_address
+// is rendered as "From address".
+func formatFieldName(name protoreflect.Name) string {
+	s := strings.ReplaceAll(string(name), "_", " ")
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,181 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"testing"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"cosmossdk.io/tx/textual/internal/testpb"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+func TestMessageValueRenderer(t *testing.T) {
+	bar, err := proto.Marshal(&testpb.Bar{BarId: "packed", Data: []byte{1, 2}})
+	require.NoError(t, err)
+	coin, err := proto.Marshal(&basev1beta1.Coin{Denom: "uatom", Amount: "10"})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name    string
+		msg     *testpb.A
+		screens []valuerenderer.Screen
+	}{
+		{
+			"empty",
+			&testpb.A{},
+			[]valuerenderer.Screen{{Text: "A object"}},
+		},
+		{
+			"scalars",
+			&testpb.A{
+				UINT32:    1,
+				UINT64:    2000,
+				INT32:     -3,
+				INT64:     -4000,
+				SDKINT:    "5000",
+				BYTES:     []byte{0xab},
+				TIMESTAMP: &tspb.Timestamp{Seconds: 1136214245},
+				ENUM:      testpb.Enumeration_Two,
+			},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "UINT32: 1", Indent: 1},
+				{Text: "UINT64: 2'000", Indent: 1},
+				{Text: "INT32: -3", Indent: 1},
+				{Text: "INT64: -4'000", Indent: 1},
+				{Text: "SDKINT: 5'000", Indent: 1},
+				{Text: "BYTES: AB", Indent: 1},
+				{Text: "TIMESTAMP: 2006-01-02T15:04:05Z", Indent: 1},
+				{Text: "ENUM: Two", Indent: 1},
+			},
+		},
+		{
+			"coins",
+			&testpb.A{
+				COIN:  &basev1beta1.Coin{Denom: "uatom", Amount: "1000000"},
+				COINS: []*basev1beta1.Coin{{Denom: "stake", Amount: "1"}, {Denom: "uatom", Amount: "10"}},
+			},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "COIN: 1 ATOM", Indent: 1},
+				{Text: "COINS: 1 stake, 0.00001 ATOM", Indent: 1},
+			},
+		},
+		{
+			"repeated",
+			&testpb.A{UINT64S: []uint64{1, 20000}},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "UINT64S: 2 uint64", Indent: 1},
+				{Text: "UINT64S (1/2): 1", Indent: 1},
+				{Text: "UINT64S (2/2): 20'000", Indent: 1},
+				{Text: "End of UINT64S", Indent: 1},
+			},
+		},
+		{
+			"nested messages",
+			&testpb.A{FOO: &testpb.Foo{
+				FullName: "foo",
+				Bar:      &testpb.Bar{BarId: "bar"},
+				Bars:     []*testpb.Bar{{BarId: "bar1"}, {Data: []byte{1}}},
+			}},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "FOO: Foo object", Indent: 1},
+				{Text: "Full name: foo", Indent: 2},
+				{Text: "Bar: Bar object", Indent: 2},
+				{Text: "Bar id: bar", Indent: 3},
+				{Text: "Bars: 2 Bar", Indent: 2},
+				{Text: "Bars (1/2): Bar object", Indent: 2},
+				{Text: "Bar id: bar1", Indent: 3},
+				{Text: "Bars (2/2): Bar object", Indent: 2},
+				{Text: "Data: 01", Indent: 3},
+				{Text: "End of Bars", Indent: 2},
+			},
+		},
+		{
+			"any message",
+			&testpb.A{ANY: &anypb.Any{TypeUrl: "/Bar", Value: bar}},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "ANY: /Bar", Indent: 1},
+				{Text: "Bar id: packed", Indent: 2},
+				{Text: "Data: 0102", Indent: 2},
+			},
+		},
+		{
+			"any with dedicated renderer",
+			&testpb.A{ANY: &anypb.Any{TypeUrl: "/cosmos.base.v1beta1.Coin", Value: coin}},
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "ANY: /cosmos.base.v1beta1.Coin", Indent: 1},
+				{Text: "0.00001 ATOM", Indent: 2},
+			},
+		},
+	}
+
+	tr := valuerenderer.NewTextual(queryCoinMetadata)
+	vr := valuerenderer.NewMessageValueRenderer(tr, (&testpb.A{}).ProtoReflect().Descriptor())
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(tc.msg.ProtoReflect()))
+			require.NoError(t, err)
+			require.Equal(t, tc.screens, screens)
+
+			v, err := vr.Parse(ctx, screens)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tc.msg, v.Message().Interface()), "got %v", v.Message().Interface())
+		})
+	}
+}
+
+func TestMessageValueRendererParseErrors(t *testing.T) {
+	tr := valuerenderer.NewTextual(nil)
+	vr := valuerenderer.NewMessageValueRenderer(tr, (&testpb.A{}).ProtoReflect().Descriptor())
+
+	testcases := []struct {
+		name    string
+		screens []valuerenderer.Screen
+	}{
+		{"no screens", nil},
+		{"bad header", []valuerenderer.Screen{{Text: "B object"}}},
+		{"unknown field", []valuerenderer.Screen{{Text: "A object"}, {Text: "FOOBAR: 1", Indent: 1}}},
+		{"bad indentation", []valuerenderer.Screen{{Text: "A object"}, {Text: "UINT32: 1", Indent: 2}}},
+		{
+			"fields out of order",
+			[]valuerenderer.Screen{{Text: "A object"}, {Text: "UINT64: 1", Indent: 1}, {Text: "UINT32: 1", Indent: 1}},
+		},
+		{
+			"missing end of repeated field",
+			[]valuerenderer.Screen{{Text: "A object"}, {Text: "UINT64S: 1 uint64", Indent: 1}, {Text: "UINT64S (1/1): 1", Indent: 1}},
+		},
+		{
+			"wrong number of elements",
+			[]valuerenderer.Screen{
+				{Text: "A object"},
+				{Text: "UINT64S: 2 uint64", Indent: 1},
+				{Text: "UINT64S (1/2): 1", Indent: 1},
+				{Text: "End of UINT64S", Indent: 1},
+			},
+		},
+		{"unknown enum value", []valuerenderer.Screen{{Text: "A object"}, {Text: "ENUM: Three", Indent: 1}}},
+		{"unresolvable any", []valuerenderer.Screen{{Text: "A object"}, {Text: "ANY: /unknown.Msg", Indent: 1}}},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := vr.Parse(context.Background(), tc.screens)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// repeatedValueRenderer is the ValueRenderer for repeated fields. A list is
+// rendered as a header screen with its length and element type, followed by
+// the screens of each element, the first of them being prefixed with the
+// field name and the element index, and a closing screen:
+//
+//	2 Bar
+//	Bars (1/2): Bar object
+//	> Bar id: 1
+//	Bars (2/2): Bar object
+//	> Bar id: 2
+//	End of Bars
+type repeatedValueRenderer struct {
+	tr Textual
+	fd protoreflect.FieldDescriptor
+}
+
+var _ ValueRenderer = repeatedValueRenderer{}
+
+// NewRepeatedValueRenderer returns a ValueRenderer for the given repeated
+// field, rendering its elements with the value renderers of the given
+// Textual.
+func NewRepeatedValueRenderer(t Textual, fd protoreflect.FieldDescriptor) ValueRenderer {
+	return repeatedValueRenderer{tr: t, fd: fd}
+}
+
+func (vr repeatedValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	evr, err := vr.tr.singularValueRenderer(vr.fd)
+	if err != nil {
+		return nil, err
+	}
+
+	list := v.List()
+	n := list.Len()
+	screens := []Screen{{Text: fmt.Sprintf("%d %s", n, vr.elementTypeName())}}
+	for i := 0; i < n; i++ {
+		subscreens, err := evr.Format(ctx, list.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		if len(subscreens) == 0 {
+			return nil, fmt.Errorf("got no screens for element %d of field %s", i, vr.fd.FullName())
+		}
+
+		subscreens[0].Text = fmt.Sprintf("%s: %s", vr.elementTitle(i+1, n), subscreens[0].Text)
+		screens = append(screens, subscreens...)
+	}
+	screens = append(screens, Screen{Text: vr.endText()})
+
+	return screens, nil
+}
+
+func (vr repeatedValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	evr, err := vr.tr.singularValueRenderer(vr.fd)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	if len(screens) < 2 || screens[0].Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected a header and an end screen for field %s", vr.fd.FullName())
+	}
+
+	var n int
+	suffix := " " + vr.elementTypeName()
+	if !strings.HasSuffix(screens[0].Text, suffix) {
+		return protoreflect.Value{}, fmt.Errorf("invalid header %q for field %s", screens[0].Text, vr.fd.FullName())
+	}
+	if _, err := fmt.Sscanf(strings.TrimSuffix(screens[0].Text, suffix), "%d", &n); err != nil {
+		return protoreflect.Value{}, fmt.Errorf("invalid header %q for field %s: %w", screens[0].Text, vr.fd.FullName(), err)
+	}
+
+	list := &valueList{}
+	i := 1
+	for elem := 1; elem <= n; elem++ {
+		prefix := vr.elementTitle(elem, n) + ": "
+		if i >= len(screens) || screens[i].Indent != 0 || !strings.HasPrefix(screens[i].Text, prefix) {
+			return protoreflect.Value{}, fmt.Errorf("expected element %d of field %s", elem, vr.fd.FullName())
+		}
+
+		// The screens of an element are those up to the next screen which
+		// is not indented.
+		end := i + 1
+		for end < len(screens) && screens[end].Indent > 0 {
+			end++
+		}
+
+		subscreens := make([]Screen, end-i)
+		copy(subscreens, screens[i:end])
+		subscreens[0].Text = strings.TrimPrefix(subscreens[0].Text, prefix)
+
+		v, err := evr.Parse(ctx, subscreens)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		list.Append(v)
+
+		i = end
+	}
+
+	if i != len(screens)-1 || screens[i].Indent != 0 || screens[i].Text != vr.endText() {
+		return protoreflect.Value{}, fmt.Errorf("expected %q as the last screen of field %s", vr.endText(), vr.fd.FullName())
+	}
+
+	return protoreflect.ValueOfList(list), nil
+}
+
+// elementTypeName returns the name of the type of the elements of the list,
+// e.g. the message name for messages.
+func (vr repeatedValueRenderer) elementTypeName() string {
+	switch vr.fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(vr.fd.Message().Name())
+	case protoreflect.EnumKind:
+		return string(vr.fd.Enum().Name())
+	default:
+		return vr.fd.Kind().String()
+	}
+}
+
+func (vr repeatedValueRenderer) elementTitle(i, n int) string {
+	return fmt.Sprintf("%s (%d/%d)", formatFieldName(vr.fd.Name()), i, n)
+}
+
+func (vr repeatedValueRenderer) endText() string {
+	return fmt.Sprintf("End of %s", formatFieldName(vr.fd.Name()))
+}
@@ -3,7 +3,6 @@ package valuerenderer
 import (
 	"context"
 	"fmt"
-	"io"
 	"time"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -20,31 +19,28 @@ func NewTimestampValueRenderer() ValueRenderer {
 }
 
 // Format implements the ValueRenderer interface.
-func (vr timestampValueRenderer) Format(_ context.Context, v protoreflect.Value, w io.Writer) error {
+func (vr timestampValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
 	// Reify the reflected message as a proto Timestamp
-	msg := v.Message().Interface()
-	timestamp, ok := msg.(*tspb.Timestamp)
-	if !ok {
-		return fmt.Errorf("expected Timestamp, got %T", msg)
+	timestamp, err := toTimestamp(v.Message())
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert proto timestamp to a Go Time.
 	t := timestamp.AsTime()
 
 	// Format the Go Time as RFC 3339.
-	s := t.Format(time.RFC3339Nano)
-	w.Write([]byte(s))
-	return nil
+	return []Screen{{Text: t.Format(time.RFC3339Nano)}}, nil
 }
 
 // Parse implements the ValueRenderer interface.
-func (vr timestampValueRenderer) Parse(_ context.Context, r io.Reader) (protoreflect.Value, error) {
+func (vr timestampValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
 	// Parse the RFC 3339 input as a Go Time.
-	bz, err := io.ReadAll(r)
+	text, err := singleScreenText(screens)
 	if err != nil {
 		return protoreflect.Value{}, err
 	}
-	t, err := time.Parse(time.RFC3339Nano, string(bz))
+	t, err := time.Parse(time.RFC3339Nano, text)
 	if err != nil {
 		return protoreflect.Value{}, err
 	}
@@ -56,3 +52,21 @@ func (vr timestampValueRenderer) Parse(_ context.Context, r io.Reader) (protoref
 	msg := timestamp.ProtoReflect()
 	return protoreflect.ValueOfMessage(msg), nil
 }
+
+// toTimestamp returns the Timestamp held by a reflected message, which may
+// also be a dynamic message, e.g. one resolved at signing time.
+func toTimestamp(msg protoreflect.Message) (*tspb.Timestamp, error) {
+	if timestamp, ok := msg.Interface().(*tspb.Timestamp); ok {
+		return timestamp, nil
+	}
+
+	desc := msg.Descriptor()
+	if desc.FullName() != "google.protobuf.Timestamp" {
+		return nil, fmt.Errorf("expected Timestamp, got %s", desc.FullName())
+	}
+
+	return &tspb.Timestamp{
+		Seconds: msg.Get(desc.Fields().ByName("seconds")).Int(),
+		Nanos:   int32(msg.Get(desc.Fields().ByName("nanos")).Int()),
+	}, nil
+}
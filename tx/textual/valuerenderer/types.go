@@ -2,11 +2,26 @@ package valuerenderer
 
 import (
 	"context"
-	"io"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// Screen is the abstract unit of SIGN_MODE_TEXTUAL rendering, i.e. what is
+// displayed to the user at once, for example on a hardware wallet.
+type Screen struct {
+	// Text is the text to display, a sequence of Unicode code points.
+	Text string
+
+	// Indent is the indentation level of the screen, zero indicating the top
+	// level. It lets nested values, e.g. the fields of a message, be told
+	// apart from their parent.
+	Indent int
+
+	// Expert indicates that the screen should only be displayed when the user
+	// opts in to see all the details of the transaction.
+	Expert bool
+}
+
 // ValueRenderer defines an interface to produce formatted output for all
 // protobuf types as well as parse a string into those protobuf types.
 //
@@ -15,6 +30,11 @@ import (
 // here, so that optionally more value renderers could be built, for example, a
 // separate one for a different language.
 type ValueRenderer interface {
-	Format(context.Context, protoreflect.Value, io.Writer) error
-	Parse(context.Context, io.Reader) (protoreflect.Value, error)
+	// Format renders the value into screens. Screens of nested values are
+	// indented relative to the value itself, the first screen having an
+	// indentation of zero.
+	Format(context.Context, protoreflect.Value) ([]Screen, error)
+
+	// Parse is the inverse of Format.
+	Parse(context.Context, []Screen) (protoreflect.Value, error)
 }
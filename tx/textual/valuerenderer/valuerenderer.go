@@ -1,31 +1,70 @@
 package valuerenderer
 
 import (
+	"context"
 	"fmt"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	cosmos_proto "github.com/cosmos/cosmos-proto"
 )
 
+const (
+	coinFullName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+	anyFullName  protoreflect.FullName = "google.protobuf.Any"
+)
+
+// CoinMetadataQueryFn returns the x/bank denom metadata of the given denom,
+// i.e. the metadata whose base denom is the given denom. It returns nil
+// metadata and no error if the denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error)
+
 // Textual holds the configuration for dispatching
 // to specific value renderers for SIGN_MODE_TEXTUAL.
 type Textual struct {
-	scalars  map[string]ValueRenderer
-	messages map[protoreflect.FullName]ValueRenderer
+	// coinMetadata is used to render coins in their display denom, coins are
+	// rendered in their own denom if its querier is nil.
+	coinMetadata *coinMetadataResolver
+	// typeResolver resolves the types of Any values and of parsed messages.
+	typeResolver protoregistry.MessageTypeResolver
+	scalars      map[string]ValueRenderer
+	messages     map[protoreflect.FullName]ValueRenderer
 }
 
-// NewTextual returns a new Textual which provides
-// value renderers.
-func NewTextual() Textual {
-	t := Textual{}
+// NewTextual returns a new Textual which provides value renderers. Coins are
+// rendered in the display denom of their metadata, as returned by the given
+// querier, which may be nil.
+func NewTextual(q CoinMetadataQueryFn) Textual {
+	t := Textual{coinMetadata: &coinMetadataResolver{q: q}}
 	t.init()
 	return t
 }
 
 // GetValueRenderer returns the value renderer for the given FieldDescriptor.
 func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
+	switch {
+	case fd.IsMap():
+		return nil, fmt.Errorf("value renderers cannot format map field %s", fd.FullName())
+
+	// Repeated fields, coins being rendered as a single list.
+	case fd.IsList() && fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == coinFullName:
+		return coinsValueRenderer{m: r.coinMetadata}, nil
+
+	case fd.IsList():
+		return NewRepeatedValueRenderer(r, fd), nil
+
+	default:
+		return r.singularValueRenderer(fd)
+	}
+}
+
+// singularValueRenderer returns the value renderer for a single value of the
+// given field, ignoring whether the field is repeated.
+func (r Textual) singularValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
 	switch {
 	// Scalars, such as sdk.Int and sdk.Dec encoded as strings.
 	case fd.Kind() == protoreflect.StringKind && proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar) != "":
@@ -42,6 +81,9 @@ func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRendere
 
 			return vr, nil
 		}
+	case fd.Kind() == protoreflect.StringKind:
+		return stringValueRenderer{}, nil
+
 	case fd.Kind() == protoreflect.BytesKind:
 		return bytesValueRenderer{}, nil
 
@@ -51,39 +93,85 @@ func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRendere
 		fd.Kind() == protoreflect.Int32Kind ||
 		fd.Kind() == protoreflect.Int64Kind:
 		{
-			return intValueRenderer{}, nil
+			return intValueRenderer{kind: fd.Kind()}, nil
 		}
 
-	case fd.Kind() == protoreflect.MessageKind:
-		md := fd.Message()
-		fullName := md.FullName()
+	case fd.Kind() == protoreflect.EnumKind:
+		return NewEnumValueRenderer(fd.Enum()), nil
 
-		vr, found := r.messages[fullName]
-		if found {
-			return vr, nil
-		}
-		// TODO default message renderer
-		return nil, fmt.Errorf("no value renderer for message %s", fullName)
+	case fd.Kind() == protoreflect.MessageKind:
+		return r.getMessageValueRenderer(fd.Message()), nil
 
 	default:
 		return nil, fmt.Errorf("value renderers cannot format value of type %s", fd.Kind())
 	}
 }
 
+// getMessageValueRenderer returns the value renderer for the messages of the
+// given type, i.e. either a dedicated one or the default message renderer.
+func (r Textual) getMessageValueRenderer(md protoreflect.MessageDescriptor) ValueRenderer {
+	if vr, found := r.messages[md.FullName()]; found {
+		return vr
+	}
+	if md.FullName() == anyFullName {
+		return NewAnyValueRenderer(r)
+	}
+
+	return NewMessageValueRenderer(r, md)
+}
+
+// resolver returns the type resolver of Textual, defaulting to the global
+// registry.
+func (r Textual) resolver() protoregistry.MessageTypeResolver {
+	if r.typeResolver == nil {
+		return protoregistry.GlobalTypes
+	}
+
+	return r.typeResolver
+}
+
+// newMessage returns a new message of the given type, built from the
+// resolved Go type if any, and as a dynamic message otherwise.
+func (r Textual) newMessage(md protoreflect.MessageDescriptor) protoreflect.Message {
+	if typ, err := r.resolver().FindMessageByName(md.FullName()); err == nil {
+		return typ.New()
+	}
+
+	return dynamicpb.NewMessage(md)
+}
+
 func (r *Textual) init() {
 	if r.scalars == nil {
 		r.scalars = map[string]ValueRenderer{}
 		r.scalars["cosmos.Int"] = intValueRenderer{}
 		r.scalars["cosmos.Dec"] = decValueRenderer{}
+		r.scalars["cosmos.AddressString"] = stringValueRenderer{}
 	}
 	if r.messages == nil {
 		r.messages = map[protoreflect.FullName]ValueRenderer{}
 		r.messages["google.protobuf.Timestamp"] = NewTimestampValueRenderer()
+		r.messages[coinFullName] = coinValueRenderer{m: r.coinMetadata}
 	}
 }
 
+// SetTypeResolver sets the resolver of the types of Any values, and of the
+// messages being parsed. protoregistry.GlobalTypes is used by default.
+func (r *Textual) SetTypeResolver(typeResolver protoregistry.MessageTypeResolver) {
+	r.typeResolver = typeResolver
+}
+
 // DefineScalar adds a value renderer to the given Cosmos scalar.
 func (r *Textual) DefineScalar(scalar string, vr ValueRenderer) {
 	r.init()
 	r.scalars[scalar] = vr
 }
+
+// singleScreenText returns the text of the single screen a scalar value is
+// rendered into.
+func singleScreenText(screens []Screen) (string, error) {
+	if len(screens) != 1 {
+		return "", fmt.Errorf("expected a single screen, got %d", len(screens))
+	}
+
+	return screens[0].Text, nil
+}
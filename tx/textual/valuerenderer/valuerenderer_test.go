@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,11 +31,11 @@ func TestFormatInteger(t *testing.T) {
 		if err == nil {
 			r, err := valueRendererOf(i)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(i), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(i))
 			require.NoError(t, err)
+			require.Len(t, screens, 1)
 
-			require.Equal(t, tc[1], b.String())
+			require.Equal(t, tc[1], screens[0].Text)
 		}
 
 		// Parse test case strings as protobuf uint32
@@ -44,11 +43,11 @@ func TestFormatInteger(t *testing.T) {
 		if err == nil {
 			r, err := valueRendererOf(i)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(i), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(i))
 			require.NoError(t, err)
+			require.Len(t, screens, 1)
 
-			require.Equal(t, tc[1], b.String())
+			require.Equal(t, tc[1], screens[0].Text)
 		}
 
 		// Parse test case strings as sdk.Ints
@@ -56,11 +55,11 @@ func TestFormatInteger(t *testing.T) {
 		if ok {
 			r, err := valueRendererOf(sdkInt)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(tc[0]), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(tc[0]))
 			require.NoError(t, err)
+			require.Len(t, screens, 1)
 
-			require.Equal(t, tc[1], b.String())
+			require.Equal(t, tc[1], screens[0].Text)
 		}
 	}
 }
@@ -80,11 +79,11 @@ func TestFormatDecimal(t *testing.T) {
 			require.NoError(t, err)
 			r, err := valueRendererOf(d)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(tc[0]), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(tc[0]))
 			require.NoError(t, err)
+			require.Len(t, screens, 1)
 
-			require.Equal(t, tc[1], b.String())
+			require.Equal(t, tc[1], screens[0].Text)
 		})
 	}
 }
@@ -119,7 +118,7 @@ func TestGetADR050ValueRenderer(t *testing.T) {
 
 func TestTimestampDispatch(t *testing.T) {
 	a := (&testpb.A{}).ProtoReflect().Descriptor().Fields()
-	textual := valuerenderer.NewTextual()
+	textual := valuerenderer.NewTextual(nil)
 	rend, err := textual.GetValueRenderer(a.ByName(protoreflect.Name("TIMESTAMP")))
 	require.NoError(t, err)
 	require.IsType(t, valuerenderer.NewTimestampValueRenderer(), rend)
@@ -130,7 +129,7 @@ func TestTimestampDispatch(t *testing.T) {
 func valueRendererOf(v interface{}) (valuerenderer.ValueRenderer, error) {
 	a, b := (&testpb.A{}).ProtoReflect().Descriptor().Fields(), (&testpb.B{}).ProtoReflect().Descriptor().Fields()
 
-	textual := valuerenderer.NewTextual()
+	textual := valuerenderer.NewTextual(nil)
 	switch v := v.(type) {
 	// Valid types for SIGN_MODE_TEXTUAL
 	case uint32:
@@ -19,3 +19,8 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
+
+// ProcessProposalHandler validates the block proposal of a proposer and decides
+// whether it should be accepted or rejected. The provided Context is branched
+// from the latest committed state and is discarded afterwards.
+type ProcessProposalHandler func(ctx Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal
@@ -0,0 +1,107 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the app-side mempool. CheckTx feeds every transaction that
+// passes the AnteHandler into it, DeliverTx removes committed transactions from
+// it, and PrepareProposal uses it to build the block proposal.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the app-side mempool returning
+	// an error upon failure. The priority of the transaction is read from the
+	// provided context, as set by the AnteHandler.
+	Insert(sdk.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the app-side mempool, yielding the
+	// transactions in the order they should be included in a block. The
+	// transactions proposed by Tendermint are passed along for implementations
+	// wishing to take them into account. A nil Iterator is returned when the
+	// mempool is empty.
+	Select(sdk.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning an
+	// error upon failure. ErrTxNotFound is returned if the transaction is not
+	// in the mempool.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an app-side mempool iterator interface that is as minimal as
+// possible. The order of iteration is determined by the app-side mempool
+// implementation.
+type Iterator interface {
+	// Next returns the next transaction from the mempool. If there are no more
+	// transactions, it returns nil.
+	Next() Iterator
+
+	// Tx returns the transaction at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when a transaction is not present in the mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrNoSigners is returned when a transaction without any signature is
+	// inserted in a mempool which orders transactions by sender.
+	ErrNoSigners = errors.New("tx must have at least one signer")
+)
+
+// txSenderNonce returns the first signer of the transaction along with the
+// sequence of its signature. Transactions built through x/auth/tx implement
+// signing.SigVerifiableTx and can therefore be keyed this way.
+func txSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, ErrNoSigners
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
+
+// sliceIterator is an Iterator over a pre-computed, ordered list of
+// transactions.
+type sliceIterator struct {
+	txs []sdk.Tx
+	pos int
+}
+
+var _ Iterator = (*sliceIterator)(nil)
+
+// newSliceIterator returns an Iterator over txs, or nil if txs is empty.
+func newSliceIterator(txs []sdk.Tx) Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return &sliceIterator{txs: txs}
+}
+
+func (it *sliceIterator) Next() Iterator {
+	if it.pos+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &sliceIterator{txs: it.txs, pos: it.pos + 1}
+}
+
+func (it *sliceIterator) Tx() sdk.Tx {
+	return it.txs[it.pos]
+}
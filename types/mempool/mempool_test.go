@@ -0,0 +1,92 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// testTx is a minimal authsigning.SigVerifiableTx with a single signer.
+type testTx struct {
+	id       int
+	priority int64
+	nonce    uint64
+	address  sdk.AccAddress
+}
+
+var _ authsigning.SigVerifiableTx = testTx{}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx testTx) ValidateBasic() error { return nil }
+
+func (tx testTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.address} }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+
+func (tx testTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+// unsignedTx is a SigVerifiableTx without any signature.
+type unsignedTx struct {
+	testTx
+}
+
+func (unsignedTx) GetSignaturesV2() ([]signing.SignatureV2, error) { return nil, nil }
+
+// plainTx is a sdk.Tx not implementing authsigning.SigVerifiableTx.
+type plainTx struct{}
+
+func (plainTx) GetMsgs() []sdk.Msg { return nil }
+
+func (plainTx) ValidateBasic() error { return nil }
+
+func newTestContext() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+}
+
+func fetchTxs(iterator mempool.Iterator) []testTx {
+	var txs []testTx
+	for ; iterator != nil; iterator = iterator.Next() {
+		txs = append(txs, iterator.Tx().(testTx))
+	}
+	return txs
+}
+
+func txIDs(txs []testTx) []int {
+	ids := make([]int, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.id
+	}
+	return ids
+}
+
+func testAddrs(n int) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, n)
+	for i := range addrs {
+		_, _, addrs[i] = testdata.KeyTestPubAddr()
+	}
+	return addrs
+}
+
+func TestTxSenderNonceRequirements(t *testing.T) {
+	ctx := newTestContext()
+	mp := mempool.NewPriorityMempool()
+
+	err := mp.Insert(ctx, unsignedTx{testTx{address: testAddrs(1)[0]}})
+	require.ErrorIs(t, err, mempool.ErrNoSigners)
+
+	err = mp.Insert(ctx, plainTx{})
+	require.Error(t, err)
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -0,0 +1,170 @@
+package mempool
+
+import (
+	"container/heap"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a mempool implementation which orders transactions
+// by their priority, as set by the AnteHandler in CheckTx, while making sure
+// that transactions of the same sender are always selected in increasing
+// sequence (nonce) order. A high priority transaction therefore never overtakes
+// a lower sequence transaction of the same sender.
+//
+// Transactions are keyed by sender and sequence: inserting a transaction with
+// a sender and sequence already present in the mempool replaces the existing
+// one.
+type PriorityNonceMempool struct {
+	senders map[string]map[uint64]*priorityTx
+	count   int
+	// arrival is incremented on every insertion and used to break priority
+	// ties in a deterministic, first-come first-served fashion.
+	arrival uint64
+}
+
+// priorityTx wraps a mempool transaction along with its ordering metadata.
+type priorityTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	arrival  uint64
+}
+
+// NewPriorityMempool returns a new, empty PriorityNonceMempool.
+func NewPriorityMempool() *PriorityNonceMempool {
+	return &PriorityNonceMempool{
+		senders: make(map[string]map[uint64]*priorityTx),
+	}
+}
+
+// Insert inserts a transaction into the mempool using the priority found in
+// the context. The transaction must implement signing.SigVerifiableTx and be
+// signed by at least one signer.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	byNonce, ok := mp.senders[sender]
+	if !ok {
+		byNonce = make(map[uint64]*priorityTx)
+		mp.senders[sender] = byNonce
+	}
+
+	if _, exists := byNonce[nonce]; !exists {
+		mp.count++
+	}
+
+	mp.arrival++
+	byNonce[nonce] = &priorityTx{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+		arrival:  mp.arrival,
+	}
+
+	return nil
+}
+
+// Select returns an Iterator over the mempool transactions ordered by
+// decreasing priority, honoring per-sender sequence ordering. The provided
+// txs are ignored as every transaction proposed by Tendermint has already been
+// inserted through CheckTx.
+func (mp *PriorityNonceMempool) Select(_ sdk.Context, _ [][]byte) Iterator {
+	var (
+		queues = make(map[string][]*priorityTx, len(mp.senders))
+		heads  = make(priorityHeap, 0, len(mp.senders))
+	)
+
+	for sender, byNonce := range mp.senders {
+		queue := make([]*priorityTx, 0, len(byNonce))
+		for _, ptx := range byNonce {
+			queue = append(queue, ptx)
+		}
+		sort.Slice(queue, func(i, j int) bool { return queue[i].nonce < queue[j].nonce })
+
+		queues[sender] = queue[1:]
+		heads = append(heads, queue[0])
+	}
+
+	heap.Init(&heads)
+
+	txs := make([]sdk.Tx, 0, mp.count)
+	for heads.Len() > 0 {
+		next := heap.Pop(&heads).(*priorityTx)
+		txs = append(txs, next.tx)
+
+		if queue := queues[next.sender]; len(queue) > 0 {
+			queues[next.sender] = queue[1:]
+			heap.Push(&heads, queue[0])
+		}
+	}
+
+	return newSliceIterator(txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes the transaction with the same sender and sequence as tx from
+// the mempool, returning ErrTxNotFound if there is none.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	byNonce, ok := mp.senders[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if _, ok := byNonce[nonce]; !ok {
+		return ErrTxNotFound
+	}
+
+	delete(byNonce, nonce)
+	if len(byNonce) == 0 {
+		delete(mp.senders, sender)
+	}
+	mp.count--
+
+	return nil
+}
+
+// priorityHeap is a max-heap of transactions ordered by priority, and by
+// arrival order for transactions of equal priority.
+type priorityHeap []*priorityTx
+
+func (h priorityHeap) Len() int { return len(h) }
+
+func (h priorityHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].arrival < h[j].arrival
+}
+
+func (h priorityHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *priorityHeap) Push(x interface{}) { *h = append(*h, x.(*priorityTx)) }
+
+func (h *priorityHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return item
+}
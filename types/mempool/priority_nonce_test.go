@@ -0,0 +1,93 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestPriorityMempoolOrdering(t *testing.T) {
+	ctx := newTestContext()
+	addrs := testAddrs(3)
+
+	testCases := []struct {
+		name  string
+		txs   []testTx
+		order []int
+	}{
+		{
+			name: "priority order across senders",
+			txs: []testTx{
+				{id: 0, priority: 5, nonce: 0, address: addrs[0]},
+				{id: 1, priority: 20, nonce: 0, address: addrs[1]},
+				{id: 2, priority: 10, nonce: 0, address: addrs[2]},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "nonce order within a sender",
+			txs: []testTx{
+				{id: 0, priority: 5, nonce: 1, address: addrs[0]},
+				{id: 1, priority: 20, nonce: 2, address: addrs[0]},
+				{id: 2, priority: 10, nonce: 0, address: addrs[0]},
+			},
+			order: []int{2, 0, 1},
+		},
+		{
+			name: "high priority tx waits for its lower nonce",
+			txs: []testTx{
+				{id: 0, priority: 1, nonce: 0, address: addrs[0]},
+				{id: 1, priority: 100, nonce: 1, address: addrs[0]},
+				{id: 2, priority: 50, nonce: 0, address: addrs[1]},
+			},
+			order: []int{2, 0, 1},
+		},
+		{
+			name: "equal priorities keep arrival order",
+			txs: []testTx{
+				{id: 0, priority: 10, nonce: 0, address: addrs[2]},
+				{id: 1, priority: 10, nonce: 0, address: addrs[0]},
+				{id: 2, priority: 10, nonce: 0, address: addrs[1]},
+			},
+			order: []int{0, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			for _, tx := range tc.txs {
+				require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+			require.Equal(t, len(tc.txs), mp.CountTx())
+
+			require.Equal(t, tc.order, txIDs(fetchTxs(mp.Select(ctx, nil))))
+		})
+	}
+}
+
+func TestPriorityMempoolReplaceAndRemove(t *testing.T) {
+	ctx := newTestContext()
+	addr := testAddrs(1)[0]
+	mp := mempool.NewPriorityMempool()
+
+	require.Nil(t, mp.Select(ctx, nil))
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{id: 0, nonce: 0, address: addr}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{id: 1, nonce: 1, address: addr}))
+
+	// same sender and nonce replaces the existing tx
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), testTx{id: 2, nonce: 1, address: addr}))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{0, 2}, txIDs(fetchTxs(mp.Select(ctx, nil))))
+
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: addr}))
+	require.Equal(t, 1, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 0, address: addr}), mempool.ErrTxNotFound)
+
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: addr}))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+}
@@ -0,0 +1,146 @@
+package mempool
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*SenderNonceMempool)(nil)
+
+// SenderNonceMempool is a mempool implementation which guarantees per-sender
+// sequence (nonce) ordering: a transaction with sequence N+1 is never selected
+// before the transaction with sequence N of the same sender, and a sender's
+// transactions are only selected up to the first gap in its sequences.
+//
+// Senders are served in a round-robin fashion, one transaction per sender and
+// per round, in the order their pending transactions arrived. Transaction
+// priorities are ignored.
+//
+// Removing a transaction, as done by DeliverTx, also evicts every transaction
+// of the same sender with a lower sequence since those can never be valid
+// anymore.
+type SenderNonceMempool struct {
+	senders map[string]map[uint64]*senderNonceTx
+	count   int
+	arrival uint64
+}
+
+// senderNonceTx wraps a mempool transaction along with its ordering metadata.
+type senderNonceTx struct {
+	tx      sdk.Tx
+	nonce   uint64
+	arrival uint64
+}
+
+// NewSenderNonceMempool returns a new, empty SenderNonceMempool.
+func NewSenderNonceMempool() *SenderNonceMempool {
+	return &SenderNonceMempool{
+		senders: make(map[string]map[uint64]*senderNonceTx),
+	}
+}
+
+// Insert inserts a transaction into the mempool. The transaction must
+// implement signing.SigVerifiableTx and be signed by at least one signer, the
+// first of which is used as the sender. A transaction with the same sender and
+// sequence as an existing one replaces it.
+func (mp *SenderNonceMempool) Insert(_ sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	byNonce, ok := mp.senders[sender]
+	if !ok {
+		byNonce = make(map[uint64]*senderNonceTx)
+		mp.senders[sender] = byNonce
+	}
+
+	if _, exists := byNonce[nonce]; !exists {
+		mp.count++
+	}
+
+	mp.arrival++
+	byNonce[nonce] = &senderNonceTx{tx: tx, nonce: nonce, arrival: mp.arrival}
+
+	return nil
+}
+
+// Select returns an Iterator over the mempool transactions, honoring
+// per-sender sequence ordering. The provided txs are ignored as every
+// transaction proposed by Tendermint has already been inserted through CheckTx.
+func (mp *SenderNonceMempool) Select(_ sdk.Context, _ [][]byte) Iterator {
+	queues := make([][]*senderNonceTx, 0, len(mp.senders))
+	for _, byNonce := range mp.senders {
+		queue := make([]*senderNonceTx, 0, len(byNonce))
+		for _, stx := range byNonce {
+			queue = append(queue, stx)
+		}
+		sort.Slice(queue, func(i, j int) bool { return queue[i].nonce < queue[j].nonce })
+
+		// only keep the contiguous sequences starting from the lowest one
+		end := 1
+		for end < len(queue) && queue[end].nonce == queue[end-1].nonce+1 {
+			end++
+		}
+
+		queues = append(queues, queue[:end])
+	}
+
+	sort.Slice(queues, func(i, j int) bool { return queues[i][0].arrival < queues[j][0].arrival })
+
+	txs := make([]sdk.Tx, 0, mp.count)
+	for round := 0; len(queues) > 0; round++ {
+		remaining := queues[:0]
+		for _, queue := range queues {
+			txs = append(txs, queue[round].tx)
+			if round+1 < len(queue) {
+				remaining = append(remaining, queue)
+			}
+		}
+
+		queues = remaining
+	}
+
+	return newSliceIterator(txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *SenderNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes the transaction with the same sender and sequence as tx from
+// the mempool, along with all the transactions of that sender with a lower,
+// stale, sequence. ErrTxNotFound is returned if no transaction was removed.
+func (mp *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	byNonce, ok := mp.senders[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	removed := 0
+	for n := range byNonce {
+		if n <= nonce {
+			delete(byNonce, n)
+			removed++
+		}
+	}
+
+	if len(byNonce) == 0 {
+		delete(mp.senders, sender)
+	}
+
+	if removed == 0 {
+		return ErrTxNotFound
+	}
+
+	mp.count -= removed
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestSenderNonceMempoolOrdering(t *testing.T) {
+	ctx := newTestContext()
+	addrs := testAddrs(3)
+
+	testCases := []struct {
+		name  string
+		txs   []testTx
+		order []int
+	}{
+		{
+			name: "nonce order within a sender regardless of priority",
+			txs: []testTx{
+				{id: 0, priority: 50, nonce: 2, address: addrs[0]},
+				{id: 1, priority: 20, nonce: 0, address: addrs[0]},
+				{id: 2, priority: 10, nonce: 1, address: addrs[0]},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "senders are served round-robin in arrival order",
+			txs: []testTx{
+				{id: 0, nonce: 0, address: addrs[1]},
+				{id: 1, nonce: 1, address: addrs[1]},
+				{id: 2, nonce: 2, address: addrs[1]},
+				{id: 3, nonce: 5, address: addrs[0]},
+				{id: 4, nonce: 6, address: addrs[0]},
+				{id: 5, nonce: 0, address: addrs[2]},
+			},
+			order: []int{0, 3, 5, 1, 4, 2},
+		},
+		{
+			name: "txs after a sequence gap are not selected",
+			txs: []testTx{
+				{id: 0, nonce: 0, address: addrs[0]},
+				{id: 1, nonce: 1, address: addrs[0]},
+				{id: 2, nonce: 3, address: addrs[0]},
+				{id: 3, nonce: 4, address: addrs[0]},
+			},
+			order: []int{0, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewSenderNonceMempool()
+			for _, tx := range tc.txs {
+				require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+			require.Equal(t, len(tc.txs), mp.CountTx())
+
+			require.Equal(t, tc.order, txIDs(fetchTxs(mp.Select(ctx, nil))))
+		})
+	}
+}
+
+func TestSenderNonceMempoolEvictsStaleSequences(t *testing.T) {
+	ctx := newTestContext()
+	addrs := testAddrs(2)
+	mp := mempool.NewSenderNonceMempool()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{id: i, nonce: uint64(i), address: addrs[0]}))
+	}
+	require.NoError(t, mp.Insert(ctx, testTx{id: 5, nonce: 0, address: addrs[1]}))
+	require.Equal(t, 6, mp.CountTx())
+
+	// delivering nonce 2 evicts nonces 0 to 2 of the same sender only
+	require.NoError(t, mp.Remove(testTx{nonce: 2, address: addrs[0]}))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []int{3, 5, 4}, txIDs(fetchTxs(mp.Select(ctx, nil))))
+
+	// a delivered tx which isn't in the mempool still evicts stale sequences
+	require.NoError(t, mp.Remove(testTx{nonce: 10, address: addrs[0]}))
+	require.Equal(t, 1, mp.CountTx())
+
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 10, address: addrs[0]}), mempool.ErrTxNotFound)
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: addrs[1]}))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+}
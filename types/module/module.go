@@ -30,7 +30,9 @@ package module
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"sort"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -327,6 +329,99 @@ func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) map[string
 	return genesisData
 }
 
+// GenesisSource returns a reader of the genesis state of a module, or a nil
+// reader if the module has no genesis state.
+type GenesisSource func(moduleName string) (io.ReadCloser, error)
+
+// GenesisTarget returns a writer for the genesis state of a module.
+type GenesisTarget func(moduleName string) (io.WriteCloser, error)
+
+// InitGenesisFrom performs init genesis functionality for modules like
+// InitGenesis, reading the genesis state of each module from the source just
+// before initializing it so that the genesis state of a single module is held
+// in memory at a time.
+func (m *Manager) InitGenesisFrom(ctx sdk.Context, cdc codec.JSONCodec, source GenesisSource) (abci.ResponseInitChain, error) {
+	var validatorUpdates []abci.ValidatorUpdate
+	ctx.Logger().Info("initializing blockchain state from streamed genesis")
+	for _, moduleName := range m.OrderInitGenesis {
+		moduleGenesis, err := readModuleGenesis(source, moduleName)
+		if err != nil {
+			return abci.ResponseInitChain{}, err
+		}
+		if moduleGenesis == nil {
+			continue
+		}
+		ctx.Logger().Debug("running initialization for module", "module", moduleName)
+
+		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, cdc, moduleGenesis)
+
+		// use these validator updates if provided, the module manager assumes
+		// only one module will update the validator set
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				return abci.ResponseInitChain{}, errors.New("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+
+	// a chain must initialize with a non-empty validator set
+	if len(validatorUpdates) == 0 {
+		return abci.ResponseInitChain{}, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}, nil
+}
+
+// ExportGenesisTo performs export genesis functionality for modules like
+// ExportGenesis, writing the genesis state of each module to the target as
+// soon as it is exported instead of returning the genesis state of all the
+// modules.
+func (m *Manager) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, target GenesisTarget) error {
+	for _, moduleName := range m.OrderExportGenesis {
+		if err := writeModuleGenesis(target, moduleName, m.Modules[moduleName].ExportGenesis(ctx, cdc)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readModuleGenesis(source GenesisSource, moduleName string) (json.RawMessage, error) {
+	r, err := source(moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the genesis state of module %s: %w", moduleName, err)
+	}
+	if r == nil {
+		return nil, nil
+	}
+	defer r.Close()
+
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the genesis state of module %s: %w", moduleName, err)
+	}
+	return bz, nil
+}
+
+func writeModuleGenesis(target GenesisTarget, moduleName string, moduleGenesis json.RawMessage) error {
+	w, err := target(moduleName)
+	if err != nil {
+		return fmt.Errorf("failed to create the genesis state of module %s: %w", moduleName, err)
+	}
+
+	if _, err := w.Write(moduleGenesis); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("failed to write the genesis state of module %s: %w", moduleName, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write the genesis state of module %s: %w", moduleName, err)
+	}
+	return nil
+}
+
 // assertNoForgottenModules checks that we didn't forget any modules in the
 // SetOrder* functions.
 func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []string) {
@@ -1,8 +1,10 @@
 package module_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -201,6 +203,85 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_InitGenesisFrom(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	genesisData := map[string]json.RawMessage{"module1": json.RawMessage(`{"key": "value"}`)}
+	source := func(moduleName string) (io.ReadCloser, error) {
+		if genesisData[moduleName] == nil {
+			return nil, nil
+		}
+		return io.NopCloser(bytes.NewReader(genesisData[moduleName])), nil
+	}
+
+	// modules without genesis state are skipped
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return([]abci.ValidatorUpdate{{Power: 1}})
+	res, err := mm.InitGenesisFrom(ctx, cdc, source)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{Power: 1}}, res.Validators)
+
+	// the validator set must be set by a single module
+	genesisData["module2"] = json.RawMessage(`{"key": "value"}`)
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module1"])).Times(1).Return([]abci.ValidatorUpdate{{}})
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesisData["module2"])).Times(1).Return([]abci.ValidatorUpdate{{}})
+	_, err = mm.InitGenesisFrom(ctx, cdc, source)
+	require.ErrorContains(t, err, "validator InitGenesis updates already set by a previous module")
+
+	_, err = mm.InitGenesisFrom(ctx, cdc, func(string) (io.ReadCloser, error) { return nil, errors.New("unavailable") })
+	require.EqualError(t, err, "failed to open the genesis state of module module1: unavailable")
+}
+
+type genesisWriter struct {
+	bytes.Buffer
+	moduleName string
+	written    map[string]json.RawMessage
+}
+
+func (w *genesisWriter) Close() error {
+	w.written[w.moduleName] = w.Bytes()
+	return nil
+}
+
+func TestManager_ExportGenesisTo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.Context{}
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1": "value1"}`))
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2": "value2"}`))
+
+	written := map[string]json.RawMessage{}
+	require.NoError(t, mm.ExportGenesisTo(ctx, cdc, func(moduleName string) (io.WriteCloser, error) {
+		return &genesisWriter{moduleName: moduleName, written: written}, nil
+	}))
+
+	want := map[string]json.RawMessage{
+		"module1": json.RawMessage(`{"key1": "value1"}`),
+		"module2": json.RawMessage(`{"key2": "value2"}`),
+	}
+	require.Equal(t, want, written)
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
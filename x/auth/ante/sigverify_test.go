@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -17,7 +18,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/stretchr/testify/require"
 )
 
@@ -295,6 +298,76 @@ func TestSigVerification_ExplicitAmino(t *testing.T) {
 	}
 }
 
+func TestSigVerification_Textual(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(t, acc.SetAccountNumber(1))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// The message only holds fields SIGN_MODE_TEXTUAL can render.
+	msg := &feegrant.MsgRevokeAllowance{Granter: addr1.String(), Grantee: addr2.String()}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		accNum    uint64
+		accSeq    uint64
+		chainID   string
+		shouldErr bool
+	}{
+		{"valid tx", 1, 0, suite.ctx.ChainID(), false},
+		{"wrong accnum", 2, 0, suite.ctx.ChainID(), true},
+		{"wrong sequence", 1, 1, suite.ctx.ChainID(), true},
+		{"wrong chain id", 1, 0, "other-chain", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(msg))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			// The signer info must be set before signing, as it is signed over.
+			sigV2 := signing.SignatureV2{
+				PubKey:   priv1.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL},
+				Sequence: tc.accSeq,
+			}
+			require.NoError(t, txBuilder.SetSignatures(sigV2))
+
+			signerData := authsigning.SignerData{
+				Address:       addr1.String(),
+				ChainID:       tc.chainID,
+				AccountNumber: tc.accNum,
+				Sequence:      tc.accSeq,
+				PubKey:        priv1.PubKey(),
+			}
+			sigV2, err := clienttx.SignWithPrivKey(
+				signing.SignMode_SIGN_MODE_TEXTUAL, signerData,
+				txBuilder, priv1, suite.clientCtx.TxConfig, tc.accSeq)
+			require.NoError(t, err)
+			require.NoError(t, txBuilder.SetSignatures(sigV2))
+
+			_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -3,6 +3,8 @@ package tx
 import (
 	"fmt"
 
+	"cosmossdk.io/tx/textual/valuerenderer"
+
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -26,7 +28,14 @@ type config struct {
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
 // is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, nil))
+}
+
+// NewTxConfigWithTextual is NewTxConfig with SIGN_MODE_TEXTUAL rendering coins
+// with the denom metadata returned by q, see NewBankKeeperCoinMetadataQueryFn
+// and NewGRPCCoinMetadataQueryFn.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, q valuerenderer.CoinMetadataQueryFn) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, q))
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
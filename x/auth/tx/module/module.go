@@ -44,6 +44,10 @@ type txOutputs struct {
 
 func provideModule(in txInputs) txOutputs {
 	txConfig := tx.NewTxConfig(in.ProtoCodecMarshaler, tx.DefaultSignModes)
+	// SIGN_MODE_TEXTUAL renders coins with the denom metadata of x/bank
+	if bk, ok := in.BankKeeper.(tx.BankKeeper); ok {
+		txConfig = tx.NewTxConfigWithTextual(in.ProtoCodecMarshaler, tx.DefaultSignModes, tx.NewBankKeeperCoinMetadataQueryFn(bk))
+	}
 
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
@@ -79,8 +83,9 @@ func provideModule(in txInputs) txOutputs {
 			app.SetPostHandler(postHandler)
 		}
 
-		// TxDecoder
+		// TxDecoder/TxEncoder
 		app.SetTxDecoder(txConfig.TxDecoder())
+		app.SetTxEncoder(txConfig.TxEncoder())
 	}
 
 	return txOutputs{TxConfig: txConfig, BaseAppOption: baseAppOption}
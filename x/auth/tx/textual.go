@@ -0,0 +1,97 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/tx/textual"
+	"cosmossdk.io/tx/textual/valuerenderer"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. It
+// adapts protobuf transactions to the textual.SignModeHandler, which works
+// with the cosmossdk.io/api transaction types.
+type signModeTextualHandler struct {
+	t textual.SignModeHandler
+}
+
+// newSignModeTextualHandler returns a signModeTextualHandler rendering coins
+// with the denom metadata returned by q. Coins are rendered in their own
+// denom if q is nil.
+func newSignModeTextualHandler(q valuerenderer.CoinMetadataQueryFn) signModeTextualHandler {
+	tr := valuerenderer.NewTextual(q)
+	tr.SetTypeResolver(newTextualTypeResolver())
+
+	return signModeTextualHandler{t: textual.NewSignModeHandler(tr)}
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	bodyBz := protoTx.getBodyBytes()
+	body := &txv1beta1.TxBody{}
+	if err := protov2.Unmarshal(bodyBz, body); err != nil {
+		return nil, err
+	}
+
+	authInfoBz := protoTx.getAuthInfoBytes()
+	authInfo := &txv1beta1.AuthInfo{}
+	if err := protov2.Unmarshal(authInfoBz, authInfo); err != nil {
+		return nil, err
+	}
+
+	var pubKey *anypb.Any
+	if data.PubKey != nil {
+		pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pubKey = &anypb.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}
+	}
+
+	return h.t.GetSignBytes(ctx, textual.SignerData{
+		Address:       data.Address,
+		ChainID:       data.ChainID,
+		AccountNumber: data.AccountNumber,
+		Sequence:      data.Sequence,
+		PubKey:        pubKey,
+	}, textual.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	})
+}
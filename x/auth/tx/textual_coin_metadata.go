@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/tx/textual/valuerenderer"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the bank keeper methods SIGN_MODE_TEXTUAL needs to
+// render coins.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading the
+// denom metadata from the bank keeper. It must be given a context wrapping an
+// sdk.Context, which is the case when verifying signatures in the ante handler.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) valuerenderer.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("expected a context wrapping an sdk.Context to query the metadata of %s", denom)
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return toAPIMetadata(metadata)
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the denom
+// metadata with the x/bank DenomMetadata gRPC query, e.g. through a
+// client.Context when signing transactions.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) valuerenderer.CoinMetadataQueryFn {
+	queryClient := banktypes.NewQueryClient(conn)
+
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := queryClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return toAPIMetadata(res.Metadata)
+	}
+}
+
+// NewClientCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the
+// denom metadata through the client.Context returned by getClientCtx when
+// signing, so that the node and offline flags of the command are taken into
+// account. The metadata can't be queried in offline mode, where it fails.
+func NewClientCoinMetadataQueryFn(getClientCtx func() (client.Context, error)) valuerenderer.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		clientCtx, err := getClientCtx()
+		if err != nil {
+			return nil, err
+		}
+
+		if clientCtx.Offline {
+			return nil, fmt.Errorf("cannot query the metadata of %s in offline mode, which SIGN_MODE_TEXTUAL requires to render coins: use another sign mode", denom)
+		}
+
+		return NewGRPCCoinMetadataQueryFn(clientCtx)(ctx, denom)
+	}
+}
+
+// toAPIMetadata converts the x/bank metadata to the cosmossdk.io/api type the
+// value renderers use.
+func toAPIMetadata(metadata banktypes.Metadata) (*bankv1beta1.Metadata, error) {
+	bz, err := metadata.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	apiMetadata := &bankv1beta1.Metadata{}
+	if err := protov2.Unmarshal(bz, apiMetadata); err != nil {
+		return nil, err
+	}
+
+	return apiMetadata, nil
+}
@@ -0,0 +1,186 @@
+package tx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// textualTypeResolver resolves the types of the Any values of transactions,
+// e.g. messages, for SIGN_MODE_TEXTUAL. Types registered in the global
+// protobuf registry, e.g. the cosmossdk.io/api ones, are used when present.
+// Other types are built as dynamic types from the file descriptors which
+// gogoproto registers for the types of the SDK and its modules.
+type textualTypeResolver struct {
+	mu    sync.Mutex
+	files *protoregistry.Files
+}
+
+var _ protoregistry.MessageTypeResolver = (*textualTypeResolver)(nil)
+
+func newTextualTypeResolver() *textualTypeResolver {
+	return &textualTypeResolver{files: new(protoregistry.Files)}
+}
+
+// FindMessageByName implements protoregistry.MessageTypeResolver.
+func (r *textualTypeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if typ, err := protoregistry.GlobalTypes.FindMessageByName(name); err == nil {
+		return typ, nil
+	}
+
+	desc, err := r.findGogoMessageDescriptor(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamicpb.NewMessageType(desc), nil
+}
+
+// FindMessageByURL implements protoregistry.MessageTypeResolver.
+func (r *textualTypeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (r *textualTypeResolver) findGogoMessageDescriptor(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if desc, err := r.files.FindDescriptorByName(name); err == nil {
+		return asMessageDescriptor(desc)
+	}
+
+	typ := gogoproto.MessageType(string(name))
+	if typ == nil {
+		return nil, protoregistry.NotFound
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(interface {
+		Descriptor() ([]byte, []int)
+	})
+	if !ok {
+		return nil, fmt.Errorf("no file descriptor for message %s", name)
+	}
+
+	gzippedFd, _ := msg.Descriptor()
+	fd, err := unzipFileDescriptor(gzippedFd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.registerFile(fd); err != nil {
+		return nil, err
+	}
+
+	desc, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return asMessageDescriptor(desc)
+}
+
+// registerFile registers a gogoproto file descriptor, along with the
+// dependencies which are neither registered yet nor in the global registry.
+func (r *textualTypeResolver) registerFile(fd *descriptorpb.FileDescriptorProto) error {
+	for _, dep := range fd.Dependency {
+		if r.hasFile(dep) {
+			continue
+		}
+
+		gzippedDep := gogoproto.FileDescriptor(dep)
+		if gzippedDep == nil {
+			return fmt.Errorf("file descriptor %s not found, imported by %s", dep, fd.GetName())
+		}
+
+		depFd, err := unzipFileDescriptor(gzippedDep)
+		if err != nil {
+			return err
+		}
+
+		if err := r.registerFile(depFd); err != nil {
+			return err
+		}
+	}
+
+	if r.hasFile(fd.GetName()) {
+		return nil
+	}
+
+	file, err := protodesc.NewFile(fd, textualFilesResolver{r.files})
+	if err != nil {
+		return err
+	}
+
+	return r.files.RegisterFile(file)
+}
+
+func (r *textualTypeResolver) hasFile(path string) bool {
+	_, err := textualFilesResolver{r.files}.FindFileByPath(path)
+	return err == nil
+}
+
+// textualFilesResolver resolves descriptors with the global registry first.
+type textualFilesResolver struct {
+	files *protoregistry.Files
+}
+
+func (r textualFilesResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := protoregistry.GlobalFiles.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+
+	return r.files.FindFileByPath(path)
+}
+
+func (r textualFilesResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		return desc, nil
+	}
+
+	return r.files.FindDescriptorByName(name)
+}
+
+func asMessageDescriptor(desc protoreflect.Descriptor) (protoreflect.MessageDescriptor, error) {
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	return msgDesc, nil
+}
+
+func unzipFileDescriptor(gzipped []byte) (*descriptorpb.FileDescriptorProto, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := protov2.Unmarshal(bz, fd); err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
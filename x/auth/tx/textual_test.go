@@ -0,0 +1,191 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.MsgCreateDog{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	txBuilder := txConfig.NewTxBuilder()
+
+	chainID := "test-chain"
+	memo := "sometestmemo"
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Size_: "small", Name: "Spot"}}
+	accNum, accSeq := uint64(1), uint64(2) // Arbitrary account number/sequence
+
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      accSeq,
+		PubKey:        pubkey,
+	}
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// The sign bytes hold the rendered transaction, the message being
+	// resolved from the types registered by gogoproto.
+	for _, text := range []string{
+		"Chain id: test-chain",
+		"Address: " + addr.String(),
+		"Public key: /cosmos.crypto.secp256k1.PubKey",
+		"Message (1/1): /testdata.MsgCreateDog",
+		"Dog: Dog object",
+		"Name: Spot",
+		"Memo: sometestmemo",
+		"Fees: 150 atom",
+		"Gas limit: 20'000",
+	} {
+		require.Contains(t, string(signBytes), text)
+	}
+
+	t.Log("verify signature")
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify signature with a modified transaction")
+	txBuilder.SetMemo("othermemo")
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify signature with other signer data")
+	txBuilder.SetMemo(memo)
+	signingData.Sequence++
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+}
+
+func TestTextualHandler_DefaultMode(t *testing.T) {
+	handler := newSignModeTextualHandler(nil)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, handler.DefaultMode())
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			handler := newSignModeTextualHandler(nil)
+			var signingData signing.SignerData
+			_, err := handler.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	handler := newSignModeTextualHandler(nil)
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
+
+type mockBankKeeper map[string]banktypes.Metadata
+
+func (bk mockBankKeeper) GetDenomMetaData(_ sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := bk[denom]
+	return metadata, found
+}
+
+func TestTextualHandler_BankKeeperCoinMetadata(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.MsgCreateDog{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	bk := mockBankKeeper{"uatom": {
+		Base:    "uatom",
+		Display: "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "ATOM", Exponent: 6},
+		},
+	}}
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, NewBankKeeperCoinMetadataQueryFn(bk))
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	modeHandler := txConfig.SignModeHandler()
+
+	// the bank keeper can only be queried with an sdk.Context
+	_, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	signBytes, err := signing.GetSignBytesWithContext(sdk.WrapSDKContext(ctx), modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), "Fees: 1.5 ATOM")
+}
+
+func TestClientCoinMetadataQueryFn(t *testing.T) {
+	// the client context is only resolved when querying
+	getClientCtxErr := fmt.Errorf("invalid node")
+	q := NewClientCoinMetadataQueryFn(func() (client.Context, error) {
+		return client.Context{}, getClientCtxErr
+	})
+	_, err := q(context.Background(), "uatom")
+	require.ErrorIs(t, err, getClientCtxErr)
+
+	// the metadata can't be queried in offline mode
+	q = NewClientCoinMetadataQueryFn(func() (client.Context, error) {
+		return client.Context{}.WithOffline(true), nil
+	})
+	_, err = q(context.Background(), "uatom")
+	require.ErrorContains(t, err, "offline mode")
+}
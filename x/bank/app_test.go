@@ -357,6 +357,7 @@ func TestMsgSetSendEnabled(t *testing.T) {
 		sdk.Coins{{"foocoin", sdk.NewInt(5)}},
 		addr1Str,
 		"set default send enabled to true",
+		false,
 	)
 	require.NoError(t, err, "making goodGovProp")
 	badGovProp, err := govv1.NewMsgSubmitProposal(
@@ -366,6 +367,7 @@ func TestMsgSetSendEnabled(t *testing.T) {
 		sdk.Coins{{"foocoin", sdk.NewInt(5)}},
 		addr1Str,
 		"set default send enabled to true",
+		false,
 	)
 	require.NoError(t, err, "making badGovProp")
 
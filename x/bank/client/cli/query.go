@@ -14,7 +14,9 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom      = "denom"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -31,9 +33,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetBalanceHistoryCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryDenomPolicies(),
 	)
 
 	return cmd
@@ -108,6 +112,76 @@ Example:
 	return cmd
 }
 
+// GetBalanceHistoryCmd defines the cobra command to query the balance changes
+// of an account from the balance history index of a node.
+func GetBalanceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-history [address]",
+		Short: "Query for the balance changes of an account over a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance changes of an account, for all denominations or a specific one,
+over a range of heights. The queried node must run the balance history index.
+
+Example:
+  $ %s query %s balance-history [address]
+  $ %s query %s balance-history [address] --denom=[denom] --from-height=100 --to-height=200
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryBalanceHistoryRequest(addr, denom, fromHeight, toHeight, pageReq)
+
+			res, err := queryClient.BalanceHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query the changes for")
+	cmd.Flags().Int64(FlagFromHeight, 0, "The first height of the changes to query")
+	cmd.Flags().Int64(FlagToHeight, 0, "The last height of the changes to query, the latest one if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balance changes")
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -278,3 +352,55 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryDenomPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-policies [denom1 ...]",
+		Short: "Query for denom supply policies",
+		Long: strings.TrimSpace(`Query for the supply policies (supply cap, allowed minters and burners, pause) of denoms.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+`,
+		),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific policy:
+  $ %[1]s query %[2]s denom-policies foocoin
+
+Getting all policies:
+  $ %[1]s query %[2]s denom-policies
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryDenomPoliciesRequest{
+				Denoms:     args,
+				Pagination: reqPag,
+			}
+
+			res, err := queryClient.DenomPolicies(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom policies")
+
+	return cmd
+}
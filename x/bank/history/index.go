@@ -0,0 +1,241 @@
+// Package history implements the optional balance history index of the bank
+// module. The index records the balances of the accounts every time they
+// change, keyed by height, in a database separate from the application state,
+// so that the balances at past heights can be queried without an archive
+// node.
+package history
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/math"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// FlagEnable is the app option enabling the balance history index.
+	FlagEnable = "bank.balance-history.enable"
+
+	// DBName is the name of the database of the balance history index, in
+	// the data directory of the node.
+	DBName = "balance_history"
+)
+
+// BalanceChangePrefix is the prefix of the balance changes in the index
+// database.
+var BalanceChangePrefix = []byte{0x01}
+
+// Index is the balance history index. The balance changes are stored in its
+// database with the following layout:
+//
+//	0x01 | len(address) | address | denom | 0x00 | big endian height -> amount
+type Index struct {
+	db dbm.DB
+}
+
+// NewIndex creates a new Index writing to the given database.
+func NewIndex(db dbm.DB) *Index {
+	return &Index{db: db}
+}
+
+// OpenIndex opens the balance history index in the data directory of the
+// given home directory.
+func OpenIndex(home string, backend dbm.BackendType) (*Index, error) {
+	db, err := dbm.NewDB(DBName, backend, filepath.Join(home, "data"))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIndex(db), nil
+}
+
+// Close closes the database of the index.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// SetBalances records the given balance changes atomically.
+func (idx *Index) SetBalances(changes []BalanceUpdate) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, change := range changes {
+		amount, err := change.Balance.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Set(balanceChangeKey(change.Address, change.Balance.Denom, change.Height), amount); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// BalanceHistory returns the changes of the balance of an address over the
+// given range of heights, both inclusive. All the denoms are returned if denom
+// is empty, and the changes up to the latest height if toHeight is zero.
+//
+// The changes of a denom are keyed by height, so only the ones in the range
+// are iterated over. The changes of all the denoms are filtered by height.
+func (idx *Index) BalanceHistory(
+	addr sdk.AccAddress, denom string, fromHeight, toHeight int64, pageReq *query.PageRequest,
+) ([]types.BalanceChange, *query.PageResponse, error) {
+	if denom != "" {
+		return idx.denomBalanceHistory(addr, denom, fromHeight, toHeight, pageReq)
+	}
+
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, accountChangesPrefix(addr))
+
+	var changes []types.BalanceChange
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		changeDenom, height, err := parseChangeKey(key, denom)
+		if err != nil {
+			return false, err
+		}
+
+		if height < fromHeight || (toHeight > 0 && height > toHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			change, err := newBalanceChange(changeDenom, height, value)
+			if err != nil {
+				return false, err
+			}
+			changes = append(changes, change)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, pageRes, nil
+}
+
+// denomBalanceHistory returns the changes of the balance of an address in a
+// denom, iterating over the given range of heights only.
+func (idx *Index) denomBalanceHistory(
+	addr sdk.AccAddress, denom string, fromHeight, toHeight int64, pageReq *query.PageRequest,
+) ([]types.BalanceChange, *query.PageResponse, error) {
+	store := rangeStore{KVStore: prefix.NewStore(dbadapter.Store{DB: idx.db}, denomChangesPrefix(addr, denom))}
+	if fromHeight > 0 {
+		store.start = sdk.Uint64ToBigEndian(uint64(fromHeight))
+	}
+	if toHeight > 0 {
+		store.end = sdk.Uint64ToBigEndian(uint64(toHeight) + 1)
+	}
+
+	var changes []types.BalanceChange
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		_, height, err := parseChangeKey(key, denom)
+		if err != nil {
+			return err
+		}
+
+		change, err := newBalanceChange(denom, height, value)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, pageRes, nil
+}
+
+func newBalanceChange(denom string, height int64, value []byte) (types.BalanceChange, error) {
+	var amount math.Int
+	if err := amount.Unmarshal(value); err != nil {
+		return types.BalanceChange{}, err
+	}
+
+	return types.BalanceChange{Height: height, Balance: sdk.NewCoin(denom, amount)}, nil
+}
+
+// rangeStore restricts the iteration over a store to the keys in [start, end),
+// a nil start or end leaving the range unbounded on that side.
+type rangeStore struct {
+	storetypes.KVStore
+
+	start, end []byte
+}
+
+func (s rangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s rangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// clamp restricts the given iteration range to the one of the store, the
+// range being empty if they don't overlap.
+func (s rangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+
+	return start, end
+}
+
+// BalanceUpdate is a change of the balance of an account at some height.
+type BalanceUpdate struct {
+	Height  int64
+	Address sdk.AccAddress
+	Balance sdk.Coin
+}
+
+func accountChangesPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, BalanceChangePrefix...), address.MustLengthPrefix(addr)...)
+}
+
+func denomChangesPrefix(addr sdk.AccAddress, denom string) []byte {
+	return append(append(accountChangesPrefix(addr), denom...), 0)
+}
+
+func balanceChangeKey(addr sdk.AccAddress, denom string, height int64) []byte {
+	return append(denomChangesPrefix(addr, denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// parseChangeKey returns the denom and height of a balance change key, with
+// the account prefix removed. The key does not include the denom when it is
+// already known.
+func parseChangeKey(key []byte, denom string) (string, int64, error) {
+	if denom != "" {
+		if len(key) != 8 {
+			return "", 0, fmt.Errorf("invalid balance change key length %d", len(key))
+		}
+		return denom, int64(sdk.BigEndianToUint64(key)), nil
+	}
+
+	if len(key) < 10 || key[len(key)-9] != 0 {
+		return "", 0, fmt.Errorf("invalid balance change key %X", key)
+	}
+	return string(key[:len(key)-9]), int64(sdk.BigEndianToUint64(key[len(key)-8:])), nil
+}
@@ -0,0 +1,112 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/history"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________")
+	addr2 = sdk.AccAddress("addr2_______________")
+)
+
+func change(height int64, denom string, amount int64) types.BalanceChange {
+	return types.BalanceChange{Height: height, Balance: sdk.NewInt64Coin(denom, amount)}
+}
+
+func TestIndexBalanceHistory(t *testing.T) {
+	index := history.NewIndex(dbm.NewMemDB())
+	require.NoError(t, index.SetBalances([]history.BalanceUpdate{
+		{Height: 1, Address: addr1, Balance: sdk.NewInt64Coin("foo", 10)},
+		{Height: 1, Address: addr1, Balance: sdk.NewInt64Coin("bar", 5)},
+		{Height: 1, Address: addr2, Balance: sdk.NewInt64Coin("foo", 1)},
+		{Height: 3, Address: addr1, Balance: sdk.NewInt64Coin("foo", 7)},
+		{Height: 300, Address: addr1, Balance: sdk.NewInt64Coin("foo", 0)},
+	}))
+
+	testCases := []struct {
+		name       string
+		denom      string
+		fromHeight int64
+		toHeight   int64
+		pageReq    *query.PageRequest
+		expChanges []types.BalanceChange
+		expTotal   uint64
+	}{
+		{
+			name:       "all denoms",
+			expChanges: []types.BalanceChange{change(1, "bar", 5), change(1, "foo", 10), change(3, "foo", 7), change(300, "foo", 0)},
+		},
+		{
+			name:       "single denom",
+			denom:      "foo",
+			expChanges: []types.BalanceChange{change(1, "foo", 10), change(3, "foo", 7), change(300, "foo", 0)},
+		},
+		{
+			name:       "height range",
+			denom:      "foo",
+			fromHeight: 2,
+			toHeight:   299,
+			expChanges: []types.BalanceChange{change(3, "foo", 7)},
+		},
+		{
+			name:       "from height",
+			fromHeight: 3,
+			expChanges: []types.BalanceChange{change(3, "foo", 7), change(300, "foo", 0)},
+		},
+		{
+			name:       "unknown denom",
+			denom:      "baz",
+			expChanges: nil,
+		},
+		{
+			name:       "pagination",
+			pageReq:    &query.PageRequest{Limit: 2, CountTotal: true},
+			expChanges: []types.BalanceChange{change(1, "bar", 5), change(1, "foo", 10)},
+			expTotal:   4,
+		},
+		{
+			name:       "single denom pagination in height range",
+			denom:      "foo",
+			fromHeight: 1,
+			toHeight:   300,
+			pageReq:    &query.PageRequest{Limit: 1, Offset: 1, CountTotal: true},
+			expChanges: []types.BalanceChange{change(3, "foo", 7)},
+			expTotal:   3,
+		},
+		{
+			name:       "single denom reverse",
+			denom:      "foo",
+			toHeight:   299,
+			pageReq:    &query.PageRequest{Reverse: true},
+			expChanges: []types.BalanceChange{change(3, "foo", 7), change(1, "foo", 10)},
+		},
+		{
+			name:       "empty height range",
+			denom:      "foo",
+			fromHeight: 4,
+			toHeight:   2,
+			expChanges: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			changes, pageRes, err := index.BalanceHistory(addr1, tc.denom, tc.fromHeight, tc.toHeight, tc.pageReq)
+			require.NoError(t, err)
+			require.Equal(t, tc.expChanges, changes)
+			if tc.expTotal > 0 {
+				require.Equal(t, tc.expTotal, pageRes.Total)
+				require.NotNil(t, pageRes.NextKey)
+			}
+		})
+	}
+}
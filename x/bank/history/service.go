@@ -0,0 +1,105 @@
+package history
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a StreamingService writing the changes of the balances
+// in the bank store to an Index.
+//
+// The balances are written to the bank store when a block is committed, the
+// changes are written to the index from the change set of the block received
+// in ListenCommit.
+type StreamingService struct {
+	index    *Index
+	storeKey storetypes.StoreKey
+}
+
+// NewStreamingService creates a new StreamingService writing the balance
+// changes of the bank store with the given key to the index.
+func NewStreamingService(index *Index, bankStoreKey storetypes.StoreKey) *StreamingService {
+	return &StreamingService{
+		index:    index,
+		storeKey: bankStoreKey,
+	}
+}
+
+// Listeners satisfies the baseapp.StreamingService interface, listening to
+// the bank store. The changes are received through ListenCommit so no
+// WriteListener is needed.
+func (s *StreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		s.storeKey: nil,
+	}
+}
+
+// Stream satisfies the baseapp.StreamingService interface. The changes are
+// written synchronously so there is nothing to do.
+func (s *StreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface.
+func (s *StreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface.
+func (s *StreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface.
+func (s *StreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface, writing the
+// changes of account balances in the change set of the committed block to the
+// index. A deleted balance is recorded as a zero balance.
+func (s *StreamingService) ListenCommit(ctx sdk.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	var updates []BalanceUpdate
+	for _, pair := range changeSet {
+		if pair.StoreKey != s.storeKey.Name() || len(pair.Key) == 0 || pair.Key[0] != types.BalancesPrefix[0] {
+			continue
+		}
+
+		addr, denom, err := types.AddressAndDenomFromBalancesStore(pair.Key[len(types.BalancesPrefix):])
+		if err != nil {
+			return err
+		}
+
+		amount := math.ZeroInt()
+		if !pair.Delete {
+			if err := amount.Unmarshal(pair.Value); err != nil {
+				return err
+			}
+		}
+
+		updates = append(updates, BalanceUpdate{
+			Height:  ctx.BlockHeight(),
+			Address: addr,
+			Balance: sdk.NewCoin(denom, amount),
+		})
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+	return s.index.SetBalances(updates)
+}
+
+// Close satisfies the io.Closer interface, closing the index.
+func (s *StreamingService) Close() error {
+	return s.index.Close()
+}
@@ -0,0 +1,54 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/history"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestStreamingService(t *testing.T) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	index := history.NewIndex(dbm.NewMemDB())
+	service := history.NewStreamingService(index, key)
+	require.Contains(t, service.Listeners(), key)
+
+	set := func(addr sdk.AccAddress, denom string, amount int64) *storetypes.StoreKVPair {
+		bz, err := math.NewInt(amount).Marshal()
+		require.NoError(t, err)
+		return &storetypes.StoreKVPair{StoreKey: key.Name(), Key: types.CreatePrefixedAccountStoreKey(addr, []byte(denom)), Value: bz}
+	}
+	commit := func(height int64, changeSet ...*storetypes.StoreKVPair) error {
+		ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: height})
+		return service.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+	}
+
+	require.NoError(t, commit(1,
+		set(addr1, "foo", 10),
+		// writes outside of the balances or of the bank store are ignored
+		&storetypes.StoreKVPair{StoreKey: key.Name(), Key: types.CreateDenomAddressPrefix("foo"), Value: []byte{0}},
+		&storetypes.StoreKVPair{StoreKey: "other", Key: types.CreatePrefixedAccountStoreKey(addr1, []byte("foo")), Value: []byte{0}},
+	))
+	require.NoError(t, commit(2,
+		set(addr1, "foo", 4),
+		&storetypes.StoreKVPair{StoreKey: key.Name(), Delete: true, Key: types.CreatePrefixedAccountStoreKey(addr1, []byte("bar"))},
+	))
+	require.NoError(t, commit(3))
+
+	changes, _, err := index.BalanceHistory(addr1, "", 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{change(2, "bar", 0), change(1, "foo", 10), change(2, "foo", 4)}, changes)
+
+	// a malformed balance fails the hook
+	require.Error(t, commit(4, &storetypes.StoreKVPair{StoreKey: key.Name(), Key: types.CreatePrefixedAccountStoreKey(addr1, []byte("foo")), Value: []byte("bad")}))
+
+	require.NoError(t, service.Close())
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetDenomPolicy gets the DenomPolicy of the given denom. The second return
+// argument is true iff a policy exists for the given denom.
+func (k BaseSendKeeper) GetDenomPolicy(ctx sdk.Context, denom string) (types.DenomPolicy, bool) {
+	return k.getDenomPolicy(ctx.KVStore(k.storeKey), denom)
+}
+
+// SetDenomPolicy validates and sets the DenomPolicy of a denom, replacing any
+// existing one.
+func (k BaseSendKeeper) SetDenomPolicy(ctx sdk.Context, policy types.DenomPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateDenomPolicyKey(policy.Denom), k.cdc.MustMarshal(&policy))
+	return nil
+}
+
+// DeleteDenomPolicies deletes the DenomPolicy of one or more denoms.
+// If a denom is provided that doesn't have a policy, it is ignored.
+func (k BaseSendKeeper) DeleteDenomPolicies(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateDenomPolicyKey(denom))
+	}
+}
+
+// getDenomPolicyPrefixStore gets a prefix store for the DenomPolicy entries.
+func (k BaseSendKeeper) getDenomPolicyPrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomPolicyPrefix)
+}
+
+// IterateDenomPolicies iterates over all the DenomPolicy entries.
+func (k BaseSendKeeper) IterateDenomPolicies(ctx sdk.Context, cb func(policy types.DenomPolicy) (stop bool)) {
+	iterator := k.getDenomPolicyPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.DenomPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// GetAllDenomPolicies gets all the DenomPolicy entries that are stored.
+func (k BaseSendKeeper) GetAllDenomPolicies(ctx sdk.Context) []types.DenomPolicy {
+	var rv []types.DenomPolicy
+	k.IterateDenomPolicies(ctx, func(policy types.DenomPolicy) bool {
+		rv = append(rv, policy)
+		return false
+	})
+	return rv
+}
+
+// IsDenomPaused returns whether a DenomPolicy pausing the given denom exists.
+func (k BaseSendKeeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	policy, found := k.GetDenomPolicy(ctx, denom)
+	return found && policy.Paused
+}
+
+// checkNotPaused returns an ErrDenomPaused if any of the provided coins is
+// paused by its DenomPolicy, unless the context bypasses the policies.
+func (k BaseSendKeeper) checkNotPaused(ctx sdk.Context, coins sdk.Coins) error {
+	if types.HasDenomPolicyBypass(ctx) {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range coins {
+		if policy, found := k.getDenomPolicy(store, coin.Denom); found && policy.Paused {
+			return types.ErrDenomPaused.Wrapf("%s transfers are currently paused", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// getDenomPolicy returns the DenomPolicy of a denom and whether it was found.
+func (k BaseSendKeeper) getDenomPolicy(store sdk.KVStore, denom string) (types.DenomPolicy, bool) {
+	bz := store.Get(types.CreateDenomPolicyKey(denom))
+	if bz == nil {
+		return types.DenomPolicy{}, false
+	}
+
+	var policy types.DenomPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// checkMintPolicies returns an error if the given module may not mint the
+// provided coins according to their DenomPolicy, i.e. if a denom is paused,
+// the module is not one of its minters or the minted amount would exceed its
+// supply cap. The policies are not checked if the context bypasses them.
+func (k BaseKeeper) checkMintPolicies(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if types.HasDenomPolicyBypass(ctx) {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, amount := range amounts {
+		policy, found := k.getDenomPolicy(store, amount.Denom)
+		if !found {
+			continue
+		}
+
+		if policy.Paused {
+			return types.ErrDenomPaused.Wrapf("%s minting is currently paused", amount.Denom)
+		}
+
+		if !policy.CanMint(moduleName) {
+			return types.ErrMintNotAllowed.Wrapf("module %s cannot mint %s", moduleName, amount.Denom)
+		}
+
+		if policy.HasSupplyCap() {
+			supply := k.GetSupply(ctx, amount.Denom).Add(amount)
+			if supply.Amount.GT(policy.SupplyCap) {
+				return types.ErrSupplyCapExceeded.Wrapf("minting %s would bring the supply to %s, the cap is %s", amount, supply.Amount, policy.SupplyCap)
+			}
+		}
+	}
+	return nil
+}
+
+// checkBurnPolicies returns an error if the given module may not burn the
+// provided coins according to their DenomPolicy, i.e. if a denom is paused or
+// the module is not one of its burners. The policies are not checked if the
+// context bypasses them.
+func (k BaseKeeper) checkBurnPolicies(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if types.HasDenomPolicyBypass(ctx) {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, amount := range amounts {
+		policy, found := k.getDenomPolicy(store, amount.Denom)
+		if !found {
+			continue
+		}
+
+		if policy.Paused {
+			return types.ErrDenomPaused.Wrapf("%s burning is currently paused", amount.Denom)
+		}
+
+		if !policy.CanBurn(moduleName) {
+			return types.ErrBurnNotAllowed.Wrapf("module %s cannot burn %s", moduleName, amount.Denom)
+		}
+	}
+	return nil
+}
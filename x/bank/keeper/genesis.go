@@ -42,6 +42,12 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, policy := range genState.DenomPolicies {
+		if err := k.SetDenomPolicy(ctx, policy); err != nil {
+			panic(fmt.Errorf("error on setting denom policy %w", err))
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -58,5 +64,6 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.DenomPolicies = k.GetAllDenomPolicies(ctx)
 	return rv
 }
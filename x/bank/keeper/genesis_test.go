@@ -65,12 +65,19 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	m := types.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom}
 	g := types.DefaultGenesisState()
 	g.DenomMetadata = []types.Metadata{m}
+	p := types.NewDenomPolicy(sdk.DefaultBondDenom, sdk.NewInt(1000), []string{minttypes.ModuleName}, nil, false)
+	g.DenomPolicies = []types.DenomPolicy{p}
 	bk := suite.bankKeeper
 	bk.InitGenesis(suite.ctx, g)
 
 	m2, found := bk.GetDenomMetaData(suite.ctx, m.Base)
 	suite.Require().True(found)
 	suite.Require().Equal(m, m2)
+
+	p2, found := bk.GetDenomPolicy(suite.ctx, p.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(p, p2)
+	suite.Require().Equal([]types.DenomPolicy{p}, bk.ExportGenesis(suite.ctx).DenomPolicies)
 }
 
 func (suite *KeeperTestSuite) TestTotalSupply() {
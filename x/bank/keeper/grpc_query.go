@@ -277,3 +277,78 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 	}
 	return resp, nil
 }
+
+func (k BaseKeeper) DenomPolicies(goCtx context.Context, req *types.QueryDenomPoliciesRequest) (*types.QueryDenomPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryDenomPoliciesResponse{}
+	if len(req.Denoms) > 0 {
+		store := ctx.KVStore(k.storeKey)
+		for _, denom := range req.Denoms {
+			if policy, ok := k.getDenomPolicy(store, denom); ok {
+				resp.DenomPolicies = append(resp.DenomPolicies, policy)
+			}
+		}
+	} else {
+		store := k.getDenomPolicyPrefixStore(ctx)
+		var err error
+		resp.Pagination, err = query.FilteredPaginate(
+			store,
+			req.Pagination,
+			func(key []byte, value []byte, accumulate bool) (bool, error) {
+				if accumulate {
+					var policy types.DenomPolicy
+					if err := k.cdc.Unmarshal(value, &policy); err != nil {
+						return false, err
+					}
+					resp.DenomPolicies = append(resp.DenomPolicies, policy)
+				}
+				return true, nil
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(_ context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	index := k.balanceHistory.index
+	if index == nil {
+		return nil, status.Error(codes.Unimplemented, "balance history index is not enabled on this node")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+
+	if req.ToHeight > 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is lower than from height %d", req.ToHeight, req.FromHeight)
+	}
+
+	changes, pageRes, err := index.BalanceHistory(addr, req.Denom, req.FromHeight, req.ToHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
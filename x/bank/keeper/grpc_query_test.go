@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"time"
 
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/history"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -586,3 +591,94 @@ func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryDenomPolicies() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+
+	barPolicy := types.NewDenomPolicy("barcoin", sdk.NewInt(10), nil, nil, false)
+	fooPolicy := types.NewDenomPolicy("foocoin", sdk.ZeroInt(), []string{"mint"}, []string{"burner"}, true)
+	suite.Require().NoError(bankKeeper.SetDenomPolicy(ctx, barPolicy))
+	suite.Require().NoError(bankKeeper.SetDenomPolicy(ctx, fooPolicy))
+
+	tests := []struct {
+		name string
+		req  *types.QueryDenomPoliciesRequest
+		exp  *types.QueryDenomPoliciesResponse
+	}{
+		{
+			name: "all denoms",
+			req:  &types.QueryDenomPoliciesRequest{},
+			exp: &types.QueryDenomPoliciesResponse{
+				DenomPolicies: []types.DenomPolicy{barPolicy, fooPolicy},
+				Pagination:    &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name: "limit 1",
+			req: &types.QueryDenomPoliciesRequest{
+				Pagination: &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				},
+			},
+			exp: &types.QueryDenomPoliciesResponse{
+				DenomPolicies: []types.DenomPolicy{barPolicy},
+				Pagination: &query.PageResponse{
+					NextKey: []byte("foocoin"),
+					Total:   2,
+				},
+			},
+		},
+		{
+			name: "specific denoms",
+			req:  &types.QueryDenomPoliciesRequest{Denoms: []string{"foocoin", "unknowncoin"}},
+			exp: &types.QueryDenomPoliciesResponse{
+				DenomPolicies: []types.DenomPolicy{fooPolicy},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			resp, err := suite.queryClient.DenomPolicies(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, resp)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBalanceHistory() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	req := types.NewQueryBalanceHistoryRequest(addr, fooDenom, 0, 0, nil)
+
+	_, err := suite.queryClient.BalanceHistory(gocontext.Background(), req)
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+
+	index := history.NewIndex(dbm.NewMemDB())
+	suite.bankKeeper.SetBalanceHistoryIndex(index)
+	suite.Require().NoError(index.SetBalances([]history.BalanceUpdate{
+		{Height: 2, Address: addr, Balance: newFooCoin(50)},
+		{Height: 5, Address: addr, Balance: newFooCoin(20)},
+		{Height: 5, Address: addr, Balance: newBarCoin(30)},
+	}))
+
+	res, err := suite.queryClient.BalanceHistory(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BalanceChange{
+		{Height: 2, Balance: newFooCoin(50)},
+		{Height: 5, Balance: newFooCoin(20)},
+	}, res.Changes)
+
+	res, err = suite.queryClient.BalanceHistory(gocontext.Background(), types.NewQueryBalanceHistoryRequest(addr, "", 3, 5, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BalanceChange{
+		{Height: 5, Balance: newBarCoin(30)},
+		{Height: 5, Balance: newFooCoin(20)},
+	}, res.Changes)
+
+	_, err = suite.queryClient.BalanceHistory(gocontext.Background(), types.NewQueryBalanceHistoryRequest(addr, "", 5, 3, nil))
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: "invalid"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -12,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/history"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -22,6 +23,7 @@ var _ Keeper = (*BaseKeeper)(nil)
 type Keeper interface {
 	SendKeeper
 	WithMintCoinsRestriction(MintingRestrictionFn) BaseKeeper
+	SetBalanceHistoryIndex(index *history.Index)
 
 	InitGenesis(sdk.Context, *types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
@@ -57,6 +59,15 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	storeKey               storetypes.StoreKey
 	mintCoinsRestrictionFn MintingRestrictionFn
+
+	// balanceHistory is shared by all the copies of the keeper, so that the
+	// index set once the keeper has been passed to other modules is used.
+	balanceHistory *balanceHistory
+}
+
+// balanceHistory holds the optional balance history index of a BaseKeeper.
+type balanceHistory struct {
+	index *history.Index
 }
 
 type MintingRestrictionFn func(ctx sdk.Context, coins sdk.Coins) error
@@ -109,9 +120,17 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		storeKey:               storeKey,
 		mintCoinsRestrictionFn: func(ctx sdk.Context, coins sdk.Coins) error { return nil },
+		balanceHistory:         &balanceHistory{},
 	}
 }
 
+// SetBalanceHistoryIndex sets the balance history index serving the
+// BalanceHistory query. The index is written by its StreamingService, which
+// must be registered on the BaseApp.
+func (k BaseKeeper) SetBalanceHistoryIndex(index *history.Index) {
+	k.balanceHistory.index = index
+}
+
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
 // have restricted permissions on minting via function passed in parameter.
 // Previous restriction functions can be nested as such:
@@ -328,7 +347,9 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
-// It will panic if either module account does not exist.
+// It will panic if either module account does not exist. The transfer is not
+// subject to the DenomPolicy of the coins, as it is part of the protocol, e.g.
+// moving the collected fees or the bonded tokens.
 func (k BaseKeeper) SendCoinsFromModuleToModule(
 	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
 ) error {
@@ -342,7 +363,7 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -395,7 +416,8 @@ func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
 }
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// It will panic if the module account does not exist or is unauthorized.
+// It will panic if the module account does not exist or is unauthorized. An
+// error is returned if minting is not allowed by the DenomPolicy of a denom.
 func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
 	err := k.mintCoinsRestrictionFn(ctx, amounts)
 	if err != nil {
@@ -411,6 +433,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
+	if err := k.checkMintPolicies(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -434,7 +460,8 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 }
 
 // BurnCoins burns coins deletes coins from the balance of the module account.
-// It will panic if the module account does not exist or is unauthorized.
+// It will panic if the module account does not exist or is unauthorized. An
+// error is returned if burning is not allowed by the DenomPolicy of a denom.
 func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
 	acc := k.ak.GetModuleAccount(ctx, moduleName)
 	if acc == nil {
@@ -445,6 +472,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
+	if err := k.checkBurnPolicies(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -567,6 +568,123 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	var calls []string
+	restriction := func(name string, toAddr sdk.AccAddress, err error) banktypes.SendRestrictionFn {
+		return func(_ sdk.Context, fromAddr, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+			require.Equal(accAddrs[0], fromAddr)
+			require.Equal(sendAmt, amt)
+			calls = append(calls, name)
+			if toAddr == nil {
+				return to, err
+			}
+			return toAddr, err
+		}
+	}
+	defer suite.bankKeeper.ClearSendRestriction()
+
+	// restrictions run in order and may change the recipient
+	suite.bankKeeper.AppendSendRestriction(restriction("second", accAddrs[2], nil))
+	suite.bankKeeper.PrependSendRestriction(restriction("first", nil, nil))
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal([]string{"first", "second"}, calls)
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// an error aborts the transfer
+	calls = nil
+	suite.bankKeeper.AppendSendRestriction(restriction("third", nil, errors.New("frozen")))
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt), "frozen")
+	require.Equal([]string{"first", "second", "third"}, calls)
+	require.Equal(balances.Sub(sendAmt...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// restrictions are skipped with a bypass context
+	calls = nil
+	bypassCtx := banktypes.WithBypass(ctx)
+	suite.mockSendCoins(bypassCtx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(bypassCtx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Empty(calls)
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// and once cleared
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Empty(calls)
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsFromModuleWithRestrictionBypass() {
+	ctx := suite.ctx
+	require := suite.Require()
+	defer suite.bankKeeper.ClearSendRestriction()
+
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, errors.New("restricted")
+	})
+
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	require.ErrorContains(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[0], initCoins), "restricted")
+
+	// the restriction is skipped for the transfers from the mint module
+	suite.bankKeeper.AddSendRestrictionBypass(minttypes.ModuleName)
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[0], initCoins))
+	require.Equal(initCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoinsWithRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	defer suite.bankKeeper.ClearSendRestriction()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	// outputs to accAddrs[1] are redirected to accAddrs[3], those to
+	// accAddrs[2] are rejected
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		require.Equal(accAddrs[0], fromAddr)
+		switch {
+		case toAddr.Equals(accAddrs[1]):
+			return accAddrs[3], nil
+		case toAddr.Equals(accAddrs[2]):
+			return nil, errors.New("blocked recipient")
+		default:
+			return toAddr, nil
+		}
+	})
+
+	inputs := []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, accAddrs[3:4])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(outputs[0].Coins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	outputs[0].Address = accAddrs[2].String()
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), "blocked recipient")
+}
+
 func (suite *KeeperTestSuite) TestValidateBalance() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1733,6 +1851,154 @@ func (suite *KeeperTestSuite) TestSetParams() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestDenomPolicy_MintCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	policy := banktypes.NewDenomPolicy(fooDenom, sdk.NewInt(100), []string{authtypes.Minter}, nil, false)
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+
+	suite.mockMintCoins(multiPermAcc)
+	err := keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrMintNotAllowed)
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(100))))
+	require.Equal(newFooCoin(100), keeper.GetSupply(ctx, fooDenom))
+
+	suite.mockMintCoins(minterAcc)
+	err = keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(1), newBarCoin(1)))
+	require.ErrorIs(err, banktypes.ErrSupplyCapExceeded)
+	require.Equal(newFooCoin(100), keeper.GetSupply(ctx, fooDenom))
+	require.Equal(newBarCoin(0), keeper.GetSupply(ctx, barDenom))
+
+	// denoms without a policy are not restricted
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newBarCoin(1000))))
+
+	// a zero supply cap means no cap
+	policy.SupplyCap = sdk.ZeroInt()
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(1000))))
+
+	policy.Paused = true
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+	suite.mockMintCoins(minterAcc)
+	err = keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(1)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	// the policies are not checked when the context bypasses them
+	policy.SupplyCap = keeper.GetSupply(ctx, fooDenom).Amount
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+	bypassCtx := banktypes.WithDenomPolicyBypass(ctx)
+	suite.authKeeper.EXPECT().GetModuleAccount(bypassCtx, multiPermAcc.Name).Return(multiPermAcc)
+	require.NoError(keeper.MintCoins(bypassCtx, multiPerm, sdk.NewCoins(newFooCoin(1))))
+}
+
+func (suite *KeeperTestSuite) TestDenomPolicy_BurnCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	coins := sdk.NewCoins(newFooCoin(100))
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(keeper.MintCoins(ctx, multiPerm, coins))
+
+	policy := banktypes.NewDenomPolicy(fooDenom, sdk.ZeroInt(), nil, []string{authtypes.Burner}, false)
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+
+	suite.mockMintCoins(multiPermAcc)
+	err := keeper.BurnCoins(ctx, multiPerm, coins)
+	require.ErrorIs(err, banktypes.ErrBurnNotAllowed)
+	require.Equal(coins, keeper.GetAllBalances(ctx, multiPermAcc.GetAddress()))
+
+	policy.Burners = []string{authtypes.Burner, multiPerm}
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+	suite.mockBurnCoins(multiPermAcc)
+	require.NoError(keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(40))))
+	require.Equal(newFooCoin(60), keeper.GetSupply(ctx, fooDenom))
+
+	policy.Paused = true
+	require.NoError(keeper.SetDenomPolicy(ctx, policy))
+	suite.mockMintCoins(multiPermAcc)
+	err = keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	// the policies are not checked when the context bypasses them
+	bypassCtx := banktypes.WithDenomPolicyBypass(ctx)
+	suite.authKeeper.EXPECT().GetModuleAccount(bypassCtx, multiPermAcc.Name).Return(multiPermAcc)
+	suite.authKeeper.EXPECT().GetAccount(bypassCtx, multiPermAcc.GetAddress()).Return(multiPermAcc)
+	require.NoError(keeper.BurnCoins(bypassCtx, multiPerm, sdk.NewCoins(newFooCoin(10))))
+	require.Equal(newFooCoin(50), keeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestDenomPolicy_Paused() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], coins))
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, coins))
+
+	require.NoError(keeper.SetDenomPolicy(ctx, banktypes.NewDenomPolicy(fooDenom, sdk.ZeroInt(), nil, nil, true)))
+	require.True(keeper.IsDenomPaused(ctx, fooDenom))
+	require.False(keeper.IsDenomPaused(ctx, barDenom))
+
+	err := keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	input := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []banktypes.Output{{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	require.ErrorIs(keeper.InputOutputCoins(ctx, input, outputs), banktypes.ErrDenomPaused)
+	require.Equal(coins, keeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.authKeeper.EXPECT().GetModuleAddress(minterAcc.Name).Return(minterAcc.GetAddress())
+	err = keeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	// transfers between module accounts are not subject to the policies
+	suite.mockSendCoinsFromModuleToModule(minterAcc, holderAcc)
+	require.NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, holder, sdk.NewCoins(newFooCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), keeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+
+	keeper.DeleteDenomPolicies(ctx, fooDenom)
+	require.False(keeper.IsDenomPaused(ctx, fooDenom))
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestDenomPolicies() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	_, found := keeper.GetDenomPolicy(ctx, fooDenom)
+	require.False(found)
+	require.Error(keeper.SetDenomPolicy(ctx, banktypes.NewDenomPolicy(fooDenom, sdk.NewInt(-1), nil, nil, false)))
+
+	fooPolicy := banktypes.NewDenomPolicy(fooDenom, sdk.NewInt(10), []string{authtypes.Minter}, nil, false)
+	barPolicy := banktypes.NewDenomPolicy(barDenom, sdk.ZeroInt(), nil, nil, true)
+	require.NoError(keeper.SetDenomPolicy(ctx, fooPolicy))
+	require.NoError(keeper.SetDenomPolicy(ctx, barPolicy))
+
+	actual, found := keeper.GetDenomPolicy(ctx, fooDenom)
+	require.True(found)
+	require.Equal(fooPolicy, actual)
+	require.Equal([]banktypes.DenomPolicy{barPolicy, fooPolicy}, keeper.GetAllDenomPolicies(ctx))
+
+	keeper.DeleteDenomPolicies(ctx, barDenom, "unknown")
+	require.Equal([]banktypes.DenomPolicy{fooPolicy}, keeper.GetAllDenomPolicies(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -148,3 +148,29 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) SetDenomPolicies(goCtx context.Context, msg *types.MsgSetDenomPolicies) (*types.MsgSetDenomPoliciesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, policy := range msg.DenomPolicies {
+		if err := k.SetDenomPolicy(ctx, policy); err != nil {
+			return nil, err
+		}
+	}
+	if len(msg.RemovePolicies) > 0 {
+		k.DeleteDenomPolicies(ctx, msg.RemovePolicies...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgSetDenomPoliciesResponse{}, nil
+}
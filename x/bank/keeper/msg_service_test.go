@@ -171,3 +171,66 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetDenomPolicies() {
+	fooPolicy := banktypes.NewDenomPolicy("foo", sdk.NewInt(100), []string{authtypes.Minter}, nil, false)
+	barPolicy := banktypes.NewDenomPolicy("bar", sdk.ZeroInt(), nil, nil, true)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgSetDenomPolicies
+		expErr    bool
+		expErrMsg string
+		expected  []banktypes.DenomPolicy
+	}{
+		{
+			name: "invalid authority",
+			input: &banktypes.MsgSetDenomPolicies{
+				Authority:     "invalid",
+				DenomPolicies: []banktypes.DenomPolicy{fooPolicy},
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid policy",
+			input: &banktypes.MsgSetDenomPolicies{
+				Authority:     suite.bankKeeper.GetAuthority(),
+				DenomPolicies: []banktypes.DenomPolicy{banktypes.NewDenomPolicy("foo", sdk.NewInt(-1), nil, nil, false)},
+			},
+			expErr:    true,
+			expErrMsg: "negative supply cap",
+		},
+		{
+			name: "set policies",
+			input: &banktypes.MsgSetDenomPolicies{
+				Authority:     suite.bankKeeper.GetAuthority(),
+				DenomPolicies: []banktypes.DenomPolicy{fooPolicy, barPolicy},
+			},
+			expected: []banktypes.DenomPolicy{barPolicy, fooPolicy},
+		},
+		{
+			name: "remove policy",
+			input: &banktypes.MsgSetDenomPolicies{
+				Authority:      suite.bankKeeper.GetAuthority(),
+				RemovePolicies: []string{"bar"},
+			},
+			expected: []banktypes.DenomPolicy{fooPolicy},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomPolicies(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expected, suite.bankKeeper.GetAllDenomPolicies(suite.ctx))
+			}
+		})
+	}
+}
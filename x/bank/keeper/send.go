@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -35,9 +36,21 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	GetDenomPolicy(ctx sdk.Context, denom string) (types.DenomPolicy, bool)
+	SetDenomPolicy(ctx sdk.Context, policy types.DenomPolicy) error
+	DeleteDenomPolicies(ctx sdk.Context, denoms ...string)
+	IterateDenomPolicies(ctx sdk.Context, cb func(policy types.DenomPolicy) (stop bool))
+	GetAllDenomPolicies(ctx sdk.Context) []types.DenomPolicy
+	IsDenomPaused(ctx sdk.Context, denom string) bool
+
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	AddSendRestrictionBypass(moduleNames ...string)
+
 	GetAuthority() string
 }
 
@@ -58,6 +71,18 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	sendRestriction *sendRestriction
+}
+
+// sendRestriction holds the send restrictions of a BaseSendKeeper. It is
+// shared by all the copies of the keeper, so that the restrictions added once
+// the keeper has been passed to other modules also apply to them.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+
+	// module account addresses for which send restrictions are skipped
+	bypassAddrs map[string]bool
 }
 
 func NewBaseSendKeeper(
@@ -78,9 +103,49 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		blockedAddrs:   blockedAddrs,
 		authority:      authority,
+		sendRestriction: &sendRestriction{
+			bypassAddrs: map[string]bool{},
+		},
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = types.ComposeSendRestrictions(k.sendRestriction.fn, restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = types.ComposeSendRestrictions(restriction, k.sendRestriction.fn)
+}
+
+// ClearSendRestriction removes the send restrictions (if there are any).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.fn = nil
+}
+
+// AddSendRestrictionBypass skips the send restrictions for the transfers from
+// or to the accounts of the given modules.
+func (k BaseSendKeeper) AddSendRestrictionBypass(moduleNames ...string) {
+	for _, moduleName := range moduleNames {
+		k.sendRestriction.bypassAddrs[authtypes.NewModuleAddress(moduleName).String()] = true
+	}
+}
+
+// applySendRestriction runs the send restrictions for a transfer, unless they
+// are bypassed by the context or by one of the accounts, and returns the
+// address which receives the coins.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	r := k.sendRestriction
+	if r == nil || r.fn == nil || types.HasBypass(ctx) || r.bypassAddrs[fromAddr.String()] || r.bypassAddrs[toAddr.String()] {
+		return toAddr, nil
+	}
+
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
+
 // GetAuthority returns the x/bank module's authority.
 func (k BaseSendKeeper) GetAuthority() string {
 	return k.authority
@@ -122,6 +187,8 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't line up or if any single transfer of tokens fails.
+// The send restrictions are run for each output against every input, the
+// recipient returned for an input being passed along to the next one.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -129,11 +196,17 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
+		if err := k.checkNotPaused(ctx, in.Coins); err != nil {
+			return err
+		}
+
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		inAddresses[i] = inAddress
 
 		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
 		if err != nil {
@@ -152,6 +225,14 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
+		for _, inAddress := range inAddresses {
+			outAddress, err = k.applySendRestriction(ctx, inAddress, outAddress, out.Coins)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -160,7 +241,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -180,9 +261,26 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// Transfers of denoms paused by their DenomPolicy are rejected, the send
+// restrictions may reject the transfer or change the receiving account. An
+// error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	if err := k.checkNotPaused(ctx, amt); err != nil {
+		return err
+	}
+
+	return k.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account,
+// regardless of the DenomPolicy of the coins.
+func (k BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
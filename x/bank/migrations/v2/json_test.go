@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"denom_policies": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomPolicy creates a new DenomPolicy object. A zero supplyCap means
+// that the supply of the denom is not capped, empty minters or burners that
+// any module with the corresponding permission can mint or burn the denom.
+func NewDenomPolicy(denom string, supplyCap sdk.Int, minters, burners []string, paused bool) DenomPolicy {
+	return DenomPolicy{
+		Denom:     denom,
+		SupplyCap: supplyCap,
+		Minters:   minters,
+		Burners:   burners,
+		Paused:    paused,
+	}
+}
+
+// Validate gets any errors with this DenomPolicy entry.
+func (p DenomPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if !p.SupplyCap.IsNil() && p.SupplyCap.IsNegative() {
+		return fmt.Errorf("negative supply cap for denom %s: %s", p.Denom, p.SupplyCap)
+	}
+
+	if err := validateModuleNames(p.Minters); err != nil {
+		return fmt.Errorf("invalid minters for denom %s: %w", p.Denom, err)
+	}
+
+	if err := validateModuleNames(p.Burners); err != nil {
+		return fmt.Errorf("invalid burners for denom %s: %w", p.Denom, err)
+	}
+
+	return nil
+}
+
+// HasSupplyCap returns whether the supply of the denom is capped.
+func (p DenomPolicy) HasSupplyCap() bool {
+	return !p.SupplyCap.IsNil() && p.SupplyCap.IsPositive()
+}
+
+// CanMint returns whether the given module is allowed to mint the denom.
+func (p DenomPolicy) CanMint(moduleName string) bool {
+	return len(p.Minters) == 0 || containsString(p.Minters, moduleName)
+}
+
+// CanBurn returns whether the given module is allowed to burn the denom.
+func (p DenomPolicy) CanBurn(moduleName string) bool {
+	return len(p.Burners) == 0 || containsString(p.Burners, moduleName)
+}
+
+// denomPolicyBypassKey is the context key of the DenomPolicy bypass flag.
+type denomPolicyBypassKey struct{}
+
+// WithDenomPolicyBypass returns a new context that causes the DenomPolicy
+// checks to be skipped, for the protocol flows which cannot fail, e.g. the
+// minting of inflation or the transfers between module accounts.
+func WithDenomPolicyBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(denomPolicyBypassKey{}, true)
+}
+
+// HasDenomPolicyBypass checks the context to see if the DenomPolicy checks
+// should be skipped.
+func HasDenomPolicyBypass(ctx sdk.Context) bool {
+	bypass, isBool := ctx.Value(denomPolicyBypassKey{}).(bool)
+	return isBool && bypass
+}
+
+func validateModuleNames(moduleNames []string) error {
+	seen := make(map[string]bool, len(moduleNames))
+	for _, name := range moduleNames {
+		if name == "" {
+			return fmt.Errorf("empty module name")
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate module name %s", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDenomPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy types.DenomPolicy
+		expErr string
+	}{
+		{"valid", types.NewDenomPolicy("foo", sdk.NewInt(10), []string{"mint"}, []string{"burner"}, false), ""},
+		{"no cap", types.NewDenomPolicy("foo", sdk.ZeroInt(), nil, nil, true), ""},
+		{"nil cap", types.DenomPolicy{Denom: "foo"}, ""},
+		{"invalid denom", types.NewDenomPolicy("", sdk.ZeroInt(), nil, nil, false), "invalid denom: "},
+		{"negative cap", types.NewDenomPolicy("foo", sdk.NewInt(-1), nil, nil, false), "negative supply cap for denom foo: -1"},
+		{"empty minter", types.NewDenomPolicy("foo", sdk.ZeroInt(), []string{""}, nil, false), "invalid minters for denom foo: empty module name"},
+		{"duplicate burner", types.NewDenomPolicy("foo", sdk.ZeroInt(), nil, []string{"a", "a"}, false), "invalid burners for denom foo: duplicate module name a"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestDenomPolicyPermissions(t *testing.T) {
+	policy := types.NewDenomPolicy("foo", sdk.ZeroInt(), nil, nil, false)
+	require.False(t, policy.HasSupplyCap())
+	require.True(t, policy.CanMint("any"))
+	require.True(t, policy.CanBurn("any"))
+
+	policy = types.NewDenomPolicy("foo", sdk.NewInt(1), []string{"mint"}, []string{"burner"}, false)
+	require.True(t, policy.HasSupplyCap())
+	require.True(t, policy.CanMint("mint"))
+	require.False(t, policy.CanMint("burner"))
+	require.True(t, policy.CanBurn("burner"))
+	require.False(t, policy.CanBurn("mint"))
+}
+
+func TestDenomPolicyBypass(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
+
+	require.False(t, types.HasDenomPolicyBypass(ctx))
+	require.True(t, types.HasDenomPolicyBypass(types.WithDenomPolicyBypass(ctx)))
+	require.False(t, types.HasBypass(types.WithDenomPolicyBypass(ctx)))
+}
@@ -14,4 +14,8 @@ var (
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = sdkerrors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = sdkerrors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrDenomPaused           = sdkerrors.Register(ModuleName, 10, "denom is paused")
+	ErrSupplyCapExceeded     = sdkerrors.Register(ModuleName, 11, "supply cap exceeded")
+	ErrMintNotAllowed        = sdkerrors.Register(ModuleName, 12, "module not allowed to mint denom")
+	ErrBurnNotAllowed        = sdkerrors.Register(ModuleName, 13, "module not allowed to burn denom")
 )
@@ -23,6 +23,7 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenPolicies := make(map[string]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -36,6 +37,16 @@ func (gs GenesisState) Validate() error {
 		seenSendEnabled[p.Denom] = true
 	}
 
+	for _, p := range gs.DenomPolicies {
+		if seenPolicies[p.Denom] {
+			return fmt.Errorf("duplicate denom policy found: '%s'", p.Denom)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenPolicies[p.Denom] = true
+	}
+
 	for _, balance := range gs.Balances {
 		if seenBalances[balance.Address] {
 			return fmt.Errorf("duplicate balance for address %s", balance.Address)
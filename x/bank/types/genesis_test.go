@@ -142,6 +142,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid denom policies",
+			GenesisState{
+				Params: DefaultParams(),
+				DenomPolicies: []DenomPolicy{
+					NewDenomPolicy("uatom", math.NewInt(1000), []string{"mint"}, nil, false),
+					NewDenomPolicy("ufoo", math.ZeroInt(), nil, nil, true),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate denom policies",
+			GenesisState{
+				Params: DefaultParams(),
+				DenomPolicies: []DenomPolicy{
+					NewDenomPolicy("uatom", math.NewInt(1000), nil, nil, false),
+					NewDenomPolicy("uatom", math.ZeroInt(), nil, nil, true),
+				},
+			},
+			true,
+		},
+		{
+			"invalid denom policy",
+			GenesisState{
+				Params:        DefaultParams(),
+				DenomPolicies: []DenomPolicy{NewDenomPolicy("uatom", math.NewInt(-1), nil, nil, false)},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -35,6 +35,9 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = []byte{0x05}
+
+	// DenomPolicyPrefix is the prefix for the supply policies of the denoms.
+	DenomPolicyPrefix = []byte{0x06}
 )
 
 const (
@@ -107,3 +110,8 @@ func ToBoolB(v bool) byte {
 	}
 	return FalseB
 }
+
+// CreateDenomPolicyKey creates the key of the supply policy of a denom.
+func CreateDenomPolicyKey(denom string) []byte {
+	return append(append([]byte{}, DenomPolicyPrefix...), []byte(denom)...)
+}
@@ -7,16 +7,19 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend           = "send"
-	TypeMsgMultiSend      = "multisend"
-	TypeMsgSetSendEnabled = "set-send-enabled"
-	TypeMsgUpdateParams   = "update_params"
+	TypeMsgSend             = "send"
+	TypeMsgMultiSend        = "multisend"
+	TypeMsgSetSendEnabled   = "set-send-enabled"
+	TypeMsgSetDenomPolicies = "set-denom-policies"
+	TypeMsgUpdateParams     = "update_params"
 )
 
 var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetSendEnabled{}
+	_ sdk.Msg = &MsgSetDenomPolicies{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -257,3 +260,57 @@ func (msg MsgSetSendEnabled) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgSetDenomPolicies Construct a message to set or remove one or more
+// DenomPolicy entries.
+func NewMsgSetDenomPolicies(authority string, denomPolicies []DenomPolicy, removePolicies []string) *MsgSetDenomPolicies {
+	return &MsgSetDenomPolicies{
+		Authority:      authority,
+		DenomPolicies:  denomPolicies,
+		RemovePolicies: removePolicies,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetDenomPolicies) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetDenomPolicies) Type() string { return TypeMsgSetDenomPolicies }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDenomPolicies) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetDenomPolicies.
+func (msg MsgSetDenomPolicies) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgSetDenomPolicies.
+func (msg MsgSetDenomPolicies) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := map[string]bool{}
+	for _, p := range msg.DenomPolicies {
+		if seen[p.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", p.Denom)
+		}
+		seen[p.Denom] = true
+		if err := p.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid DenomPolicy for %q: %s", p.Denom, err)
+		}
+	}
+	for _, denom := range msg.RemovePolicies {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid RemovePolicies denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("denom %q is both set and removed", denom)
+		}
+	}
+	return nil
+}
@@ -414,3 +414,79 @@ func TestMsgSetSendEnabledValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetDenomPoliciesGetSignBytes(t *testing.T) {
+	msg := NewMsgSetDenomPolicies("cartman", []DenomPolicy{NewDenomPolicy("kylecoin", sdk.NewInt(10), []string{"mint"}, nil, true)}, []string{"stancoin"})
+	expected := `{"type":"cosmos-sdk/MsgSetDenomPolicies","value":{"authority":"cartman","denom_policies":[{"denom":"kylecoin","minters":["mint"],"paused":true,"supply_cap":"10"}],"remove_policies":["stancoin"]}}`
+	actual := string(msg.GetSignBytes())
+	assert.Equal(t, expected, actual)
+}
+
+func TestMsgSetDenomPoliciesGetSigners(t *testing.T) {
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msg := NewMsgSetDenomPolicies(govModuleAddr.String(), nil, nil)
+	expected := []sdk.AccAddress{govModuleAddr}
+	actual := msg.GetSigners()
+	assert.Equal(t, expected, actual)
+}
+
+func TestMsgSetDenomPoliciesValidateBasic(t *testing.T) {
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	tests := []struct {
+		name string
+		msg  *MsgSetDenomPolicies
+		exp  string
+	}{
+		{
+			name: "valid",
+			msg: NewMsgSetDenomPolicies(govModuleAddr, []DenomPolicy{
+				NewDenomPolicy("somecoina", sdk.NewInt(10), []string{"mint"}, []string{"burner"}, false),
+				NewDenomPolicy("somecoinb", sdk.ZeroInt(), nil, nil, true),
+			}, []string{"somecoinc"}),
+			exp: "",
+		},
+		{
+			name: "bad authority",
+			msg:  NewMsgSetDenomPolicies("farva", nil, nil),
+			exp:  "invalid authority address: decoding bech32 failed: invalid bech32 string length 5: invalid address",
+		},
+		{
+			name: "duplicate denom",
+			msg: NewMsgSetDenomPolicies(govModuleAddr, []DenomPolicy{
+				NewDenomPolicy("copycoin", sdk.ZeroInt(), nil, nil, false),
+				NewDenomPolicy("copycoin", sdk.ZeroInt(), nil, nil, true),
+			}, nil),
+			exp: `duplicate denom entries found for "copycoin": invalid request`,
+		},
+		{
+			name: "invalid policy",
+			msg: NewMsgSetDenomPolicies(govModuleAddr, []DenomPolicy{
+				NewDenomPolicy("somecoina", sdk.ZeroInt(), []string{"mint", "mint"}, nil, false),
+			}, nil),
+			exp: `invalid DenomPolicy for "somecoina": invalid minters for denom somecoina: duplicate module name mint: invalid request`,
+		},
+		{
+			name: "bad denom to remove",
+			msg:  NewMsgSetDenomPolicies(govModuleAddr, nil, []string{"Not A Denom"}),
+			exp:  `invalid RemovePolicies denom "Not A Denom": invalid denom: Not A Denom: invalid request`,
+		},
+		{
+			name: "denom set and removed",
+			msg: NewMsgSetDenomPolicies(govModuleAddr, []DenomPolicy{
+				NewDenomPolicy("somecoina", sdk.ZeroInt(), nil, nil, false),
+			}, []string{"somecoina"}),
+			exp: `denom "somecoina" is both set and removed: invalid request`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualError(tt, actual, tc.exp)
+			} else {
+				require.NoError(tt, actual)
+			}
+		})
+	}
+}
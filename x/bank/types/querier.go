@@ -35,6 +35,14 @@ func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageReques
 	return &QuerySpendableBalancesRequest{Address: addr.String(), Pagination: req}
 }
 
+// NewQueryBalanceHistoryRequest creates a new instance of a
+// QueryBalanceHistoryRequest.
+//
+//nolint:interfacer
+func NewQueryBalanceHistoryRequest(addr sdk.AccAddress, denom string, fromHeight, toHeight int64, req *query.PageRequest) *QueryBalanceHistoryRequest {
+	return &QueryBalanceHistoryRequest{Address: addr.String(), Denom: denom, FromHeight: fromHeight, ToHeight: toHeight, Pagination: req}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
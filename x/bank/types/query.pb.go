@@ -1073,6 +1073,231 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDenomPoliciesRequest defines the RPC request for looking up DenomPolicy
+// entries.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomPoliciesRequest struct {
+	// denoms is the specific denoms you want look up. Leave empty to get all entries.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines an optional pagination for the request. It is ignored
+	// when denoms are given.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomPoliciesRequest) Reset()         { *m = QueryDenomPoliciesRequest{} }
+func (m *QueryDenomPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoliciesRequest) ProtoMessage()    {}
+func (*QueryDenomPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{21}
+}
+func (m *QueryDenomPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoliciesRequest.Merge(m, src)
+}
+func (m *QueryDenomPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomPoliciesRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryDenomPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomPoliciesResponse defines the RPC response of a DenomPolicies query.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomPoliciesResponse struct {
+	DenomPolicies []DenomPolicy `protobuf:"bytes,1,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomPoliciesResponse) Reset()         { *m = QueryDenomPoliciesResponse{} }
+func (m *QueryDenomPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPoliciesResponse) ProtoMessage()    {}
+func (*QueryDenomPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{22}
+}
+func (m *QueryDenomPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPoliciesResponse.Merge(m, src)
+}
+func (m *QueryDenomPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPoliciesResponse) GetDenomPolicies() []DenomPolicy {
+	if m != nil {
+		return m.DenomPolicies
+	}
+	return nil
+}
+
+func (m *QueryDenomPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBalanceHistoryRequest defines the RPC request for looking up the
+// balance changes of an address.
+//
+// Since: cosmos-sdk 0.47
+type QueryBalanceHistoryRequest struct {
+	// address is the address to query the balance changes for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the coin denom to query the balance changes for. Leave empty to
+	// get the changes of all the denoms.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_height is the first height, inclusive, of the changes to return.
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height, inclusive, of the changes to return. Leave
+	// empty to return the changes up to the latest height.
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBalanceHistoryRequest) Reset()         { *m = QueryBalanceHistoryRequest{} }
+func (m *QueryBalanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceHistoryRequest) ProtoMessage()    {}
+func (*QueryBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{23}
+}
+func (m *QueryBalanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceHistoryRequest.Merge(m, src)
+}
+func (m *QueryBalanceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceHistoryRequest proto.InternalMessageInfo
+
+// QueryBalanceHistoryResponse defines the RPC response of a BalanceHistory
+// query.
+//
+// Since: cosmos-sdk 0.47
+type QueryBalanceHistoryResponse struct {
+	// changes are the balance changes, ordered by denom then height.
+	Changes []BalanceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBalanceHistoryResponse) Reset()         { *m = QueryBalanceHistoryResponse{} }
+func (m *QueryBalanceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceHistoryResponse) ProtoMessage()    {}
+func (*QueryBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{24}
+}
+func (m *QueryBalanceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceHistoryResponse.Merge(m, src)
+}
+func (m *QueryBalanceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBalanceHistoryResponse) GetChanges() []BalanceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryBalanceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1095,82 +1320,98 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryDenomPoliciesRequest)(nil), "cosmos.bank.v1beta1.QueryDenomPoliciesRequest")
+	proto.RegisterType((*QueryDenomPoliciesResponse)(nil), "cosmos.bank.v1beta1.QueryDenomPoliciesResponse")
+	proto.RegisterType((*QueryBalanceHistoryRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceHistoryRequest")
+	proto.RegisterType((*QueryBalanceHistoryResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x04, 0xea, 0x24, 0xcf, 0x01, 0x89, 0x69, 0x80, 0x64, 0x43, 0xec, 0xb2, 0xa0, 0xe6,
-	0x83, 0x64, 0x37, 0x76, 0x10, 0x28, 0x48, 0x80, 0xe2, 0xf0, 0x71, 0x40, 0xa8, 0xc1, 0xe1, 0x84,
-	0x84, 0xac, 0xb5, 0x77, 0x30, 0x56, 0xec, 0x5d, 0xd7, 0xb3, 0xa6, 0x58, 0x51, 0x2f, 0x48, 0x48,
-	0x3d, 0x22, 0xd1, 0x13, 0x12, 0x22, 0x42, 0xe2, 0xfb, 0xc2, 0x81, 0x23, 0x7f, 0x40, 0x0e, 0x1c,
-	0xaa, 0x72, 0xe9, 0x09, 0x50, 0x82, 0x04, 0x7f, 0x06, 0xf2, 0xcc, 0x1b, 0xef, 0xda, 0x1e, 0x6f,
-	0x96, 0x60, 0x24, 0x38, 0x35, 0x3b, 0xf3, 0x3e, 0x7e, 0xbf, 0xdf, 0x9b, 0x99, 0xf7, 0x5c, 0xc8,
-	0x55, 0x7d, 0xde, 0xf4, 0xb9, 0x5d, 0x71, 0xbc, 0x43, 0xfb, 0xbd, 0x7c, 0x85, 0x05, 0x4e, 0xde,
-	0xbe, 0xde, 0x61, 0xed, 0xae, 0xd5, 0x6a, 0xfb, 0x81, 0x4f, 0x2f, 0x4b, 0x03, 0xab, 0x67, 0x60,
-	0xa1, 0x81, 0xb1, 0xde, 0xf7, 0xe2, 0x4c, 0x5a, 0xf7, 0x7d, 0x5b, 0x4e, 0xad, 0xee, 0x39, 0x41,
-	0xdd, 0xf7, 0x64, 0x00, 0x63, 0xbe, 0xe6, 0xd7, 0x7c, 0xf1, 0xa7, 0xdd, 0xfb, 0x0b, 0x57, 0x1f,
-	0xab, 0xf9, 0x7e, 0xad, 0xc1, 0x6c, 0xa7, 0x55, 0xb7, 0x1d, 0xcf, 0xf3, 0x03, 0xe1, 0xc2, 0x71,
-	0x37, 0x1b, 0x8d, 0xaf, 0x22, 0x57, 0xfd, 0xba, 0x37, 0xb2, 0x1f, 0x41, 0x2d, 0x10, 0xca, 0xfd,
-	0x45, 0xb9, 0x5f, 0x96, 0x69, 0x91, 0x81, 0xdc, 0x5a, 0x42, 0x57, 0x85, 0x3a, 0x4a, 0xd6, 0xac,
-	0xc3, 0xe5, 0x37, 0x7a, 0x9f, 0x45, 0xa7, 0xe1, 0x78, 0x55, 0x56, 0x62, 0xd7, 0x3b, 0x8c, 0x07,
-	0xb4, 0x00, 0xd3, 0x8e, 0xeb, 0xb6, 0x19, 0xe7, 0x0b, 0xe4, 0x0a, 0x59, 0x9d, 0x2d, 0x2e, 0xdc,
-	0xfd, 0x61, 0x73, 0x1e, 0xc3, 0xee, 0xca, 0x9d, 0x83, 0xa0, 0x5d, 0xf7, 0x6a, 0x25, 0x65, 0x48,
-	0xe7, 0xe1, 0x92, 0xcb, 0x3c, 0xbf, 0xb9, 0x30, 0xd5, 0xf3, 0x28, 0xc9, 0x8f, 0xe7, 0x66, 0x6e,
-	0x1d, 0xe7, 0x52, 0x7f, 0x1e, 0xe7, 0x52, 0xe6, 0x6b, 0x30, 0x3f, 0x98, 0x8a, 0xb7, 0x7c, 0x8f,
-	0x33, 0xba, 0x0d, 0xd3, 0x15, 0xb9, 0x24, 0x72, 0x65, 0x0a, 0x8b, 0x56, 0xbf, 0x02, 0x9c, 0xa9,
-	0x0a, 0x58, 0x7b, 0x7e, 0xdd, 0x2b, 0x29, 0x4b, 0xf3, 0x33, 0x02, 0x8f, 0x8a, 0x68, 0xbb, 0x8d,
-	0x06, 0x06, 0xe4, 0xff, 0x04, 0xfc, 0x2b, 0x00, 0x61, 0x1d, 0x05, 0x83, 0x4c, 0xe1, 0xea, 0x00,
-	0x0e, 0xa9, 0x9a, 0x42, 0xb3, 0xef, 0xd4, 0x94, 0x58, 0xa5, 0x88, 0x67, 0x84, 0xee, 0x4f, 0x04,
-	0x16, 0x46, 0x11, 0x22, 0xe7, 0x1a, 0xcc, 0x20, 0x93, 0x1e, 0xc6, 0xfb, 0x62, 0x49, 0x17, 0xb7,
-	0x4e, 0x7e, 0xc9, 0xa5, 0xbe, 0xfd, 0x35, 0xb7, 0x5a, 0xab, 0x07, 0xef, 0x76, 0x2a, 0x56, 0xd5,
-	0x6f, 0x62, 0x85, 0xf1, 0x9f, 0x4d, 0xee, 0x1e, 0xda, 0x41, 0xb7, 0xc5, 0xb8, 0x70, 0xe0, 0xa5,
-	0x7e, 0x70, 0xfa, 0xaa, 0x86, 0xd7, 0xca, 0xb9, 0xbc, 0x24, 0xca, 0x28, 0x31, 0xf3, 0x0b, 0x02,
-	0xcb, 0x82, 0xce, 0x41, 0x8b, 0x79, 0xae, 0x53, 0x69, 0xb0, 0xff, 0xa6, 0xec, 0x77, 0x09, 0x64,
-	0xc7, 0xe1, 0xfc, 0xdf, 0x8a, 0x7f, 0x88, 0x87, 0xfd, 0x4d, 0x3f, 0x70, 0x1a, 0x07, 0x9d, 0x56,
-	0xab, 0xd1, 0x55, 0xaa, 0x0f, 0x2a, 0x48, 0x26, 0xa0, 0xe0, 0x89, 0x3a, 0xb8, 0x03, 0xd9, 0x50,
-	0xbb, 0x2a, 0xa4, 0xb9, 0x58, 0xf9, 0x37, 0x94, 0xc3, 0xd0, 0x93, 0xd3, 0x6d, 0x03, 0x9f, 0x1c,
-	0x49, 0xe2, 0xda, 0x3b, 0x4a, 0xb4, 0xfe, 0x53, 0x45, 0x22, 0x4f, 0x95, 0xb9, 0x0f, 0x0f, 0x0f,
-	0x59, 0x23, 0xe9, 0x67, 0x21, 0xed, 0x34, 0xfd, 0x8e, 0x17, 0x9c, 0xfb, 0x40, 0x15, 0xef, 0xef,
-	0x91, 0x2e, 0xa1, 0xb9, 0x39, 0x0f, 0x54, 0x44, 0xdc, 0x77, 0xda, 0x4e, 0x53, 0x5d, 0x14, 0x73,
-	0x1f, 0xdf, 0x5c, 0xb5, 0x8a, 0x59, 0x76, 0x20, 0xdd, 0x12, 0x2b, 0x98, 0x65, 0xc9, 0xd2, 0x34,
-	0x22, 0x4b, 0x3a, 0xa9, 0x3c, 0xd2, 0xc1, 0x74, 0xc1, 0x10, 0x11, 0x5f, 0xea, 0xf1, 0xe0, 0xaf,
-	0xb3, 0xc0, 0x71, 0x9d, 0xc0, 0x99, 0xf0, 0x11, 0x31, 0xbf, 0x21, 0xb0, 0xa4, 0x4d, 0x83, 0x04,
-	0x76, 0x61, 0xb6, 0x89, 0x6b, 0xea, 0x62, 0x2d, 0x6b, 0x39, 0x28, 0x4f, 0x64, 0x11, 0x7a, 0x4d,
-	0xae, 0xf2, 0x79, 0x58, 0x0c, 0xa1, 0x0e, 0x0b, 0xa2, 0x2f, 0xff, 0xdb, 0x51, 0x11, 0x47, 0xc8,
-	0xbd, 0x08, 0x33, 0x0a, 0x26, 0x4a, 0x98, 0x88, 0x5b, 0xdf, 0xc9, 0xbc, 0x81, 0x77, 0x58, 0x84,
-	0xbf, 0x76, 0xc3, 0x63, 0x6d, 0x1e, 0x8b, 0x67, 0x52, 0x6f, 0xa3, 0x79, 0x04, 0x10, 0xe6, 0xbc,
-	0xd0, 0x2b, 0xbd, 0x13, 0x76, 0xe8, 0xa9, 0x64, 0x17, 0xa0, 0xdf, 0xa7, 0xbf, 0x52, 0x8f, 0xc9,
-	0x00, 0x6d, 0xd4, 0xb4, 0x08, 0x73, 0x82, 0x6a, 0xd9, 0x17, 0xeb, 0x78, 0x66, 0x72, 0x5a, 0x5d,
-	0x43, 0xff, 0x52, 0xc6, 0x0d, 0x63, 0x4d, 0xee, 0xc4, 0x74, 0xb1, 0x3e, 0x07, 0xcc, 0x73, 0x5f,
-	0xf6, 0x7a, 0x8d, 0xc3, 0x55, 0xf5, 0x79, 0x04, 0xd2, 0x22, 0xa5, 0x44, 0x38, 0x5b, 0xc2, 0xaf,
-	0xa1, 0x0a, 0x55, 0x2f, 0x5c, 0xa1, 0xaf, 0x95, 0x48, 0x03, 0xb9, 0x51, 0xa4, 0x3d, 0x98, 0xe3,
-	0xcc, 0x73, 0xcb, 0x4c, 0xae, 0xa3, 0x48, 0x57, 0xb4, 0x22, 0x45, 0xfd, 0x33, 0x3c, 0xfc, 0x18,
-	0x52, 0xa9, 0x7a, 0x61, 0x95, 0x0a, 0xf7, 0xe6, 0xe0, 0x92, 0x80, 0x4a, 0x3f, 0x25, 0x30, 0x8d,
-	0xad, 0x95, 0xae, 0x6a, 0xd1, 0x68, 0x06, 0x4b, 0x63, 0x2d, 0x81, 0xa5, 0x4c, 0x6b, 0x3e, 0x7f,
-	0xeb, 0x8f, 0xef, 0xd7, 0xc9, 0x07, 0x3f, 0xff, 0xfe, 0xf1, 0x54, 0x81, 0x6e, 0xd9, 0xfa, 0x01,
-	0x58, 0x76, 0x5a, 0xfb, 0x08, 0x8f, 0xea, 0x4d, 0xbb, 0xd2, 0x2d, 0xcb, 0xeb, 0x73, 0x4c, 0x20,
-	0x13, 0x19, 0xbd, 0xe8, 0xc6, 0xf8, 0xcc, 0xa3, 0x33, 0xa4, 0xb1, 0x99, 0xd0, 0x1a, 0xb1, 0x3e,
-	0x1d, 0x62, 0x5d, 0xa3, 0x2b, 0x09, 0xb1, 0xd2, 0x1f, 0x09, 0x3c, 0x34, 0x32, 0xa6, 0xd0, 0xc2,
-	0xf8, 0xd4, 0xe3, 0x66, 0x2f, 0x63, 0xfb, 0x6f, 0xf9, 0x20, 0xe8, 0x17, 0x42, 0xd0, 0xdb, 0x34,
-	0xaf, 0x05, 0xcd, 0x95, 0x73, 0x59, 0x03, 0xff, 0x36, 0x81, 0x4c, 0x64, 0x46, 0x88, 0x53, 0x78,
-	0x74, 0x70, 0x89, 0x53, 0x58, 0x33, 0x78, 0x98, 0xab, 0x21, 0xd8, 0x65, 0xba, 0xa4, 0x07, 0x2b,
-	0x61, 0xdc, 0x26, 0x30, 0xa3, 0x5a, 0x38, 0x8d, 0x39, 0x6f, 0x43, 0x43, 0x81, 0xb1, 0x9e, 0xc4,
-	0x14, 0xd1, 0xe4, 0x43, 0x34, 0x57, 0xe9, 0x93, 0x31, 0x68, 0xc2, 0xf3, 0xf8, 0x21, 0x81, 0xb4,
-	0x6c, 0xde, 0x74, 0x65, 0x7c, 0xa6, 0x81, 0x49, 0xc1, 0x58, 0x3d, 0xdf, 0x30, 0xb9, 0x3c, 0x72,
-	0x56, 0xa0, 0xdf, 0x11, 0x78, 0x60, 0xa0, 0xc5, 0x51, 0x6b, 0x7c, 0x16, 0x5d, 0xfb, 0x34, 0xec,
-	0xc4, 0xf6, 0x08, 0x6e, 0x27, 0x04, 0x67, 0xd1, 0x0d, 0x2d, 0x38, 0xf9, 0xa2, 0x96, 0x55, 0xb7,
-	0xb4, 0x8f, 0xc4, 0xc2, 0x4d, 0xfa, 0x25, 0x81, 0x07, 0x07, 0xc7, 0x0d, 0x7a, 0x5e, 0xfa, 0xe1,
-	0xf9, 0xc7, 0xd8, 0x4a, 0xee, 0x90, 0xbc, 0xbc, 0x43, 0x80, 0xe9, 0xe7, 0x04, 0x32, 0x91, 0x1e,
-	0x17, 0x77, 0x19, 0x46, 0x27, 0x80, 0xb8, 0xcb, 0xa0, 0x69, 0x9c, 0xe6, 0x33, 0x21, 0xbe, 0xa7,
-	0xe8, 0xda, 0x78, 0x7c, 0xd8, 0x58, 0xfb, 0x6a, 0x7e, 0x42, 0x20, 0x13, 0xe9, 0x11, 0x71, 0x20,
-	0x47, 0xdb, 0x60, 0x1c, 0x48, 0x4d, 0xe3, 0x32, 0xad, 0x10, 0xe4, 0x13, 0xf4, 0x71, 0xfd, 0x1d,
-	0x89, 0x34, 0xb6, 0xe2, 0xde, 0xc9, 0x69, 0x96, 0xdc, 0x39, 0xcd, 0x92, 0xdf, 0x4e, 0xb3, 0xe4,
-	0xa3, 0xb3, 0x6c, 0xea, 0xce, 0x59, 0x36, 0x75, 0xef, 0x2c, 0x9b, 0x7a, 0x6b, 0x2d, 0xf6, 0x17,
-	0xc4, 0xfb, 0x32, 0xa6, 0xf8, 0x21, 0x51, 0x49, 0x8b, 0xff, 0xd6, 0xd8, 0xfe, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0xae, 0xb4, 0xcb, 0xa9, 0xe6, 0x11, 0x00, 0x00,
+	// 1303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0xf4, 0xc3, 0x49, 0x5e, 0xb7, 0xfd, 0xe9, 0x37, 0x09, 0x34, 0xd9, 0x10, 0x3b, 0x6c,
+	0xa1, 0x71, 0x42, 0xbc, 0xeb, 0x38, 0x08, 0x14, 0x24, 0x40, 0x71, 0x80, 0x56, 0x42, 0x55, 0x83,
+	0xc3, 0x09, 0x09, 0x59, 0x6b, 0xef, 0xd6, 0x59, 0xc5, 0xde, 0x75, 0x3d, 0x1b, 0x8a, 0x15, 0xf5,
+	0x82, 0x84, 0xd4, 0x23, 0x12, 0x3d, 0x21, 0x21, 0xa2, 0x4a, 0x7c, 0xb5, 0x17, 0x0e, 0x3d, 0xf2,
+	0x07, 0xe4, 0xc0, 0xa1, 0x2a, 0x17, 0x4e, 0x80, 0x12, 0x24, 0xf8, 0x0b, 0x38, 0x23, 0xcf, 0xbc,
+	0xe3, 0x5d, 0xdb, 0xeb, 0xcd, 0x12, 0x0c, 0x82, 0x53, 0x32, 0x33, 0xef, 0xc7, 0xf3, 0x3e, 0xef,
+	0x7c, 0x3c, 0x6b, 0xc8, 0x54, 0x5d, 0xd6, 0x70, 0x99, 0x5e, 0x31, 0x9c, 0x1d, 0xfd, 0xbd, 0x95,
+	0x8a, 0xe5, 0x19, 0x2b, 0xfa, 0xcd, 0x5d, 0xab, 0xd5, 0xd6, 0x9a, 0x2d, 0xd7, 0x73, 0xe9, 0xa4,
+	0x30, 0xd0, 0x3a, 0x06, 0x1a, 0x1a, 0x28, 0x4b, 0x5d, 0x2f, 0x66, 0x09, 0xeb, 0xae, 0x6f, 0xd3,
+	0xa8, 0xd9, 0x8e, 0xe1, 0xd9, 0xae, 0x23, 0x02, 0x28, 0x53, 0x35, 0xb7, 0xe6, 0xf2, 0x7f, 0xf5,
+	0xce, 0x7f, 0x38, 0xfb, 0x54, 0xcd, 0x75, 0x6b, 0x75, 0x4b, 0x37, 0x9a, 0xb6, 0x6e, 0x38, 0x8e,
+	0xeb, 0x71, 0x17, 0x86, 0xab, 0xe9, 0x60, 0x7c, 0x19, 0xb9, 0xea, 0xda, 0xce, 0xc0, 0x7a, 0x00,
+	0x75, 0x67, 0x80, 0xeb, 0x33, 0x62, 0xbd, 0x2c, 0xd2, 0x8a, 0x01, 0x2e, 0xcd, 0xa2, 0xab, 0x44,
+	0x1d, 0x2c, 0x56, 0xb5, 0x61, 0xf2, 0xad, 0xce, 0xb0, 0x68, 0xd4, 0x0d, 0xa7, 0x6a, 0x95, 0xac,
+	0x9b, 0xbb, 0x16, 0xf3, 0x68, 0x01, 0xc6, 0x0c, 0xd3, 0x6c, 0x59, 0x8c, 0x4d, 0x93, 0x79, 0x92,
+	0x9d, 0x28, 0x4e, 0x3f, 0x7e, 0x98, 0x9b, 0xc2, 0xb0, 0xeb, 0x62, 0x65, 0xcb, 0x6b, 0xd9, 0x4e,
+	0xad, 0x24, 0x0d, 0xe9, 0x14, 0x9c, 0x35, 0x2d, 0xc7, 0x6d, 0x4c, 0x9f, 0xea, 0x78, 0x94, 0xc4,
+	0xe0, 0xa5, 0xf1, 0x3b, 0xfb, 0x99, 0xc4, 0x6f, 0xfb, 0x99, 0x84, 0xfa, 0x26, 0x4c, 0xf5, 0xa6,
+	0x62, 0x4d, 0xd7, 0x61, 0x16, 0x5d, 0x85, 0xb1, 0x8a, 0x98, 0xe2, 0xb9, 0x52, 0x85, 0x19, 0xad,
+	0xdb, 0x01, 0x66, 0xc9, 0x0e, 0x68, 0x1b, 0xae, 0xed, 0x94, 0xa4, 0xa5, 0xfa, 0x19, 0x81, 0x8b,
+	0x3c, 0xda, 0x7a, 0xbd, 0x8e, 0x01, 0xd9, 0x5f, 0x01, 0xff, 0x06, 0x80, 0xdf, 0x47, 0x5e, 0x41,
+	0xaa, 0x70, 0xb9, 0x07, 0x87, 0x60, 0x4d, 0xa2, 0xd9, 0x34, 0x6a, 0x92, 0xac, 0x52, 0xc0, 0x33,
+	0x50, 0xee, 0x77, 0x04, 0xa6, 0x07, 0x11, 0x62, 0xcd, 0x35, 0x18, 0xc7, 0x4a, 0x3a, 0x18, 0x4f,
+	0x47, 0x16, 0x5d, 0xcc, 0x1f, 0xfc, 0x98, 0x49, 0xdc, 0xff, 0x29, 0x93, 0xad, 0xd9, 0xde, 0xf6,
+	0x6e, 0x45, 0xab, 0xba, 0x0d, 0xec, 0x30, 0xfe, 0xc9, 0x31, 0x73, 0x47, 0xf7, 0xda, 0x4d, 0x8b,
+	0x71, 0x07, 0x56, 0xea, 0x06, 0xa7, 0x57, 0x42, 0xea, 0x5a, 0x38, 0xb6, 0x2e, 0x81, 0x32, 0x58,
+	0x98, 0xfa, 0x39, 0x81, 0x39, 0x5e, 0xce, 0x56, 0xd3, 0x72, 0x4c, 0xa3, 0x52, 0xb7, 0xfe, 0x9d,
+	0xb4, 0x3f, 0x26, 0x90, 0x1e, 0x86, 0xf3, 0x3f, 0x4b, 0xfe, 0x0e, 0x6e, 0xf6, 0xb7, 0x5d, 0xcf,
+	0xa8, 0x6f, 0xed, 0x36, 0x9b, 0xf5, 0xb6, 0x64, 0xbd, 0x97, 0x41, 0x32, 0x02, 0x06, 0x0f, 0xe4,
+	0xc6, 0xed, 0xc9, 0x86, 0xdc, 0x55, 0x21, 0xc9, 0xf8, 0xcc, 0xdf, 0xc1, 0x1c, 0x86, 0x1e, 0x1d,
+	0x6f, 0xcb, 0x78, 0xe5, 0x88, 0x22, 0xae, 0xdf, 0x90, 0xa4, 0x75, 0xaf, 0x2a, 0x12, 0xb8, 0xaa,
+	0xd4, 0x4d, 0x78, 0xa2, 0xcf, 0x1a, 0x8b, 0x7e, 0x11, 0x92, 0x46, 0xc3, 0xdd, 0x75, 0xbc, 0x63,
+	0x2f, 0xa8, 0xe2, 0x99, 0x4e, 0xd1, 0x25, 0x34, 0x57, 0xa7, 0x80, 0xf2, 0x88, 0x9b, 0x46, 0xcb,
+	0x68, 0xc8, 0x83, 0xa2, 0x6e, 0xc2, 0x64, 0xcf, 0x2c, 0x66, 0x59, 0x83, 0x64, 0x93, 0xcf, 0x60,
+	0x96, 0x59, 0x2d, 0xe4, 0x21, 0xd2, 0x84, 0x93, 0xcc, 0x23, 0x1c, 0x54, 0x13, 0x14, 0x1e, 0xf1,
+	0xb5, 0x4e, 0x1d, 0xec, 0x9a, 0xe5, 0x19, 0xa6, 0xe1, 0x19, 0x23, 0xde, 0x22, 0xea, 0xd7, 0x04,
+	0x66, 0x43, 0xd3, 0x60, 0x01, 0xeb, 0x30, 0xd1, 0xc0, 0x39, 0x79, 0xb0, 0xe6, 0x42, 0x6b, 0x90,
+	0x9e, 0x58, 0x85, 0xef, 0x35, 0xba, 0xce, 0xaf, 0xc0, 0x8c, 0x0f, 0xb5, 0x9f, 0x90, 0xf0, 0xf6,
+	0xbf, 0x0b, 0x4a, 0x98, 0x0b, 0x16, 0xf7, 0x2a, 0x8c, 0x4b, 0x98, 0x48, 0x61, 0xac, 0xda, 0xba,
+	0x4e, 0xea, 0x2d, 0xb8, 0xe8, 0x87, 0xbf, 0x7e, 0xcb, 0xb1, 0x5a, 0x2c, 0x12, 0xcf, 0xa8, 0xee,
+	0x46, 0x75, 0x0f, 0xc0, 0xcf, 0x79, 0xa2, 0x5b, 0x7a, 0xcd, 0x7f, 0xa1, 0x4f, 0xc5, 0x3b, 0x00,
+	0xdd, 0x77, 0xfa, 0x4b, 0x79, 0x99, 0xf4, 0x94, 0x8d, 0x9c, 0x16, 0xe1, 0x1c, 0x2f, 0xb5, 0xec,
+	0xf2, 0x79, 0xdc, 0x33, 0x99, 0x50, 0x5e, 0x7d, 0xff, 0x52, 0xca, 0xf4, 0x63, 0x8d, 0x6e, 0xc7,
+	0xb4, 0xb1, 0x3f, 0x5b, 0x96, 0x63, 0xbe, 0xee, 0x74, 0x1e, 0x0e, 0x53, 0xf6, 0xe7, 0x49, 0x48,
+	0xf2, 0x94, 0x02, 0xe1, 0x44, 0x09, 0x47, 0x7d, 0x1d, 0xaa, 0x9e, 0xb8, 0x43, 0x5f, 0x49, 0x92,
+	0x7a, 0x72, 0x23, 0x49, 0x1b, 0x70, 0x8e, 0x59, 0x8e, 0x59, 0xb6, 0xc4, 0x3c, 0x92, 0x34, 0x1f,
+	0x4a, 0x52, 0xd0, 0x3f, 0xc5, 0xfc, 0x01, 0xbd, 0x12, 0x82, 0xf4, 0x44, 0x2c, 0xed, 0x05, 0xcf,
+	0xd5, 0xa6, 0x5b, 0xb7, 0xab, 0xb6, 0xc5, 0xfe, 0x29, 0x9e, 0x1e, 0x12, 0x50, 0xc2, 0xb2, 0x23,
+	0x53, 0xd7, 0xe0, 0x82, 0xd8, 0x4e, 0x4d, 0x5c, 0x89, 0xe4, 0xca, 0x8f, 0xd1, 0xc6, 0x4d, 0x7b,
+	0xde, 0x0c, 0x86, 0x1d, 0x1d, 0x67, 0xbf, 0x4b, 0xd8, 0xa8, 0x44, 0xae, 0xda, 0xcc, 0x73, 0x5b,
+	0xed, 0x91, 0x6b, 0x6d, 0x9a, 0x81, 0xd4, 0x8d, 0x96, 0xdb, 0x28, 0x6f, 0x5b, 0x76, 0x6d, 0xdb,
+	0x9b, 0x3e, 0x3d, 0x4f, 0xb2, 0xa7, 0x4b, 0xd0, 0x99, 0xba, 0xca, 0x67, 0xe8, 0x2c, 0x4c, 0x78,
+	0xae, 0x5c, 0x3e, 0xc3, 0x97, 0xc7, 0x3d, 0x17, 0x17, 0x47, 0xd4, 0xa5, 0x80, 0x92, 0xb8, 0x2f,
+	0x1f, 0x8c, 0xfe, 0xc2, 0xbb, 0xe7, 0x7f, 0xac, 0xba, 0x6d, 0x38, 0xb5, 0x6e, 0xa7, 0xd4, 0xd0,
+	0x4e, 0xa1, 0xf7, 0x06, 0x37, 0x95, 0x17, 0x0c, 0x3a, 0x8e, 0xac, 0x4b, 0x85, 0x07, 0xff, 0x83,
+	0xb3, 0x1c, 0x2c, 0xfd, 0x94, 0xc0, 0x18, 0xe6, 0xa4, 0xd9, 0x50, 0x44, 0x21, 0x9f, 0x4c, 0xca,
+	0x62, 0x0c, 0x4b, 0x91, 0x56, 0x7d, 0xf9, 0xce, 0xaf, 0xdf, 0x2c, 0x91, 0x0f, 0xbe, 0xff, 0xe5,
+	0xe3, 0x53, 0x05, 0x9a, 0xd7, 0xc3, 0x3f, 0xed, 0xb8, 0x0b, 0xd3, 0xf7, 0xb0, 0xe5, 0xb7, 0xf5,
+	0x4a, 0xbb, 0x2c, 0xda, 0xbc, 0x4f, 0x20, 0x15, 0xf8, 0xa8, 0xa0, 0xcb, 0xc3, 0x33, 0x0f, 0x7e,
+	0x1d, 0x29, 0xb9, 0x98, 0xd6, 0x88, 0xf5, 0x79, 0x1f, 0xeb, 0x22, 0x5d, 0x88, 0x89, 0x95, 0x7e,
+	0x4b, 0xe0, 0xff, 0x03, 0x02, 0x9c, 0x16, 0x86, 0xa7, 0x1e, 0xf6, 0x55, 0xa1, 0xac, 0xfe, 0x29,
+	0x1f, 0x04, 0xfd, 0x8a, 0x0f, 0x7a, 0x95, 0xae, 0x84, 0x82, 0x66, 0xd2, 0xb9, 0x1c, 0x02, 0xff,
+	0x2e, 0x81, 0x54, 0x40, 0xfd, 0x46, 0x31, 0x3c, 0x28, 0xc9, 0x95, 0x5c, 0x4c, 0x6b, 0x04, 0x9b,
+	0xf5, 0xc1, 0xce, 0xd1, 0xd9, 0x70, 0xb0, 0x02, 0xc6, 0x5d, 0x02, 0xe3, 0x52, 0x9c, 0xd2, 0x88,
+	0xfd, 0xd6, 0x27, 0x77, 0x95, 0xa5, 0x38, 0xa6, 0x88, 0x66, 0xc5, 0x47, 0x73, 0x99, 0x3e, 0x13,
+	0x81, 0xc6, 0xdf, 0x8f, 0x1f, 0x12, 0x48, 0x0a, 0x59, 0x4a, 0x17, 0x86, 0x67, 0xea, 0xd1, 0xc0,
+	0x4a, 0xf6, 0x78, 0xc3, 0xf8, 0xf4, 0x08, 0x15, 0x4c, 0x1f, 0x10, 0x38, 0xdf, 0x23, 0xde, 0xa8,
+	0x36, 0x3c, 0x4b, 0x98, 0x30, 0x54, 0xf4, 0xd8, 0xf6, 0x08, 0x6e, 0xcd, 0x07, 0xa7, 0xd1, 0xe5,
+	0x50, 0x70, 0xe2, 0x0d, 0x2c, 0x4b, 0x1d, 0xa8, 0xef, 0xf1, 0x89, 0xdb, 0xf4, 0x0b, 0x02, 0x17,
+	0x7a, 0x85, 0x34, 0x3d, 0x2e, 0x7d, 0xbf, 0xb2, 0x57, 0xf2, 0xf1, 0x1d, 0xe2, 0xb7, 0xb7, 0x0f,
+	0x30, 0xbd, 0x47, 0x20, 0x15, 0x50, 0x6f, 0x51, 0x87, 0x61, 0x50, 0xdb, 0x2a, 0xb9, 0x98, 0xd6,
+	0x88, 0xef, 0x05, 0x1f, 0xdf, 0x73, 0x74, 0x71, 0x38, 0x3e, 0x94, 0x8c, 0x5d, 0x36, 0x3f, 0x21,
+	0x90, 0x0a, 0xa8, 0x9f, 0x28, 0x90, 0x83, 0x02, 0x4f, 0xc9, 0xc5, 0xb4, 0x46, 0x90, 0x9a, 0x0f,
+	0xf2, 0x12, 0x7d, 0x3a, 0xfc, 0x8c, 0x04, 0x24, 0x1b, 0xbd, 0x27, 0x37, 0x66, 0x57, 0x5b, 0x1c,
+	0xb7, 0x31, 0xfb, 0x94, 0x95, 0xa2, 0xc7, 0xb6, 0x47, 0x88, 0x79, 0x1f, 0xe2, 0xb3, 0xf4, 0x52,
+	0x04, 0x8f, 0x52, 0x2b, 0x75, 0x4e, 0xcf, 0x85, 0xde, 0x77, 0x3a, 0x6a, 0x3f, 0x86, 0x4a, 0x19,
+	0x25, 0x1f, 0xdf, 0x41, 0xf6, 0x9b, 0x43, 0xcc, 0x53, 0x2d, 0xea, 0x65, 0x29, 0x6f, 0x0b, 0x2f,
+	0xff, 0x86, 0x2e, 0x6e, 0x1c, 0x1c, 0xa6, 0xc9, 0xa3, 0xc3, 0x34, 0xf9, 0xf9, 0x30, 0x4d, 0x3e,
+	0x3a, 0x4a, 0x27, 0x1e, 0x1d, 0xa5, 0x13, 0x3f, 0x1c, 0xa5, 0x13, 0xef, 0x2c, 0x46, 0xfe, 0xdc,
+	0xf0, 0xbe, 0x48, 0xc0, 0x7f, 0x75, 0xa8, 0x24, 0xf9, 0x6f, 0xa0, 0xab, 0x7f, 0x0c, 0x00, 0xe3,
+	0x67, 0x57, 0x6e, 0x13, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1218,6 +1459,22 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// DenomPolicies queries for the supply policies of denoms.
+	//
+	// This query only returns the denoms that have a policy, the other ones
+	// being minted, burned and sent without restriction.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomPolicies(ctx context.Context, in *QueryDenomPoliciesRequest, opts ...grpc.CallOption) (*QueryDenomPoliciesResponse, error)
+	// BalanceHistory queries the changes of the balances of an address over a
+	// range of heights, without requiring an archive node.
+	//
+	// The changes are read from the balance history index, written by the node
+	// as blocks are committed. The query fails on nodes that don't run the index,
+	// and its result depends on the height from which the node started it.
+	//
+	// Since: cosmos-sdk 0.47
+	BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1318,6 +1575,24 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) DenomPolicies(ctx context.Context, in *QueryDenomPoliciesRequest, opts ...grpc.CallOption) (*QueryDenomPoliciesResponse, error) {
+	out := new(QueryDenomPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error) {
+	out := new(QueryBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/BalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1353,6 +1628,22 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// DenomPolicies queries for the supply policies of denoms.
+	//
+	// This query only returns the denoms that have a policy, the other ones
+	// being minted, burned and sent without restriction.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomPolicies(context.Context, *QueryDenomPoliciesRequest) (*QueryDenomPoliciesResponse, error)
+	// BalanceHistory queries the changes of the balances of an address over a
+	// range of heights, without requiring an archive node.
+	//
+	// The changes are read from the balance history index, written by the node
+	// as blocks are committed. The query fails on nodes that don't run the index,
+	// and its result depends on the height from which the node started it.
+	//
+	// Since: cosmos-sdk 0.47
+	BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1389,6 +1680,12 @@ func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) DenomPolicies(ctx context.Context, req *QueryDenomPoliciesRequest) (*QueryDenomPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPolicies not implemented")
+}
+func (*UnimplementedQueryServer) BalanceHistory(ctx context.Context, req *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1574,6 +1871,42 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPolicies(ctx, req.(*QueryDenomPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/BalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceHistory(ctx, req.(*QueryBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1618,6 +1951,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "DenomPolicies",
+			Handler:    _Query_DenomPolicies_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _Query_BalanceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2455,37 +2796,246 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryDenomPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2789,6 +3339,90 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4937,6 +5571,552 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, BalanceChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
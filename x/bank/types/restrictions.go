@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It is called for every transfer of coins between accounts, including module
+// accounts, before the coins are moved. Returning an error aborts the transfer,
+// the returned address is the one actually receiving the coins.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a no-op SendRestrictionFn.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one, the second one receiving the address returned by the first.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple send restrictions into one,
+// running them in the given order. Nil restrictions are ignored. The
+// composition returns the first error encountered, if any.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+		return toAddr, nil
+	}
+}
+
+// bypassKey is the context key of the send restrictions bypass flag.
+type bypassKey struct{}
+
+// WithBypass returns a new context that causes the send restrictions to be
+// skipped, e.g. for transfers made by a module which must not be blocked.
+func WithBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bypassKey{}, true)
+}
+
+// WithoutBypass returns a new context that causes the send restrictions to
+// be applied.
+func WithoutBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bypassKey{}, false)
+}
+
+// HasBypass checks the context to see if the send restrictions should be
+// skipped.
+func HasBypass(ctx sdk.Context) bool {
+	bypassValue := ctx.Value(bypassKey{})
+	if bypassValue == nil {
+		return false
+	}
+	bypass, isBool := bypassValue.(bool)
+	return isBool && bypass
+}
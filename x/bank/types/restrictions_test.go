@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var newAddr = sdk.AccAddress("new_________________")
+
+// recordingRestriction returns a SendRestrictionFn which records its name and
+// the recipient it gets, then returns the given recipient, if not nil, and
+// error.
+func recordingRestriction(calls *[]string, name string, recipient sdk.AccAddress, err error) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name+":"+string(to))
+		if recipient == nil {
+			return to, err
+		}
+		return recipient, err
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	ctx := sdk.Context{}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	var calls []string
+	single := recordingRestriction(&calls, "a", nil, nil)
+	addr, err := types.ComposeSendRestrictions(nil, single, nil)(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, addr)
+	require.Equal(t, []string{"a:" + string(toAddr)}, calls)
+
+	// the recipient returned by a restriction is passed to the next one
+	calls = nil
+	composed := recordingRestriction(&calls, "a", newAddr, nil).
+		Then(recordingRestriction(&calls, "b", nil, nil))
+	addr, err = composed(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, newAddr, addr)
+	require.Equal(t, []string{"a:" + string(toAddr), "b:" + string(newAddr)}, calls)
+
+	// the first error stops the composition
+	calls = nil
+	composed = types.ComposeSendRestrictions(
+		recordingRestriction(&calls, "a", nil, errors.New("a failed")),
+		recordingRestriction(&calls, "b", nil, nil),
+	)
+	_, err = composed(ctx, fromAddr, toAddr, coins)
+	require.EqualError(t, err, "a failed")
+	require.Equal(t, []string{"a:" + string(toAddr)}, calls)
+
+	addr, err = types.NoOpSendRestrictionFn(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, addr)
+}
+
+func TestSendRestrictionBypass(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
+
+	require.False(t, types.HasBypass(ctx))
+	require.True(t, types.HasBypass(types.WithBypass(ctx)))
+	require.False(t, types.HasBypass(types.WithoutBypass(types.WithBypass(ctx))))
+}
@@ -411,6 +411,116 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgSetDenomPolicies is the Msg/SetDenomPolicies request type.
+//
+// Only entries to add/update/delete need to be included.
+// Existing DenomPolicy entries that are not included in this
+// message are left unchanged.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetDenomPolicies struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom_policies is the list of policies to add or update.
+	DenomPolicies []DenomPolicy `protobuf:"bytes,2,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
+	// remove_policies is a list of denoms whose policies should be deleted,
+	// lifting all the restrictions on them. If a denom is included that doesn't
+	// have a policy, it will be ignored.
+	RemovePolicies []string `protobuf:"bytes,3,rep,name=remove_policies,json=removePolicies,proto3" json:"remove_policies,omitempty"`
+}
+
+func (m *MsgSetDenomPolicies) Reset()         { *m = MsgSetDenomPolicies{} }
+func (m *MsgSetDenomPolicies) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPolicies) ProtoMessage()    {}
+func (*MsgSetDenomPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgSetDenomPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPolicies.Merge(m, src)
+}
+func (m *MsgSetDenomPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomPolicies proto.InternalMessageInfo
+
+func (m *MsgSetDenomPolicies) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDenomPolicies) GetDenomPolicies() []DenomPolicy {
+	if m != nil {
+		return m.DenomPolicies
+	}
+	return nil
+}
+
+func (m *MsgSetDenomPolicies) GetRemovePolicies() []string {
+	if m != nil {
+		return m.RemovePolicies
+	}
+	return nil
+}
+
+// MsgSetDenomPoliciesResponse defines the Msg/SetDenomPolicies response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetDenomPoliciesResponse struct {
+}
+
+func (m *MsgSetDenomPoliciesResponse) Reset()         { *m = MsgSetDenomPoliciesResponse{} }
+func (m *MsgSetDenomPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPoliciesResponse) ProtoMessage()    {}
+func (*MsgSetDenomPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgSetDenomPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPoliciesResponse.Merge(m, src)
+}
+func (m *MsgSetDenomPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomPoliciesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -420,52 +530,59 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgSetDenomPolicies)(nil), "cosmos.bank.v1beta1.MsgSetDenomPolicies")
+	proto.RegisterType((*MsgSetDenomPoliciesResponse)(nil), "cosmos.bank.v1beta1.MsgSetDenomPoliciesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb7, 0xdb, 0x2a, 0x55, 0xae, 0xf9, 0xb6, 0xaa, 0xbf, 0x11, 0x4d, 0xdc, 0xca, 0x09, 0x11,
-	0xaa, 0x52, 0x44, 0x6d, 0x52, 0x24, 0x7e, 0xa4, 0x13, 0x09, 0x20, 0x81, 0x14, 0x81, 0x5c, 0x31,
-	0xc0, 0x12, 0xd9, 0xf1, 0xc5, 0xb1, 0x1a, 0xfb, 0x2c, 0xdf, 0xb9, 0x6a, 0x57, 0x26, 0x46, 0x06,
-	0xc4, 0xdc, 0x99, 0x89, 0x81, 0x3f, 0x22, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xe0, 0x6f, 0x60,
-	0x01, 0xf9, 0xee, 0xec, 0xb8, 0x4d, 0xd2, 0x54, 0x4c, 0xb6, 0xee, 0xf3, 0xe3, 0x7d, 0xde, 0xbb,
-	0xa7, 0x03, 0x5b, 0x1d, 0x84, 0x5d, 0x84, 0x35, 0xd3, 0xf0, 0x0e, 0xb5, 0xa3, 0x9a, 0x09, 0x89,
-	0x51, 0xd3, 0xc8, 0xb1, 0xea, 0x07, 0x88, 0x20, 0xe9, 0x7f, 0x86, 0xaa, 0x11, 0xaa, 0x72, 0x54,
-	0xce, 0xdb, 0xc8, 0x46, 0x14, 0xd7, 0xa2, 0x3f, 0x46, 0x95, 0x95, 0xc4, 0x08, 0xc3, 0xc4, 0xa8,
-	0x83, 0x1c, 0x6f, 0x02, 0x4f, 0x15, 0xa2, 0xbe, 0x0c, 0x2f, 0x32, 0xbc, 0xcd, 0x8c, 0x79, 0x5d,
-	0x06, 0x6d, 0x70, 0xa9, 0x8b, 0x6d, 0xed, 0xa8, 0x16, 0x7d, 0x18, 0x50, 0xf9, 0x23, 0x82, 0xe5,
-	0x16, 0xb6, 0x0f, 0xa0, 0x67, 0x49, 0xfb, 0x20, 0xd7, 0x0d, 0x90, 0xdb, 0x36, 0x2c, 0x2b, 0x80,
-	0x18, 0x17, 0xc4, 0xb2, 0x58, 0xcd, 0x36, 0x0a, 0x5f, 0x3e, 0xef, 0xe6, 0xb9, 0xd9, 0x43, 0x86,
-	0x1c, 0x90, 0xc0, 0xf1, 0x6c, 0x7d, 0x25, 0x62, 0xf3, 0x23, 0xe9, 0x1e, 0x00, 0x04, 0x25, 0xd2,
-	0x85, 0x39, 0xd2, 0x2c, 0x41, 0xb1, 0xb0, 0x03, 0x32, 0x86, 0x8b, 0x42, 0x8f, 0x14, 0x16, 0xcb,
-	0x8b, 0xd5, 0x95, 0xbd, 0xa2, 0x9a, 0x4c, 0x0c, 0xc3, 0x78, 0x62, 0x6a, 0x13, 0x39, 0x5e, 0xe3,
-	0xf6, 0xe0, 0x5b, 0x49, 0xf8, 0xf8, 0xbd, 0x54, 0xb5, 0x1d, 0xd2, 0x0b, 0x4d, 0xb5, 0x83, 0x5c,
-	0xde, 0x26, 0xff, 0xec, 0x62, 0xeb, 0x50, 0x23, 0x27, 0x3e, 0xc4, 0x54, 0x80, 0x75, 0x6e, 0x5d,
-	0x2f, 0xbe, 0x3d, 0x2d, 0x09, 0xbf, 0x4e, 0x4b, 0xc2, 0x9b, 0x9f, 0x9f, 0x6e, 0x9e, 0xeb, 0xb2,
-	0xb2, 0x0e, 0xd6, 0xf8, 0x00, 0x74, 0x88, 0x7d, 0xe4, 0x61, 0x58, 0xf9, 0x20, 0x82, 0x5c, 0x0b,
-	0xdb, 0xad, 0xb0, 0x4f, 0x1c, 0x3a, 0x99, 0xfb, 0x20, 0xe3, 0x78, 0x7e, 0x48, 0xa2, 0x99, 0x44,
-	0x19, 0x65, 0x75, 0xca, 0xad, 0xaa, 0x4f, 0x23, 0x4a, 0x63, 0x29, 0x0a, 0xa9, 0x73, 0xbe, 0xb4,
-	0x0f, 0x96, 0x51, 0x48, 0xa8, 0x74, 0x81, 0x4a, 0x37, 0xa7, 0x4a, 0x9f, 0x53, 0x0e, 0xd7, 0xc6,
-	0x8a, 0xfa, 0x5a, 0x9c, 0x98, 0xbb, 0x55, 0xae, 0x81, 0x7c, 0x3a, 0x57, 0x12, 0xf8, 0xbd, 0x48,
-	0x9b, 0x78, 0xe9, 0x5b, 0x06, 0x81, 0x2f, 0x8c, 0xc0, 0x70, 0xb1, 0x74, 0x17, 0x64, 0x8d, 0x90,
-	0xf4, 0x50, 0xe0, 0x90, 0x93, 0xb9, 0x57, 0x39, 0xa6, 0x4a, 0x0f, 0x40, 0xc6, 0xa7, 0x0e, 0xf4,
-	0x12, 0x67, 0x05, 0x66, 0x45, 0xe2, 0x66, 0x99, 0xa0, 0xbe, 0x1a, 0x65, 0x1d, 0x5b, 0x55, 0x8a,
-	0x60, 0xe3, 0x42, 0xaa, 0x24, 0xf1, 0x40, 0x04, 0xeb, 0x74, 0xec, 0x24, 0x6a, 0xe4, 0xb1, 0x67,
-	0x98, 0x7d, 0x68, 0xfd, 0x73, 0xe6, 0x26, 0xc8, 0x61, 0xe8, 0x59, 0x6d, 0xc8, 0x7c, 0xf8, 0xa8,
-	0xcb, 0x53, 0x93, 0xa7, 0xea, 0xe9, 0x2b, 0x38, 0x55, 0x7c, 0x1b, 0xac, 0x85, 0x18, 0xb6, 0x2d,
-	0xd8, 0x35, 0xc2, 0x3e, 0x69, 0x77, 0x51, 0x40, 0x37, 0x32, 0xab, 0xff, 0x17, 0x62, 0xf8, 0x88,
-	0x9d, 0x3e, 0x41, 0xc1, 0x44, 0x97, 0x9b, 0xa0, 0x38, 0xd1, 0x49, 0xdc, 0xe7, 0xde, 0xef, 0x05,
-	0xb0, 0xd8, 0xc2, 0xb6, 0xf4, 0x0c, 0x2c, 0xd1, 0x4d, 0xda, 0x9a, 0x9a, 0x89, 0x2f, 0xa0, 0x7c,
-	0xe3, 0x32, 0x34, 0xf6, 0x94, 0x5e, 0x81, 0xec, 0x78, 0x35, 0xaf, 0xcf, 0x92, 0x24, 0x14, 0x79,
-	0x67, 0x2e, 0x25, 0xb1, 0x36, 0x41, 0xee, 0xdc, 0x12, 0xcd, 0x0c, 0x94, 0x66, 0xc9, 0xb7, 0xae,
-	0xc2, 0x4a, 0x6a, 0xf4, 0xc0, 0xea, 0x85, 0x6b, 0xdf, 0x9e, 0xdd, 0x76, 0x9a, 0x27, 0xab, 0x57,
-	0xe3, 0xc5, 0x95, 0x1a, 0xcd, 0xc1, 0x50, 0x11, 0xcf, 0x86, 0x8a, 0xf8, 0x63, 0xa8, 0x88, 0xef,
-	0x46, 0x8a, 0x70, 0x36, 0x52, 0x84, 0xaf, 0x23, 0x45, 0x78, 0xbd, 0x73, 0xe9, 0x0b, 0x72, 0xcc,
-	0x9e, 0x58, 0xfa, 0x90, 0x98, 0x19, 0xfa, 0x50, 0xde, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xed,
-	0xc0, 0x85, 0x96, 0xe7, 0x05, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x52, 0x52, 0xd2, 0x97, 0xd2, 0xfe, 0x58, 0xc8, 0x0f, 0xba, 0xe0, 0x16, 0x1b, 0x83,
+	0xc5, 0xc8, 0x16, 0x30, 0xf1, 0x0f, 0x9c, 0x2c, 0x68, 0xa2, 0x49, 0x23, 0x29, 0xf1, 0xa0, 0x97,
+	0x66, 0xdb, 0x1d, 0x96, 0x0d, 0xdd, 0x99, 0xcd, 0xce, 0x2c, 0x81, 0xab, 0x27, 0x8f, 0x1e, 0x8c,
+	0x67, 0xce, 0x9e, 0x3c, 0xf8, 0x21, 0x38, 0x12, 0x13, 0x13, 0x4f, 0x6a, 0xe0, 0xa0, 0xf1, 0x4b,
+	0x68, 0x76, 0x66, 0x76, 0xdb, 0xd2, 0x3f, 0x10, 0x4e, 0xbb, 0x99, 0xf7, 0x79, 0x9e, 0xf7, 0x79,
+	0xde, 0x99, 0xd9, 0x85, 0xf9, 0x26, 0xa1, 0x2e, 0xa1, 0xe5, 0x86, 0x89, 0xf7, 0xcb, 0x07, 0xab,
+	0x0d, 0xc4, 0xcc, 0xd5, 0x32, 0x3b, 0x34, 0x3c, 0x9f, 0x30, 0xa2, 0x4e, 0x89, 0xaa, 0x11, 0x56,
+	0x0d, 0x59, 0xd5, 0xa6, 0x6d, 0x62, 0x13, 0x5e, 0x2f, 0x87, 0x6f, 0x02, 0xaa, 0xe9, 0xb1, 0x10,
+	0x45, 0xb1, 0x50, 0x93, 0x38, 0xb8, 0xa7, 0xde, 0xd1, 0x88, 0xeb, 0x8a, 0x7a, 0x5e, 0xd4, 0xeb,
+	0x42, 0x58, 0xf6, 0x15, 0xa5, 0x19, 0x49, 0x75, 0xa9, 0x5d, 0x3e, 0x58, 0x0d, 0x1f, 0xa2, 0x50,
+	0xfc, 0xab, 0xc0, 0x58, 0x95, 0xda, 0x3b, 0x08, 0x5b, 0xea, 0x06, 0x64, 0x76, 0x7d, 0xe2, 0xd6,
+	0x4d, 0xcb, 0xf2, 0x11, 0xa5, 0xb3, 0xca, 0x82, 0x52, 0x4a, 0x57, 0x66, 0xbf, 0x7c, 0x5e, 0x9e,
+	0x96, 0x62, 0x8f, 0x45, 0x65, 0x87, 0xf9, 0x0e, 0xb6, 0x6b, 0xe3, 0x21, 0x5a, 0x2e, 0xa9, 0x0f,
+	0x00, 0x18, 0x89, 0xa9, 0x23, 0x97, 0x50, 0xd3, 0x8c, 0x44, 0xc4, 0x26, 0xa4, 0x4c, 0x97, 0x04,
+	0x98, 0xcd, 0x26, 0x17, 0x92, 0xa5, 0xf1, 0xb5, 0xbc, 0x11, 0x4f, 0x8c, 0xa2, 0x68, 0x62, 0xc6,
+	0x26, 0x71, 0x70, 0x65, 0xe5, 0xe4, 0x7b, 0x21, 0xf1, 0xf1, 0x47, 0xa1, 0x64, 0x3b, 0x6c, 0x2f,
+	0x68, 0x18, 0x4d, 0xe2, 0xca, 0x98, 0xf2, 0xb1, 0x4c, 0xad, 0xfd, 0x32, 0x3b, 0xf2, 0x10, 0xe5,
+	0x04, 0x5a, 0x93, 0xd2, 0xeb, 0xf9, 0xb7, 0xc7, 0x85, 0xc4, 0xef, 0xe3, 0x42, 0xe2, 0xcd, 0xaf,
+	0x4f, 0x77, 0xba, 0x52, 0x16, 0x27, 0x21, 0x27, 0x07, 0x50, 0x43, 0xd4, 0x23, 0x98, 0xa2, 0xe2,
+	0x07, 0x05, 0x32, 0x55, 0x6a, 0x57, 0x83, 0x16, 0x73, 0xf8, 0x64, 0x1e, 0x42, 0xca, 0xc1, 0x5e,
+	0xc0, 0xc2, 0x99, 0x84, 0x1e, 0x35, 0xa3, 0xcf, 0xae, 0x1a, 0xcf, 0x42, 0x48, 0x65, 0x34, 0x34,
+	0x59, 0x93, 0x78, 0x75, 0x03, 0xc6, 0x48, 0xc0, 0x38, 0x75, 0x84, 0x53, 0xe7, 0xfa, 0x52, 0x5f,
+	0x04, 0xac, 0xcd, 0x8d, 0x18, 0xeb, 0xb9, 0xc8, 0xb1, 0x54, 0x2b, 0xfe, 0x0f, 0xd3, 0x9d, 0xbe,
+	0x62, 0xc3, 0xef, 0x15, 0x1e, 0xe2, 0xa5, 0x67, 0x99, 0x0c, 0x6d, 0x9b, 0xbe, 0xe9, 0x52, 0xf5,
+	0x3e, 0xa4, 0xcd, 0x80, 0xed, 0x11, 0xdf, 0x61, 0x47, 0x97, 0x6e, 0x65, 0x1b, 0xaa, 0x3e, 0x82,
+	0x94, 0xc7, 0x15, 0xf8, 0x26, 0x0e, 0x32, 0x2c, 0x9a, 0x44, 0x61, 0x05, 0x61, 0x3d, 0x1b, 0x7a,
+	0x6d, 0x4b, 0x15, 0xf3, 0x30, 0x73, 0xc1, 0x55, 0xec, 0xf8, 0x44, 0x81, 0x49, 0x3e, 0x76, 0x16,
+	0x06, 0x79, 0x82, 0xcd, 0x46, 0x0b, 0x59, 0xd7, 0xf6, 0xbc, 0x09, 0x19, 0x8a, 0xb0, 0x55, 0x47,
+	0x42, 0x47, 0x8e, 0x7a, 0xa1, 0xaf, 0xf3, 0x8e, 0x7e, 0xb5, 0x71, 0xda, 0xd1, 0x7c, 0x11, 0x72,
+	0x01, 0x45, 0x75, 0x0b, 0xed, 0x9a, 0x41, 0x8b, 0xd5, 0x77, 0x89, 0xcf, 0x4f, 0x64, 0xba, 0x36,
+	0x11, 0x50, 0xb4, 0x25, 0x56, 0x9f, 0x12, 0xbf, 0x27, 0xe5, 0x1c, 0xe4, 0x7b, 0x92, 0xc4, 0x39,
+	0xbf, 0x2a, 0x30, 0x25, 0xaa, 0x5b, 0x08, 0x13, 0x77, 0x9b, 0xb4, 0x9c, 0xa6, 0x83, 0xae, 0xbf,
+	0x3b, 0x55, 0xc8, 0x5a, 0xa1, 0x50, 0xdd, 0x93, 0x4a, 0x43, 0xb3, 0xb6, 0x7b, 0x1e, 0xc9, 0xad,
+	0x9a, 0xb0, 0xba, 0x6c, 0xdc, 0x86, 0x9c, 0x8f, 0x5c, 0x72, 0x80, 0xda, 0x7a, 0x22, 0x73, 0x56,
+	0x2c, 0x47, 0xc0, 0x9e, 0xd0, 0x37, 0x60, 0xae, 0x4f, 0xac, 0x28, 0xf6, 0xda, 0x9f, 0x24, 0x24,
+	0xab, 0xd4, 0x56, 0x9f, 0xc3, 0x28, 0xbf, 0x40, 0xf3, 0x7d, 0xed, 0xc9, 0x7b, 0xa7, 0xdd, 0x1a,
+	0x56, 0x8d, 0x34, 0xd5, 0x57, 0x90, 0x6e, 0xdf, 0xc8, 0x9b, 0x83, 0x28, 0x31, 0x44, 0x5b, 0xba,
+	0x14, 0x12, 0x4b, 0x37, 0x20, 0xd3, 0x75, 0x77, 0x06, 0x1a, 0xea, 0x44, 0x69, 0x77, 0xaf, 0x82,
+	0x8a, 0x7b, 0xec, 0x41, 0xf6, 0xc2, 0x69, 0x5f, 0x1c, 0x1c, 0xbb, 0x13, 0xa7, 0x19, 0x57, 0xc3,
+	0xc5, 0x9d, 0x30, 0xfc, 0xd7, 0x73, 0xde, 0x4a, 0x43, 0x34, 0xba, 0x90, 0xda, 0xca, 0x55, 0x91,
+	0x51, 0xbf, 0xca, 0xe6, 0xc9, 0x99, 0xae, 0x9c, 0x9e, 0xe9, 0xca, 0xcf, 0x33, 0x5d, 0x79, 0x77,
+	0xae, 0x27, 0x4e, 0xcf, 0xf5, 0xc4, 0xb7, 0x73, 0x3d, 0xf1, 0x7a, 0x69, 0xe8, 0x87, 0xfa, 0x50,
+	0xfc, 0xc9, 0xf8, 0xf7, 0xba, 0x91, 0xe2, 0xff, 0xa3, 0x7b, 0xff, 0x06, 0x00, 0xe0, 0x78, 0x4d,
+	0x25, 0x4e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,6 +613,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetDenomPolicies is a governance operation for setting the supply policy
+	// of any number of denoms. Only the policies to add or update should be
+	// included. Policies that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	//
+	// Since: cosmos-sdk 0.47
+	SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error)
 }
 
 type msgClient struct {
@@ -542,6 +666,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error) {
+	out := new(MsgSetDenomPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetDenomPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -560,6 +693,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetDenomPolicies is a governance operation for setting the supply policy
+	// of any number of denoms. Only the policies to add or update should be
+	// included. Policies that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	//
+	// Since: cosmos-sdk 0.47
+	SetDenomPolicies(context.Context, *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -578,6 +718,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomPolicies(ctx context.Context, req *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPolicies not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -655,6 +798,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetDenomPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomPolicies(ctx, req.(*MsgSetDenomPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -675,6 +836,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetDenomPolicies",
+			Handler:    _Msg_SetDenomPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -967,6 +1132,82 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetDenomPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovePolicies) > 0 {
+		for iNdEx := len(m.RemovePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovePolicies[iNdEx])
+			copy(dAtA[i:], m.RemovePolicies[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovePolicies[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1098,6 +1339,40 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetDenomPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemovePolicies) > 0 {
+		for _, s := range m.RemovePolicies {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1833,6 +2108,204 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetDenomPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovePolicies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovePolicies = append(m.RemovePolicies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
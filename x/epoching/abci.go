@@ -0,0 +1,25 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// BeginBlocker starts the epochs whose first block is the current one.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.StartEpochs(ctx)
+}
+
+// EndBlocker ends the epochs whose last block is the current one, executing
+// the actions queued on them.
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.EndEpochs(ctx)
+}
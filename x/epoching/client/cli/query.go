@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	epochingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingQueryCmd.AddCommand(
+		GetCmdQueryEpochs(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryPendingActions(),
+	)
+
+	return epochingQueryCmd
+}
+
+// GetCmdQueryEpochs implements the query epochs command.
+func GetCmdQueryEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epochs",
+		Args:    cobra.NoArgs,
+		Short:   "Query all the epochs",
+		Example: fmt.Sprintf(`$ %s query %s epochs`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Epochs(cmd.Context(), &types.QueryEpochsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epochs")
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current-epoch command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-epoch [identifier]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the current epoch of the given identifier",
+		Example: fmt.Sprintf(`$ %s query %s current-epoch day`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{
+				Identifier: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingActions implements the query pending-actions command.
+func GetCmdQueryPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-actions [identifier]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the actions queued for execution at the end of the current epoch of the given identifier",
+		Example: fmt.Sprintf(`$ %s query %s pending-actions day`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingActions(cmd.Context(), &types.QueryPendingActionsRequest{
+				Identifier: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending actions")
+
+	return cmd
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetEpochInfo returns the epoch of the given identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochInfoKey(identifier))
+	if bz == nil {
+		return types.EpochInfo{}, false
+	}
+
+	var epoch types.EpochInfo
+	k.cdc.MustUnmarshal(bz, &epoch)
+
+	return epoch, true
+}
+
+// SetEpochInfo stores an epoch.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochInfoKey(epoch.Identifier), k.cdc.MustMarshal(&epoch))
+}
+
+// AddEpochInfo registers a new epoch. It fails if the epoch is invalid or if
+// an epoch of the same identifier already exists.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+		return sdkerrors.Wrapf(types.ErrEpochExists, "epoch %s", epoch.Identifier)
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	return nil
+}
+
+// DeleteEpochInfo removes an epoch along with its queued actions.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	for _, action := range k.GetPendingActions(ctx, identifier) {
+		k.DeleteQueuedAction(ctx, identifier, action.Id)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochInfoKey(identifier))
+}
+
+// IterateEpochInfos iterates over the epochs, by identifier, and calls cb on
+// each of them until it returns true.
+func (k Keeper) IterateEpochInfos(ctx sdk.Context, cb func(epoch types.EpochInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochInfoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all the epochs, by identifier.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	epochs := []types.EpochInfo{}
+	k.IterateEpochInfos(ctx, func(epoch types.EpochInfo) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
+
+// StartEpochs starts the epochs whose first block is the current one, either
+// because their start time has been reached or because the previous epoch
+// ended with the previous block.
+func (k Keeper) StartEpochs(ctx sdk.Context) {
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		switch {
+		case !epoch.IsStarted() && !ctx.BlockTime().Before(epoch.StartTime):
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+			epoch.CurrentEpochStartTime = ctx.BlockTime()
+
+		case epoch.IsStarted() && epoch.CurrentEpochStartHeight == ctx.BlockHeight():
+			// duration based epochs keep on starting at a multiple of the
+			// duration, regardless of block times
+			if epoch.Blocks > 0 {
+				epoch.CurrentEpochStartTime = ctx.BlockTime()
+			}
+
+		default:
+			continue
+		}
+
+		k.SetEpochInfo(ctx, epoch)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(epoch.CurrentEpoch, 10)),
+			),
+		)
+
+		k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	}
+}
+
+// EndEpochs ends the epochs whose last block is the current one: the actions
+// queued on them are executed and the next epochs are set to start with the
+// next block.
+func (k Keeper) EndEpochs(ctx sdk.Context) {
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		if !epoch.IsEnded(ctx.BlockHeight(), ctx.BlockTime()) {
+			continue
+		}
+
+		k.ExecutePendingActions(ctx, epoch.Identifier)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochEnd,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(epoch.CurrentEpoch, 10)),
+			),
+		)
+
+		k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+		epoch.CurrentEpoch++
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight() + 1
+		if epoch.Blocks == 0 {
+			epoch.CurrentEpochStartTime = epoch.CurrentEpochStartTime.Add(epoch.Duration)
+		}
+
+		k.SetEpochInfo(ctx, epoch)
+	}
+}
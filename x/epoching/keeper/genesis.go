@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := types.ValidateGenesis(*data); err != nil {
+		panic(err)
+	}
+
+	for _, epoch := range data.Epochs {
+		k.SetEpochInfo(ctx, epoch)
+	}
+
+	for _, action := range data.Actions {
+		k.SetQueuedAction(ctx, action)
+	}
+
+	k.SetNextActionID(ctx, data.NextActionId)
+}
+
+// ExportGenesis returns the epoching module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.AllEpochInfos(ctx), k.GetAllPendingActions(ctx), k.GetNextActionID(ctx))
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Epochs returns all the epochs.
+func (k Keeper) Epochs(goCtx context.Context, r *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochInfoKeyPrefix)
+
+	var epochs []types.EpochInfo
+	pageRes, err := query.Paginate(store, r.Pagination, func(_, value []byte) error {
+		var epoch types.EpochInfo
+		if err := k.cdc.Unmarshal(value, &epoch); err != nil {
+			return err
+		}
+
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochsResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
+
+// CurrentEpoch returns the current epoch of the given identifier.
+func (k Keeper) CurrentEpoch(goCtx context.Context, r *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := types.ValidateIdentifier(r.Identifier); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epoch, found := k.GetEpochInfo(ctx, r.Identifier)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", r.Identifier)
+	}
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: epoch.CurrentEpoch, Epoch: epoch}, nil
+}
+
+// PendingActions returns the actions queued on the epoch of the given
+// identifier.
+func (k Keeper) PendingActions(goCtx context.Context, r *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := types.ValidateIdentifier(r.Identifier); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, r.Identifier); !found {
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", r.Identifier)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActionQueueKey(r.Identifier))
+
+	var actions []types.QueuedAction
+	pageRes, err := query.Paginate(store, r.Pagination, func(_, value []byte) error {
+		var action types.QueuedAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeforeEpochStart - call hook if registered. The hook runs in a cached
+// context: if it fails, its state changes are discarded and the error is
+// logged rather than halting the chain.
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) {
+	if k.hooks != nil {
+		k.runHook(ctx, "BeforeEpochStart", identifier, epochNumber, k.hooks.BeforeEpochStart)
+	}
+}
+
+// AfterEpochEnd - call hook if registered. The hook runs in a cached context:
+// if it fails, its state changes are discarded and the error is logged rather
+// than halting the chain.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) {
+	if k.hooks != nil {
+		k.runHook(ctx, "AfterEpochEnd", identifier, epochNumber, k.hooks.AfterEpochEnd)
+	}
+}
+
+func (k Keeper) runHook(
+	ctx sdk.Context, name, identifier string, epochNumber uint64,
+	hook func(ctx sdk.Context, identifier string, epochNumber uint64) error,
+) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := hook(cacheCtx, identifier, epochNumber); err != nil {
+		k.Logger(ctx).Error(
+			"epoch hook failed",
+			"hook", name,
+			"identifier", identifier,
+			"epoch", epochNumber,
+			"err", err,
+		)
+
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,351 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var genesisTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// dogServer stores the created dogs along with whether they were created by a
+// queued action, failing to create the ones named fail and panicking on the
+// ones named panic once stored.
+type dogServer struct {
+	key storetypes.StoreKey
+}
+
+func (s dogServer) CreateDog(goCtx context.Context, msg *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.KVStore(s.key).Set([]byte("dog/"+msg.Dog.Name), []byte(strconv.FormatBool(types.IsQueuedExecution(ctx))))
+	ctx.EventManager().EmitEvent(sdk.NewEvent("dog_created", sdk.NewAttribute("name", msg.Dog.Name)))
+
+	switch msg.Dog.Name {
+	case "fail":
+		return nil, errors.New("dog creation failed")
+	case "panic":
+		panic("dog creation panicked")
+	}
+
+	return &testdata.MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+type hookCall struct {
+	hook        string
+	identifier  string
+	epochNumber uint64
+}
+
+// recordingHooks records the hook calls, failing them if err is set.
+type recordingHooks struct {
+	key   storetypes.StoreKey
+	calls []hookCall
+	err   error
+}
+
+func (h *recordingHooks) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) error {
+	return h.record(ctx, hookCall{"BeforeEpochStart", identifier, epochNumber})
+}
+
+func (h *recordingHooks) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) error {
+	return h.record(ctx, hookCall{"AfterEpochEnd", identifier, epochNumber})
+}
+
+func (h *recordingHooks) record(ctx sdk.Context, call hookCall) error {
+	h.calls = append(h.calls, call)
+	ctx.KVStore(h.key).Set([]byte("hook/"+call.hook), []byte{})
+
+	return h.err
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	key         storetypes.StoreKey
+	keeper      *keeper.Keeper
+	hooks       *recordingHooks
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epoching.AppModuleBasic{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(router, dogServer{key: key})
+
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: genesisTime})
+	s.key = key
+	s.hooks = &recordingHooks{key: key}
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, router).SetHooks(s.hooks)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// runBlock runs the begin and end blockers of the block of the given height
+// and time.
+func (s *KeeperTestSuite) runBlock(height int64, blockTime time.Time) {
+	s.ctx = s.ctx.WithBlockHeight(height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	epoching.BeginBlocker(s.ctx, s.keeper)
+	epoching.EndBlocker(s.ctx, s.keeper)
+}
+
+func (s *KeeperTestSuite) createDog(name string) *testdata.MsgCreateDog {
+	return &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: name}}
+}
+
+func (s *KeeperTestSuite) hasDog(name string) bool {
+	return s.ctx.KVStore(s.key).Has([]byte("dog/" + name))
+}
+
+func (s *KeeperTestSuite) isQueuedDog(name string) bool {
+	return string(s.ctx.KVStore(s.key).Get([]byte("dog/"+name))) == "true"
+}
+
+func (s *KeeperTestSuite) TestAddEpochInfo() {
+	require := s.Require()
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 3, 0)))
+	require.ErrorIs(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 5, 0)), types.ErrEpochExists)
+	require.ErrorIs(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("both", genesisTime, 5, time.Hour)), types.ErrInvalidEpoch)
+	require.ErrorIs(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("none", genesisTime, 0, 0)), types.ErrInvalidEpoch)
+	require.ErrorIs(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("", genesisTime, 5, 0)), types.ErrInvalidEpoch)
+
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("hour", genesisTime, 0, time.Hour)))
+	epochs := s.keeper.AllEpochInfos(s.ctx)
+	require.Len(epochs, 2)
+	require.Equal("blocks", epochs[0].Identifier)
+	require.Equal("hour", epochs[1].Identifier)
+
+	s.keeper.DeleteEpochInfo(s.ctx, "blocks")
+	_, found := s.keeper.GetEpochInfo(s.ctx, "blocks")
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestBlockEpochs() {
+	require := s.Require()
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 3, 0)))
+
+	for height := int64(1); height <= 7; height++ {
+		s.runBlock(height, genesisTime.Add(time.Duration(height)*time.Minute))
+	}
+
+	require.Equal([]hookCall{
+		{"BeforeEpochStart", "blocks", 1},
+		{"AfterEpochEnd", "blocks", 1},
+		{"BeforeEpochStart", "blocks", 2},
+		{"AfterEpochEnd", "blocks", 2},
+		{"BeforeEpochStart", "blocks", 3},
+	}, s.hooks.calls)
+
+	epoch, found := s.keeper.GetEpochInfo(s.ctx, "blocks")
+	require.True(found)
+	require.Equal(uint64(3), epoch.CurrentEpoch)
+	require.Equal(int64(7), epoch.CurrentEpochStartHeight)
+	require.Equal(genesisTime.Add(7*time.Minute), epoch.CurrentEpochStartTime)
+}
+
+func (s *KeeperTestSuite) TestDurationEpochs() {
+	require := s.Require()
+
+	startTime := genesisTime.Add(time.Hour)
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("hour", startTime, 0, time.Hour)))
+
+	// the first epoch starts once its start time is reached
+	s.runBlock(1, genesisTime)
+	require.Empty(s.hooks.calls)
+
+	s.runBlock(2, startTime.Add(time.Minute))
+	require.Equal([]hookCall{{"BeforeEpochStart", "hour", 1}}, s.hooks.calls)
+
+	epoch, _ := s.keeper.GetEpochInfo(s.ctx, "hour")
+	require.Equal(startTime.Add(time.Minute), epoch.CurrentEpochStartTime)
+
+	s.runBlock(3, startTime.Add(time.Hour))
+	require.Len(s.hooks.calls, 1)
+
+	s.runBlock(4, startTime.Add(time.Hour+time.Minute))
+	require.Equal(hookCall{"AfterEpochEnd", "hour", 1}, s.hooks.calls[1])
+
+	// the next epoch starts with the next block, a duration after the previous
+	// one regardless of the block time
+	s.runBlock(5, startTime.Add(time.Hour+10*time.Minute))
+	require.Equal(hookCall{"BeforeEpochStart", "hour", 2}, s.hooks.calls[2])
+
+	epoch, _ = s.keeper.GetEpochInfo(s.ctx, "hour")
+	require.Equal(uint64(2), epoch.CurrentEpoch)
+	require.Equal(int64(5), epoch.CurrentEpochStartHeight)
+	require.Equal(startTime.Add(time.Hour+time.Minute), epoch.CurrentEpochStartTime)
+}
+
+func (s *KeeperTestSuite) TestQueueMsg() {
+	require := s.Require()
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 2, 0)))
+
+	_, err := s.keeper.QueueMsg(s.ctx, "unknown", s.createDog("a"))
+	require.ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = s.keeper.QueueMsg(s.ctx, "blocks", testdata.NewTestMsg())
+	require.ErrorIs(err, types.ErrUnroutableMsg)
+
+	s.runBlock(1, genesisTime)
+
+	names := []string{"a", "fail", "panic", "b"}
+	for i, name := range names {
+		id, err := s.keeper.QueueMsg(s.ctx, "blocks", s.createDog(name))
+		require.NoError(err)
+		require.Equal(uint64(i+1), id)
+	}
+
+	actions := s.keeper.GetPendingActions(s.ctx, "blocks")
+	require.Len(actions, 4)
+	for i, action := range actions {
+		require.Equal(uint64(i+1), action.Id)
+		require.Equal(uint64(1), action.EpochNumber)
+
+		msg, err := action.GetMsg()
+		require.NoError(err)
+		require.Equal(s.createDog(names[i]), msg)
+	}
+
+	// the actions are executed at the end of the epoch, failing and
+	// panicking ones without affecting the state
+	s.runBlock(2, genesisTime)
+	require.True(s.hasDog("a"))
+	require.False(s.hasDog("fail"))
+	require.False(s.hasDog("panic"))
+	require.True(s.hasDog("b"))
+	require.True(s.isQueuedDog("a"))
+	require.Empty(s.keeper.GetPendingActions(s.ctx, "blocks"))
+
+	var results []string
+	var dogs int
+	for _, event := range s.ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeEpochAction:
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyResult {
+					results = append(results, attr.Value)
+				}
+			}
+		case "dog_created":
+			dogs++
+		}
+	}
+	require.Equal([]string{
+		types.AttributeValueActionExecuted,
+		types.AttributeValueActionFailed,
+		types.AttributeValueActionFailed,
+		types.AttributeValueActionExecuted,
+	}, results)
+	require.Equal(2, dogs)
+
+	// the actions are executed before the epoch end hook
+	require.Equal(hookCall{"AfterEpochEnd", "blocks", 1}, s.hooks.calls[len(s.hooks.calls)-1])
+}
+
+func (s *KeeperTestSuite) TestHookFailure() {
+	require := s.Require()
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 1, 0)))
+
+	s.hooks.err = errors.New("hook failed")
+	s.runBlock(1, genesisTime)
+
+	// the epoch progresses but the hooks' state changes are discarded
+	require.Len(s.hooks.calls, 2)
+	require.False(s.ctx.KVStore(s.key).Has([]byte("hook/BeforeEpochStart")))
+	require.False(s.ctx.KVStore(s.key).Has([]byte("hook/AfterEpochEnd")))
+
+	epoch, _ := s.keeper.GetEpochInfo(s.ctx, "blocks")
+	require.Equal(uint64(2), epoch.CurrentEpoch)
+
+	s.hooks.err = nil
+	s.runBlock(2, genesisTime)
+	require.True(s.ctx.KVStore(s.key).Has([]byte("hook/BeforeEpochStart")))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 3, 0)))
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("hour", genesisTime, 0, time.Hour)))
+
+	s.runBlock(1, genesisTime)
+	_, err := s.keeper.QueueMsg(s.ctx, "blocks", s.createDog("a"))
+	require.NoError(err)
+	_, err = s.keeper.QueueMsg(s.ctx, "hour", s.createDog("b"))
+	require.NoError(err)
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(types.ValidateGenesis(*genesis))
+	require.Len(genesis.Epochs, 2)
+	require.Len(genesis.Actions, 2)
+	require.Equal(uint64(3), genesis.NextActionId)
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.ctx, genesis)
+	require.Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+
+	id, err := s.keeper.QueueMsg(s.ctx, "blocks", s.createDog("c"))
+	require.NoError(err)
+	require.Equal(uint64(3), id)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	require := s.Require()
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("blocks", genesisTime, 3, 0)))
+	require.NoError(s.keeper.AddEpochInfo(s.ctx, types.NewEpochInfo("hour", genesisTime, 0, time.Hour)))
+
+	s.runBlock(1, genesisTime)
+	for _, name := range []string{"a", "b"} {
+		_, err := s.keeper.QueueMsg(s.ctx, "blocks", s.createDog(name))
+		require.NoError(err)
+	}
+
+	epochs, err := s.queryClient.Epochs(s.ctx, &types.QueryEpochsRequest{})
+	require.NoError(err)
+	require.Len(epochs.Epochs, 2)
+
+	current, err := s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{Identifier: "blocks"})
+	require.NoError(err)
+	require.Equal(uint64(1), current.CurrentEpoch)
+	require.Equal(int64(1), current.Epoch.CurrentEpochStartHeight)
+
+	_, err = s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{Identifier: "day"})
+	require.ErrorIs(err, types.ErrEpochNotFound)
+
+	pending, err := s.queryClient.PendingActions(s.ctx, &types.QueryPendingActionsRequest{Identifier: "blocks"})
+	require.NoError(err)
+	require.Len(pending.Actions, 2)
+	require.Equal(uint64(1), pending.Actions[0].Id)
+	require.Equal(uint64(2), pending.Actions[1].Id)
+
+	pending, err = s.queryClient.PendingActions(s.ctx, &types.QueryPendingActionsRequest{Identifier: "hour"})
+	require.NoError(err)
+	require.Empty(pending.Actions)
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetNextActionID returns the identifier of the next queued action.
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextActionIDKey)
+	if bz == nil {
+		return types.DefaultActionID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextActionID sets the identifier of the next queued action.
+func (k Keeper) SetNextActionID(ctx sdk.Context, actionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(actionID))
+}
+
+// GetNewActionID returns the identifier of a new queued action.
+func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+	actionID := k.GetNextActionID(ctx)
+	k.SetNextActionID(ctx, actionID+1)
+
+	return actionID
+}
+
+// QueueMsg queues msg for execution at the end of the current epoch of the
+// given identifier, or of the first one if it has not started yet. The message
+// must be routable, it is executed with the signers it carries: the caller is
+// responsible for their authentication.
+func (k Keeper) QueueMsg(ctx sdk.Context, identifier string, msg sdk.Msg) (uint64, error) {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", identifier)
+	}
+
+	if k.router.Handler(msg) == nil {
+		return 0, sdkerrors.Wrap(types.ErrUnroutableMsg, sdk.MsgTypeURL(msg))
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	action, err := types.NewQueuedAction(k.GetNewActionID(ctx), identifier, epoch.CurrentEpoch, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetQueuedAction(ctx, action)
+
+	return action.Id, nil
+}
+
+// SetQueuedAction stores a queued action.
+func (k Keeper) SetQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedActionKey(action.Identifier, action.Id), k.cdc.MustMarshal(&action))
+}
+
+// GetQueuedAction returns a queued action.
+func (k Keeper) GetQueuedAction(ctx sdk.Context, identifier string, actionID uint64) (types.QueuedAction, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.QueuedActionKey(identifier, actionID))
+	if bz == nil {
+		return types.QueuedAction{}, false
+	}
+
+	var action types.QueuedAction
+	k.cdc.MustUnmarshal(bz, &action)
+
+	return action, true
+}
+
+// DeleteQueuedAction removes a queued action.
+func (k Keeper) DeleteQueuedAction(ctx sdk.Context, identifier string, actionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.QueuedActionKey(identifier, actionID))
+}
+
+// IteratePendingActions iterates over the actions queued on the epoch of the
+// given identifier, in execution order, and calls cb on each of them until it
+// returns true.
+func (k Keeper) IteratePendingActions(ctx sdk.Context, identifier string, cb func(action types.QueuedAction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ActionQueueKey(identifier))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.QueuedAction
+		k.cdc.MustUnmarshal(iterator.Value(), &action)
+
+		if cb(action) {
+			break
+		}
+	}
+}
+
+// GetPendingActions returns the actions queued on the epoch of the given
+// identifier, in execution order.
+func (k Keeper) GetPendingActions(ctx sdk.Context, identifier string) []types.QueuedAction {
+	actions := []types.QueuedAction{}
+	k.IteratePendingActions(ctx, identifier, func(action types.QueuedAction) bool {
+		actions = append(actions, action)
+		return false
+	})
+
+	return actions
+}
+
+// GetAllPendingActions returns the actions queued on all the epochs.
+func (k Keeper) GetAllPendingActions(ctx sdk.Context) []types.QueuedAction {
+	actions := []types.QueuedAction{}
+	k.IterateEpochInfos(ctx, func(epoch types.EpochInfo) bool {
+		actions = append(actions, k.GetPendingActions(ctx, epoch.Identifier)...)
+		return false
+	})
+
+	return actions
+}
+
+// ExecutePendingActions dequeues and executes the actions queued on the epoch
+// of the given identifier. Every action is executed in a cached context: the
+// state changes of a failing action are discarded, without affecting the
+// other ones.
+func (k Keeper) ExecutePendingActions(ctx sdk.Context, identifier string) {
+	for _, action := range k.GetPendingActions(ctx, identifier) {
+		k.DeleteQueuedAction(ctx, identifier, action.Id)
+
+		err := k.executeAction(ctx, action)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyIdentifier, identifier),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, action.Message.GetTypeUrl()),
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error(
+				"queued action failed",
+				"identifier", identifier,
+				"action", action.Id,
+				"msg", action.Message.GetTypeUrl(),
+				"err", err,
+			)
+
+			attrs = append(attrs,
+				sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueActionFailed),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			)
+		} else {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueActionExecuted))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEpochAction, attrs...))
+	}
+}
+
+// executeAction executes the message of a queued action, writing its state
+// changes and events only if it succeeds. The message is executed with a
+// context marked by WithQueuedExecution.
+func (k Keeper) executeAction(ctx sdk.Context, action types.QueuedAction) error {
+	msg, err := action.GetMsg()
+	if err != nil {
+		return err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrap(types.ErrUnroutableMsg, sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := safeExecuteMsg(types.WithQueuedExecution(cacheCtx), handler, msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+
+	// emit the events from the executed message
+	events := make(sdk.Events, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// safeExecuteMsg executes a queued message, turning a panic of its handler
+// into an error so that a message failing on a state changed since it was
+// queued does not halt the chain.
+func safeExecuteMsg(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	return handler(ctx, msg)
+}
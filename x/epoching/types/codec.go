@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterLegacyAminoCodec registers the epoching module types on the given
+// LegacyAmino codec. The module has no message.
+func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers the epoching module interface types. The
+// queued messages are registered by the modules defining them.
+func RegisterInterfaces(_ types.InterfaceRegistry) {}
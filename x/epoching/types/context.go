@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type queuedExecutionKey struct{}
+
+// WithQueuedExecution returns a context marking the execution of a queued
+// action.
+func WithQueuedExecution(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(queuedExecutionKey{}, true)
+}
+
+// IsQueuedExecution reports whether ctx is the context of the execution of a
+// queued action. Modules queuing their own messages rely on it to execute them
+// at the end of the epoch rather than queuing them again.
+func IsQueuedExecution(ctx sdk.Context) bool {
+	executed, _ := ctx.Value(queuedExecutionKey{}).(bool)
+	return executed
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxIdentifierLength is the maximum length of an epoch identifier.
+const MaxIdentifierLength = 128
+
+var _ types.UnpackInterfacesMessage = QueuedAction{}
+
+// NewEpochInfo returns a new EpochInfo, starting at startTime and lasting
+// either the given number of blocks or the given duration.
+func NewEpochInfo(identifier string, startTime time.Time, blocks int64, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		StartTime:  startTime,
+		Blocks:     blocks,
+		Duration:   duration,
+	}
+}
+
+// Validate performs a stateless validation of the epoch.
+func (e EpochInfo) Validate() error {
+	if err := ValidateIdentifier(e.Identifier); err != nil {
+		return err
+	}
+
+	switch {
+	case e.Blocks < 0 || e.Duration < 0:
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s has a negative length", e.Identifier)
+	case (e.Blocks == 0) == (e.Duration == 0):
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s must last either a number of blocks or a duration", e.Identifier)
+	case e.CurrentEpochStartHeight < 0:
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s has a negative start height", e.Identifier)
+	}
+
+	return nil
+}
+
+// IsStarted reports whether the first epoch started.
+func (e EpochInfo) IsStarted() bool {
+	return e.CurrentEpoch > 0
+}
+
+// IsEnded reports whether the current epoch ends with the block of the given
+// height and time.
+func (e EpochInfo) IsEnded(height int64, blockTime time.Time) bool {
+	if !e.IsStarted() {
+		return false
+	}
+
+	if e.Blocks > 0 {
+		return height >= e.CurrentEpochStartHeight+e.Blocks-1
+	}
+
+	return !blockTime.Before(e.CurrentEpochStartTime.Add(e.Duration))
+}
+
+// ValidateIdentifier validates an epoch identifier.
+func ValidateIdentifier(identifier string) error {
+	switch {
+	case identifier == "":
+		return sdkerrors.Wrap(ErrInvalidEpoch, "empty epoch identifier")
+	case len(identifier) > MaxIdentifierLength:
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch identifier longer than %d bytes", MaxIdentifierLength)
+	}
+
+	return nil
+}
+
+// NewQueuedAction returns a new QueuedAction of msg.
+func NewQueuedAction(id uint64, identifier string, epochNumber uint64, msg sdk.Msg) (QueuedAction, error) {
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedAction{}, err
+	}
+
+	return QueuedAction{
+		Id:          id,
+		Identifier:  identifier,
+		EpochNumber: epochNumber,
+		Message:     any,
+	}, nil
+}
+
+// GetMsg returns the queued message.
+func (a QueuedAction) GetMsg() (sdk.Msg, error) {
+	if a.Message == nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidAction, "action %d has no message", a.Id)
+	}
+
+	msg, ok := a.Message.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidAction, "action %d message is not a sdk.Msg: %T", a.Id, a.Message.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// Validate performs a stateless validation of the action.
+func (a QueuedAction) Validate() error {
+	if a.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidAction, "action id cannot be 0")
+	}
+
+	if err := ValidateIdentifier(a.Identifier); err != nil {
+		return err
+	}
+
+	msg, err := a.GetMsg()
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAction, "action %d: %s", a.Id, err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a QueuedAction) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Message, &msg)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidEpoch  = sdkerrors.Register(ModuleName, 2, "invalid epoch")
+	ErrEpochExists   = sdkerrors.Register(ModuleName, 3, "epoch already exists")
+	ErrEpochNotFound = sdkerrors.Register(ModuleName, 4, "epoch not found")
+	ErrInvalidAction = sdkerrors.Register(ModuleName, 5, "invalid queued action")
+	ErrUnroutableMsg = sdkerrors.Register(ModuleName, 6, "no handler found for queued message")
+)
@@ -0,0 +1,18 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeEpochStart  = "epoch_start"
+	EventTypeEpochEnd    = "epoch_end"
+	EventTypeEpochAction = "epoch_action"
+
+	AttributeKeyIdentifier  = "identifier"
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeyResult      = "result"
+	AttributeKeyError       = "error"
+
+	AttributeValueActionExecuted = "executed"
+	AttributeValueActionFailed   = "failed"
+)
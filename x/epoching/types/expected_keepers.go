@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks event hooks for epochs
+type EpochHooks interface {
+	// BeforeEpochStart is called in the BeginBlock of the first block of an
+	// epoch.
+	BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) error
+	// AfterEpochEnd is called in the EndBlock of the last block of an epoch,
+	// once the actions queued on the epoch are executed.
+	AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) error
+}
+
+// EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
+type EpochHooksWrapper struct{ EpochHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EpochHooksWrapper) IsOnePerModuleType() {}
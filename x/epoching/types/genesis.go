@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultActionID is the identifier of the first queued action.
+const DefaultActionID = 1
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(epochs []EpochInfo, actions []QueuedAction, nextActionID uint64) *GenesisState {
+	return &GenesisState{
+		Epochs:       epochs,
+		Actions:      actions,
+		NextActionId: nextActionID,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, without any epoch.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]EpochInfo{}, []QueuedAction{}, DefaultActionID)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if data.NextActionId < DefaultActionID {
+		return sdkerrors.Wrapf(ErrInvalidAction, "next action id must be at least %d", DefaultActionID)
+	}
+
+	epochs := make(map[string]bool, len(data.Epochs))
+	for _, epoch := range data.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+
+		if epochs[epoch.Identifier] {
+			return sdkerrors.Wrapf(ErrEpochExists, "duplicate epoch %s", epoch.Identifier)
+		}
+		epochs[epoch.Identifier] = true
+	}
+
+	actions := make(map[uint64]bool, len(data.Actions))
+	for _, action := range data.Actions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+
+		if !epochs[action.Identifier] {
+			return sdkerrors.Wrapf(ErrEpochNotFound, "action %d is queued on unknown epoch %s", action.Id, action.Identifier)
+		}
+
+		if action.Id >= data.NextActionId {
+			return sdkerrors.Wrapf(ErrInvalidAction, "action id %d must be lower than the next action id %d", action.Id, data.NextActionId)
+		}
+
+		if actions[action.Id] {
+			return sdkerrors.Wrapf(ErrInvalidAction, "duplicate action id %d", action.Id)
+		}
+		actions[action.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, action := range data.Actions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newAction := func(id uint64, identifier string) types.QueuedAction {
+		action, err := types.NewQueuedAction(id, identifier, 1, &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "dog"}})
+		require.NoError(t, err)
+		return action
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			malleate: func(_ *types.GenesisState) {},
+			expPass:  true,
+		},
+		{
+			name: "valid genesis state",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{
+					types.NewEpochInfo("blocks", startTime, 10, 0),
+					types.NewEpochInfo("day", startTime, 0, 24*time.Hour),
+				}
+				genState.Actions = []types.QueuedAction{newAction(1, "blocks"), newAction(2, "day")}
+				genState.NextActionId = 3
+			},
+			expPass: true,
+		},
+		{
+			name: "next action id is 0",
+			malleate: func(genState *types.GenesisState) {
+				genState.NextActionId = 0
+			},
+			expPass: false,
+		},
+		{
+			name: "epoch without length",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{types.NewEpochInfo("blocks", startTime, 0, 0)}
+			},
+			expPass: false,
+		},
+		{
+			name: "epoch with both a number of blocks and a duration",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{types.NewEpochInfo("blocks", startTime, 10, time.Hour)}
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate epoch",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{
+					types.NewEpochInfo("blocks", startTime, 10, 0),
+					types.NewEpochInfo("blocks", startTime, 20, 0),
+				}
+			},
+			expPass: false,
+		},
+		{
+			name: "action on unknown epoch",
+			malleate: func(genState *types.GenesisState) {
+				genState.Actions = []types.QueuedAction{newAction(1, "blocks")}
+				genState.NextActionId = 2
+			},
+			expPass: false,
+		},
+		{
+			name: "action id not lower than the next action id",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{types.NewEpochInfo("blocks", startTime, 10, 0)}
+				genState.Actions = []types.QueuedAction{newAction(1, "blocks")}
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate action id",
+			malleate: func(genState *types.GenesisState) {
+				genState.Epochs = []types.EpochInfo{types.NewEpochInfo("blocks", startTime, 10, 0)}
+				genState.Actions = []types.QueuedAction{newAction(1, "blocks"), newAction(1, "blocks")}
+				genState.NextActionId = 2
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			tc.malleate(genState)
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// combine multiple epoch hooks, all hook functions are run in array sequence
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) error {
+	for i := range h {
+		if err := h[i].BeforeEpochStart(ctx, identifier, epochNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) error {
+	for i := range h {
+		if err := h[i].AfterEpochEnd(ctx, identifier, epochNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epoching"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	NextActionIDKey      = []byte{0x11}
+	EpochInfoKeyPrefix   = []byte{0x12}
+	ActionQueueKeyPrefix = []byte{0x13}
+)
+
+// EpochInfoKey returns the store key of the epoch of the given identifier.
+func EpochInfoKey(identifier string) []byte {
+	return append(EpochInfoKeyPrefix, []byte(identifier)...)
+}
+
+// ActionQueueKey returns the store key prefix of the actions queued on the
+// epoch of the given identifier.
+func ActionQueueKey(identifier string) []byte {
+	return append(ActionQueueKeyPrefix, address.MustLengthPrefix([]byte(identifier))...)
+}
+
+// QueuedActionKey returns the store key of a queued action. Actions of the
+// same epoch are ordered by identifier, i.e. in queuing order.
+func QueuedActionKey(identifier string, actionID uint64) []byte {
+	return append(ActionQueueKey(identifier), sdk.Uint64ToBigEndian(actionID)...)
+}
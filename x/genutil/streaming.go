@@ -0,0 +1,240 @@
+package genutil
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// A streamed genesis holds the genesis document without its app state and the
+// genesis state of each module in a separate file, so that neither has to be
+// held in memory at once. It is either a directory or a tar archive of the
+// directory, laid out as:
+//
+//	genesis.json             the genesis document, without its app state
+//	app_state/{module}.json  the genesis state of a module
+const (
+	// StreamedGenesisDocFile is the name of the genesis document file of a
+	// streamed genesis.
+	StreamedGenesisDocFile = "genesis.json"
+
+	streamedAppStateDir = "app_state"
+)
+
+func streamedModuleFile(moduleName string) string {
+	return path.Join(streamedAppStateDir, moduleName+".json")
+}
+
+// StreamedGenesisWriter writes a streamed genesis to a directory or a tar
+// archive.
+type StreamedGenesisWriter struct {
+	dir string
+
+	file *os.File
+	tw   *tar.Writer
+}
+
+// NewStreamedGenesisDirWriter returns a writer of a streamed genesis into the
+// provided directory, which is created if it doesn't exist.
+func NewStreamedGenesisDirWriter(dir string) (*StreamedGenesisWriter, error) {
+	if err := os.MkdirAll(filepath.Join(dir, streamedAppStateDir), 0o755); err != nil {
+		return nil, err
+	}
+
+	return &StreamedGenesisWriter{dir: dir}, nil
+}
+
+// NewStreamedGenesisTarballWriter returns a writer of a streamed genesis into
+// a tar archive created at the provided path.
+func NewStreamedGenesisTarballWriter(tarball string) (*StreamedGenesisWriter, error) {
+	file, err := os.Create(tarball)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamedGenesisWriter{file: file, tw: tar.NewWriter(file)}, nil
+}
+
+// ModuleTarget returns the target writing the genesis state of each module to
+// its own file.
+func (w *StreamedGenesisWriter) ModuleTarget() module.GenesisTarget {
+	return func(moduleName string) (io.WriteCloser, error) {
+		return w.create(streamedModuleFile(moduleName))
+	}
+}
+
+// WriteGenesisDoc writes the genesis document, without its app state.
+func (w *StreamedGenesisWriter) WriteGenesisDoc(genDoc *tmtypes.GenesisDoc) error {
+	doc := *genDoc
+	doc.AppState = nil
+	bz, err := tmjson.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := w.create(StreamedGenesisDocFile)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Close completes the streamed genesis. No file can be written afterwards.
+func (w *StreamedGenesisWriter) Close() error {
+	if w.tw == nil {
+		return nil
+	}
+
+	if err := w.tw.Close(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+func (w *StreamedGenesisWriter) create(name string) (io.WriteCloser, error) {
+	if w.tw == nil {
+		return os.Create(filepath.Join(w.dir, filepath.FromSlash(name)))
+	}
+
+	// the size of a tar entry precedes its content, so the content is spooled
+	// to a temporary file until it is complete
+	spool, err := os.CreateTemp("", "genesis-*.json")
+	if err != nil {
+		return nil, err
+	}
+	return &tarEntryWriter{File: spool, tw: w.tw, name: name}, nil
+}
+
+// tarEntryWriter spools a file to add to a tar archive once closed.
+type tarEntryWriter struct {
+	*os.File
+
+	tw   *tar.Writer
+	name string
+}
+
+func (w *tarEntryWriter) Close() error {
+	defer os.Remove(w.Name())
+	defer w.File.Close()
+
+	size, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     w.name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(w.tw, w.File)
+	return err
+}
+
+// StreamedGenesisReader reads a streamed genesis from a directory or a tar
+// archive.
+type StreamedGenesisReader struct {
+	path    string
+	tarball bool
+}
+
+// NewStreamedGenesisReader returns a reader of the streamed genesis at the
+// provided path, either a directory or a tar archive.
+func NewStreamedGenesisReader(path string) (*StreamedGenesisReader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamedGenesisReader{path: path, tarball: !info.IsDir()}, nil
+}
+
+// GenesisDoc reads the genesis document, without its app state.
+func (r *StreamedGenesisReader) GenesisDoc() (*tmtypes.GenesisDoc, error) {
+	f, err := r.open(StreamedGenesisDocFile)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%s not found in %s", StreamedGenesisDocFile, r.path)
+	}
+	defer f.Close()
+
+	bz, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return tmtypes.GenesisDocFromJSON(bz)
+}
+
+// ModuleSource returns the source reading the genesis state of each module
+// from its own file. Modules without a file have no genesis state.
+func (r *StreamedGenesisReader) ModuleSource() module.GenesisSource {
+	return func(moduleName string) (io.ReadCloser, error) {
+		return r.open(streamedModuleFile(moduleName))
+	}
+}
+
+// open opens the file with the provided name, returning a nil reader if it
+// doesn't exist.
+func (r *StreamedGenesisReader) open(name string) (io.ReadCloser, error) {
+	if !r.tarball {
+		f, err := os.Open(filepath.Join(r.path, filepath.FromSlash(name)))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return f, err
+	}
+
+	// the entries are looked up by scanning the archive, skipping over the
+	// content of the other entries
+	file, err := os.Open(r.path)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(file)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			_ = file.Close()
+			return nil, nil
+		}
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+
+		if path.Clean(header.Name) == name {
+			return tarEntryReader{Reader: tr, Closer: file}, nil
+		}
+	}
+}
+
+// tarEntryReader reads an entry of a tar archive, closing the archive once
+// done.
+type tarEntryReader struct {
+	io.Reader
+	io.Closer
+}
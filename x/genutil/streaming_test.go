@@ -0,0 +1,79 @@
+package genutil
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestStreamedGenesis(t *testing.T) {
+	dir := t.TempDir()
+	newWriters := map[string]func() (*StreamedGenesisWriter, string, error){
+		"directory": func() (*StreamedGenesisWriter, string, error) {
+			path := filepath.Join(dir, "export")
+			w, err := NewStreamedGenesisDirWriter(path)
+			return w, path, err
+		},
+		"tarball": func() (*StreamedGenesisWriter, string, error) {
+			path := filepath.Join(dir, "export.tar")
+			w, err := NewStreamedGenesisTarballWriter(path)
+			return w, path, err
+		},
+	}
+
+	for name, newWriter := range newWriters {
+		t.Run(name, func(t *testing.T) {
+			w, path, err := newWriter()
+			require.NoError(t, err)
+
+			target := w.ModuleTarget()
+			for moduleName, state := range map[string]string{
+				"bank":    `{"balances":[]}`,
+				"staking": `{"validators":[]}`,
+			} {
+				f, err := target(moduleName)
+				require.NoError(t, err)
+				_, err = io.WriteString(f, state)
+				require.NoError(t, err)
+				require.NoError(t, f.Close())
+			}
+
+			genDoc := &tmtypes.GenesisDoc{
+				GenesisTime:   time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+				ChainID:       "test-chain",
+				InitialHeight: 10,
+				AppState:      []byte(`{"bank":{}}`),
+			}
+			require.NoError(t, w.WriteGenesisDoc(genDoc))
+			require.NoError(t, w.Close())
+
+			r, err := NewStreamedGenesisReader(path)
+			require.NoError(t, err)
+
+			readDoc, err := r.GenesisDoc()
+			require.NoError(t, err)
+			require.Equal(t, genDoc.ChainID, readDoc.ChainID)
+			require.Equal(t, genDoc.InitialHeight, readDoc.InitialHeight)
+			require.Empty(t, readDoc.AppState)
+
+			source := r.ModuleSource()
+			f, err := source("staking")
+			require.NoError(t, err)
+			bz, err := io.ReadAll(f)
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+			require.Equal(t, `{"validators":[]}`, string(bz))
+
+			f, err = source("gov")
+			require.NoError(t, err)
+			require.Nil(t, f)
+		})
+	}
+
+	_, err := NewStreamedGenesisReader(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
@@ -48,7 +48,41 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// tallying deletes the votes, which are kept if an expedited proposal
+		// is converted to a regular one, so it is done in a cached context
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		// an expedited proposal which doesn't pass is converted to a regular
+		// proposal: its voting period is extended to the regular one, at the end
+		// of which it is tallied again with the regular quorum and threshold,
+		// its votes and deposits being kept until then
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(*keeper.GetParams(ctx).VotingPeriod)
+			proposal.VotingEndTime = &endTime
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			logger.Info(
+				"proposal tallied",
+				"proposal", proposal.Id,
+				"results", "expedited proposal converted to regular",
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
@@ -40,6 +40,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -94,6 +95,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -118,6 +120,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -177,6 +180,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -230,7 +234,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -299,7 +303,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", false, addrs[0])
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -350,7 +354,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", false, addrs[0])
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -376,6 +380,138 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, suite.GovKeeper)
 }
 
+func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
+	testcases := map[string]struct {
+		// votes of the three validators, of equal power
+		votes []v1.VoteOption
+		// whether the expedited proposal passes
+		expeditedPasses bool
+		// whether the proposal converted to a regular one passes
+		regularPasses bool
+	}{
+		"expedited passes": {
+			votes:           []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionYes},
+			expeditedPasses: true,
+		},
+		"expedited fails, regular passes": {
+			votes:         []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			regularPasses: true,
+		},
+		"expedited fails, regular fails": {
+			votes: []v1.VoteOption{v1.OptionYes, v1.OptionNo, v1.OptionNo},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens.MulRaw(2))
+			params := suite.GovKeeper.GetParams(ctx)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := make([]sdk.ValAddress, len(addrs))
+			for i, addr := range addrs {
+				valAddrs[i] = sdk.ValAddress(addr)
+			}
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{10, 10, 10})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			macc := suite.GovKeeper.GetGovernanceAccount(ctx)
+			require.NotNil(t, macc)
+			initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			// the minimum deposit is not enough for an expedited proposal to
+			// enter its voting period
+			newProposalMsg, err := v1.NewMsgSubmitProposal(
+				[]sdk.Msg{mkTestLegacyContent(t)},
+				params.MinDeposit,
+				addrs[0].String(),
+				"",
+				true,
+			)
+			require.NoError(t, err)
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.True(t, proposal.Expedited)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			depositCoins := sdk.Coins(params.ExpeditedMinDeposit).Sub(params.MinDeposit...)
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[1], res.ProposalId, depositCoins))
+			require.NoError(t, err)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			for i, option := range tc.votes {
+				err = suite.GovKeeper.AddVote(ctx, res.ProposalId, addrs[i], v1.NewNonSplitVoteOption(option), "")
+				require.NoError(t, err)
+			}
+
+			// the expedited voting period ends
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Duration(1) * time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the proposal is converted to a regular one, its votes and
+			// deposits being kept
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			for i := range tc.votes {
+				_, found := suite.GovKeeper.GetVote(ctx, res.ProposalId, addrs[i])
+				require.True(t, found)
+			}
+			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins.Add(params.ExpeditedMinDeposit...)))
+
+			activeQueue := suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			// the regular voting period ends
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Duration(1) * time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			if tc.regularPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+			}
+			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQuerySimulateProposal(),
 	)
 
 	return govQueryCmd
@@ -508,6 +509,55 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQuerySimulateProposal implements the command to simulate the execution
+// of the messages of a proposal.
+func GetCmdQuerySimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [path/to/proposal.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of the messages of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a proposal against the current state, as if
+the proposal passed, without persisting anything. The proposal file has the same format
+as the one of "%s tx gov submit-proposal", only its messages are used.
+
+Example:
+$ %s query gov simulate-proposal path/to/proposal.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			_, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			req, err := v1.NewQuerySimulateProposalRequest(msgs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
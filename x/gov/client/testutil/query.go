@@ -6,9 +6,12 @@ import (
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -24,7 +27,7 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000"}}`,
+			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","expedited_voting_period":"86400s","expedited_quorum":"0.500000000000000000","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","message_based_params":[]}}`,
 		},
 		{
 			"text output",
@@ -36,11 +39,20 @@ deposit_params:
   - amount: "10000000"
     denom: stake
 params:
+  expedited_min_deposit:
+  - amount: "50000000"
+    denom: stake
+  expedited_quorum: "0.500000000000000000"
+  expedited_threshold: "0.667000000000000000"
+  expedited_voting_period: 86400s
   max_deposit_period: 172800s
+  message_based_params: []
   min_deposit:
   - amount: "10000000"
     denom: stake
   min_initial_deposit_ratio: "0.000000000000000000"
+  proposal_cancel_dest: ""
+  proposal_cancel_ratio: "0.500000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
@@ -219,6 +231,119 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdSimulateProposal() {
+	val := s.network.Validators[0]
+	govAcct := authtypes.NewModuleAddress(types.ModuleName)
+
+	textProp := fmt.Sprintf(`{
+	"messages": [
+		{
+			"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
+			"authority": "%s",
+			"content": {
+				"@type": "/cosmos.gov.v1beta1.TextProposal",
+				"title": "My awesome title",
+				"description": "My awesome description"
+			}
+		}
+	]
+}`, govAcct)
+	textPropFile := testutil.WriteToNewTempFile(s.T(), textProp)
+
+	// The gov account holds the proposal deposits, send more than the supply.
+	sendProp := fmt.Sprintf(`{
+	"messages": [
+		{
+			"@type": "/cosmos.bank.v1beta1.MsgSend",
+			"from_address": "%s",
+			"to_address": "%s",
+			"amount": [{"denom": "%s", "amount": "1000000000000000000000000"}]
+		}
+	]
+}`, govAcct, val.Address, s.cfg.BondDenom)
+	sendPropFile := testutil.WriteToNewTempFile(s.T(), sendProp)
+
+	notGovProp := fmt.Sprintf(`{
+	"messages": [
+		{
+			"@type": "/cosmos.bank.v1beta1.MsgSend",
+			"from_address": "%s",
+			"to_address": "%s",
+			"amount": [{"denom": "%s", "amount": "1000"}]
+		}
+	]
+}`, val.Address, govAcct, s.cfg.BondDenom)
+	notGovPropFile := testutil.WriteToNewTempFile(s.T(), notGovProp)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		execErr   string
+	}{
+		{
+			"without proposal file",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"",
+		},
+		{
+			"signer isn't the gov account",
+			[]string{
+				notGovPropFile.Name(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"",
+		},
+		{
+			"messages executed",
+			[]string{
+				textPropFile.Name(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+		},
+		{
+			"messages failed on execution",
+			[]string{
+				sendPropFile.Name(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySimulateProposal()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var res v1.QuerySimulateProposalResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Positive(res.GasUsed)
+				if tc.execErr == "" {
+					s.Require().Empty(res.Error)
+					s.Require().Len(res.MsgResponses, 1)
+				} else {
+					s.Require().Contains(res.Error, tc.execErr)
+				}
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdGetProposal() {
 	val := s.network.Validators[0]
 
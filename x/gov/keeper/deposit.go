@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -52,11 +54,12 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits v
 }
 
 // DeleteAndBurnDeposits deletes and burn all the deposits on a specific proposal.
+// The burn cannot fail, so it is not subject to the DenomPolicy of the deposits.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
-		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
+		err := keeper.bankKeeper.BurnCoins(banktypes.WithDenomPolicyBypass(ctx), types.ModuleName, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
@@ -131,7 +134,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(proposal.GetMinDepositFromParams(keeper.GetParams(ctx))) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -163,13 +166,14 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 }
 
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
+// The refunds cannot fail, so they are not subject to the DenomPolicy of the deposits.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
 		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
 
-		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(banktypes.WithDenomPolicyBypass(ctx), types.ModuleName, depositor, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
@@ -179,10 +183,68 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeDeposit charges the given ratio of each deposit on a specific proposal and
+// refunds the rest to the depositors, then deletes the deposits. The charged coins
+// are burned when destAddress is empty, sent to the community pool when it is the
+// distribution module address and the keeper has a distribution keeper, and sent
+// to destAddress otherwise. Returns the charged coins.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, ratio string) (sdk.Coins, error) {
+	rate, err := sdk.NewDecFromStr(ratio)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	charges := sdk.NewCoins()
+
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		refund := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			charge := sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
+			charges = charges.Add(sdk.NewCoin(coin.Denom, charge))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(charge)))
+		}
+
+		if !refund.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return nil, err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if charges.IsZero() {
+		return charges, nil
+	}
+
+	switch {
+	case len(destAddress) == 0:
+		err = keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, charges)
+	case keeper.distrKeeper != nil && keeper.authKeeper.GetModuleAddress(disttypes.ModuleName).String() == destAddress:
+		err = keeper.distrKeeper.FundCommunityPool(ctx, charges, keeper.GetGovernanceAccount(ctx).GetAddress())
+	default:
+		var dest sdk.AccAddress
+		dest, err = sdk.AccAddressFromBech32(destAddress)
+		if err != nil {
+			return nil, err
+		}
+		err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, charges)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+// the deposit parameters, expedited proposals requiring a fraction of the expedited minimum
+// deposit. Returns nil on success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
@@ -192,6 +254,9 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 		return nil
 	}
 	minDepositCoins := params.MinDeposit
+	if expedited {
+		minDepositCoins = params.ExpeditedMinDeposit
+	}
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
@@ -21,7 +21,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -105,7 +105,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = govKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -121,6 +121,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 		minDeposit               sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
+		expedited                bool
 
 		expectError bool
 	}{
@@ -184,6 +185,20 @@ func TestValidateInitialDeposit(t *testing.T) {
 				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
 		},
+		"expedited min deposit * initial percent == initial deposit: success": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100))),
+			expedited:                true,
+		},
+		"expedited min deposit * initial percent > initial deposit == min deposit * initial percent: error": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			expedited:                true,
+
+			expectError: true,
+		},
 		"0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -197,11 +212,12 @@ func TestValidateInitialDeposit(t *testing.T) {
 
 			params := v1.DefaultParams()
 			params.MinDeposit = tc.minDeposit
+			params.ExpeditedMinDeposit = tc.minDeposit.MulInt(sdk.NewInt(2))
 			params.MinInitialDepositRatio = sdk.NewDec(tc.minInitialDepositPercent).Quo(sdk.NewDec(100)).String()
 
 			govKeeper.SetParams(ctx, params)
 
-			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit)
+			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit, tc.expedited)
 
 			if tc.expectError {
 				require.Error(t, err)
@@ -211,3 +227,56 @@ func TestValidateInitialDeposit(t *testing.T) {
 		})
 	}
 }
+
+func TestChargeDeposit(t *testing.T) {
+	testcases := []struct {
+		name           string
+		ratio          string
+		dest           string
+		expectedCharge int64
+	}{
+		{"no charge", "0", "", 0},
+		{"half burned", "0.5", "", 2000000},
+		{"half sent to the community pool", "0.5", distAcct.String(), 2000000},
+		{"half sent to an account", "0.5", addr.String(), 2000000},
+		{"fully charged", "1", "", 4000000},
+		{"charge truncated", "0.3333", "", 1333200},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+			TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", false, TestAddrs[0])
+			require.NoError(t, err)
+			proposalID := proposal.Id
+
+			twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 2)))
+			_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], twoStake)
+			require.NoError(t, err)
+			_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], twoStake)
+			require.NoError(t, err)
+
+			addr0Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+			destInitial := bankKeeper.GetAllBalances(ctx, addr)
+
+			charges, err := govKeeper.ChargeDeposit(ctx, proposalID, tc.dest, tc.ratio)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt(tc.expectedCharge), charges.AmountOf(sdk.DefaultBondDenom))
+			require.Len(t, govKeeper.GetDeposits(ctx, proposalID), 0)
+
+			// each depositor is refunded what was not charged on their own deposit
+			refund := twoStake.AmountOf(sdk.DefaultBondDenom).Sub(sdk.NewInt(tc.expectedCharge / 2))
+			require.Equal(t, addr0Initial.AmountOf(sdk.DefaultBondDenom).Add(refund), bankKeeper.GetAllBalances(ctx, TestAddrs[0]).AmountOf(sdk.DefaultBondDenom))
+			require.Equal(t, addr1Initial.AmountOf(sdk.DefaultBondDenom).Add(refund), bankKeeper.GetAllBalances(ctx, TestAddrs[1]).AmountOf(sdk.DefaultBondDenom))
+
+			if tc.dest == addr.String() {
+				require.Equal(t, destInitial.Add(charges...), bankKeeper.GetAllBalances(ctx, addr))
+			} else {
+				require.Equal(t, destInitial, bankKeeper.GetAllBalances(ctx, addr))
+			}
+		})
+	}
+}
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -281,6 +284,71 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// SimulateProposal executes the messages of a proposal the way they are
+// executed when it passes, against a branch of the current state which is
+// discarded afterwards. Messages which can't be proposed are rejected, while
+// the error of the first message failing execution is part of the response.
+func (q Keeper) SimulateProposal(c context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal messages can not be empty")
+	}
+
+	if err := req.UnpackInterfaces(q.cdc); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	handlers := make([]baseapp.MsgServiceHandler, len(msgs))
+	for i, msg := range msgs {
+		handlers[i], err = q.validateProposalMsg(ctx, msg)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// The write function of the cached context is never called, so that none of
+	// the state changes of the messages are persisted.
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	res := &v1.QuerySimulateProposalResponse{}
+	for i, msg := range msgs {
+		msgRes, err := simulateProposalMsg(cacheCtx, handlers[i], msg)
+		if err != nil {
+			res.Error = fmt.Sprintf("msg %d (%s) failed on execution: %s", i, sdk.MsgTypeURL(msg), err)
+			break
+		}
+
+		res.Events = append(res.Events, msgRes.Events...)
+		res.MsgResponses = append(res.MsgResponses, msgRes.MsgResponses...)
+	}
+	res.GasUsed = cacheCtx.GasMeter().GasConsumed()
+
+	return res, nil
+}
+
+// simulateProposalMsg executes a proposal message, turning a panic of its
+// handler into an error as the message comes from a query.
+func simulateProposalMsg(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	return handler(ctx, msg)
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
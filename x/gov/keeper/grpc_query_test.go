@@ -9,6 +9,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -56,7 +57,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -131,7 +132,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -197,7 +198,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "")
+					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", false, addr)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -335,7 +336,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -416,7 +417,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -530,7 +531,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -636,7 +637,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -738,7 +739,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -877,6 +878,80 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params := v1.DefaultParams()
+	params.Quorum = "0.5"
+	updateParams := &v1.MsgUpdateParams{Authority: govAcct.String(), Params: params}
+	send := banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+
+	testCases := []struct {
+		msg             string
+		msgs            []sdk.Msg
+		expPass         bool
+		expErr          string
+		expMsgResponses int
+	}{
+		{
+			"empty request",
+			nil,
+			false,
+			"",
+			0,
+		},
+		{
+			"signer isn't the gov account",
+			[]sdk.Msg{&v1.MsgUpdateParams{Authority: addr.String(), Params: params}},
+			false,
+			"",
+			0,
+		},
+		{
+			"messages executed",
+			[]sdk.Msg{updateParams},
+			true,
+			"",
+			1,
+		},
+		{
+			"second message failed on execution",
+			// the bank msg server isn't set in the test setup, its handler panics
+			[]sdk.Msg{updateParams, send},
+			true,
+			"msg 1 (/cosmos.bank.v1beta1.MsgSend) failed on execution",
+			1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			req, err := v1.NewQuerySimulateProposalRequest(testCase.msgs)
+			suite.Require().NoError(err)
+
+			res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				if testCase.expErr == "" {
+					suite.Require().Empty(res.Error)
+				} else {
+					suite.Require().Contains(res.Error, testCase.expErr)
+				}
+				suite.Require().Len(res.MsgResponses, testCase.expMsgResponses)
+				suite.Require().Positive(res.GasUsed)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			// nothing is persisted
+			suite.Require().Equal(v1.DefaultParams(), suite.govKeeper.GetParams(ctx))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryParams() {
 	queryClient := suite.legacyQueryClient
 
@@ -1023,7 +1098,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1124,7 +1199,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1214,7 +1289,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1308,7 +1383,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -27,9 +27,16 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the distribution keeper to fund the community pool with cancellation charges,
+	// which may be nil
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
+	// The function tallying the votes of proposals
+	tallyFn TallyFn
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey storetypes.StoreKey
 
@@ -63,7 +70,7 @@ func (k Keeper) GetAuthority() string {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper types.AccountKeeper,
-	bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	router *baseapp.MsgServiceRouter, config types.Config, authority string,
 ) *Keeper {
 	// ensure governance module account is set
@@ -81,14 +88,16 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     router,
-		config:     config,
-		authority:  authority,
+		storeKey:    key,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		distrKeeper: distrKeeper,
+		cdc:         cdc,
+		router:      router,
+		config:      config,
+		authority:   authority,
+		tallyFn:     DefaultTally,
 	}
 }
 
@@ -103,6 +112,18 @@ func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return keeper
 }
 
+// SetTallyFn sets the function used to tally the votes of proposals, replacing
+// DefaultTally.
+func (keeper *Keeper) SetTallyFn(fn TallyFn) *Keeper {
+	if fn == nil {
+		panic("cannot set a nil governance tally function")
+	}
+
+	keeper.tallyFn = fn
+
+	return keeper
+}
+
 func (keeper *Keeper) SetLegacyRouter(router v1beta1.Router) {
 	// It is vital to seal the governance proposal router here as to not allow
 	// further handlers to be registered after the keeper is created since this
@@ -74,17 +74,17 @@ func TestIncrementProposalNumber(t *testing.T) {
 	govKeeper, _, _, _, _, ctx := setupGovKeeper(t)
 
 	tp := TestProposal
-	_, err := govKeeper.SubmitProposal(ctx, tp, "")
+	_, err := govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
-	_, err = govKeeper.SubmitProposal(ctx, tp, "")
+	_, err = govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
-	_, err = govKeeper.SubmitProposal(ctx, tp, "")
+	_, err = govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
-	_, err = govKeeper.SubmitProposal(ctx, tp, "")
+	_, err = govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
-	_, err = govKeeper.SubmitProposal(ctx, tp, "")
+	_, err = govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
-	proposal6, err := govKeeper.SubmitProposal(ctx, tp, "")
+	proposal6, err := govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.Id)
@@ -95,7 +95,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", false, addr)
 	require.NoError(t, err)
 
 	inactiveIterator := govKeeper.InactiveProposalQueueIterator(ctx, *proposal.DepositEndTime)
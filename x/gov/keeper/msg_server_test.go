@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -39,6 +40,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					strings.Repeat("1", 300),
+					false,
 				)
 			},
 			expErr:    true,
@@ -51,6 +53,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -63,6 +66,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -75,6 +79,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -87,6 +92,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -98,6 +104,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -139,6 +146,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -162,6 +170,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -203,6 +212,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -252,6 +262,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -276,6 +287,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -317,6 +329,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -365,6 +378,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -415,6 +429,138 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	submitProposal := func() uint64 {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{bankMsg},
+			coins,
+			proposer.String(),
+			"",
+			false,
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(res.ProposalId)
+		return res.ProposalId
+	}
+
+	cases := map[string]struct {
+		preRun    func() uint64
+		proposer  sdk.AccAddress
+		expErr    bool
+		expErrMsg string
+	}{
+		"wrong proposal id": {
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+		"not the proposer": {
+			preRun:    submitProposal,
+			proposer:  addrs[1],
+			expErr:    true,
+			expErrMsg: "invalid proposer",
+		},
+		"voting period ended": {
+			preRun: func() uint64 {
+				pID := submitProposal()
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, pID)
+				suite.Require().True(ok)
+				suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+				proposal, ok = suite.govKeeper.GetProposal(suite.ctx, pID)
+				suite.Require().True(ok)
+				votingEndTime := suite.ctx.BlockTime().Add(-time.Second)
+				proposal.VotingEndTime = &votingEndTime
+				suite.govKeeper.SetProposal(suite.ctx, proposal)
+				return pID
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "voting period already ended",
+		},
+		"proposal passed": {
+			preRun: func() uint64 {
+				pID := submitProposal()
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, pID)
+				suite.Require().True(ok)
+				proposal.Status = v1.StatusPassed
+				suite.govKeeper.SetProposal(suite.ctx, proposal)
+				return pID
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "voting period already ended",
+		},
+		"all good": {
+			preRun:   submitProposal,
+			proposer: proposer,
+			expErr:   false,
+		},
+		"all good in voting period": {
+			preRun: func() uint64 {
+				pID := submitProposal()
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, pID)
+				suite.Require().True(ok)
+				suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+				suite.Require().NoError(suite.govKeeper.AddVote(suite.ctx, pID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				return pID
+			},
+			proposer: proposer,
+			expErr:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.proposer))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposalID, res.ProposalId)
+			_, found := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.govKeeper.GetDeposits(suite.ctx, proposalID))
+			suite.Require().Empty(suite.govKeeper.GetVotes(suite.ctx, proposalID))
+
+			// the proposal is removed from the proposal queues
+			endTime := suite.ctx.BlockTime().Add(2 * v1.DefaultPeriod)
+			activeIterator := suite.govKeeper.ActiveProposalQueueIterator(suite.ctx, endTime)
+			defer activeIterator.Close()
+			for ; activeIterator.Valid(); activeIterator.Next() {
+				queuedID, _ := types.SplitActiveProposalQueueKey(activeIterator.Key())
+				suite.Require().NotEqual(proposalID, queuedID)
+			}
+			inactiveIterator := suite.govKeeper.InactiveProposalQueueIterator(suite.ctx, endTime)
+			defer inactiveIterator.Close()
+			for ; inactiveIterator.Valid(); inactiveIterator.Next() {
+				queuedID, _ := types.SplitInactiveProposalQueueKey(inactiveIterator.Key())
+				suite.Require().NotEqual(proposalID, queuedID)
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -483,6 +629,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -506,6 +653,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -537,6 +685,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -586,6 +735,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -610,6 +760,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -641,6 +792,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -689,6 +841,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -984,6 +1137,144 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "negative proposal cancel ratio",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "-0.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "proposal cancel ratio cannot be negative",
+		},
+		{
+			name: "proposal cancel ratio > 1",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "1.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "proposal cancel ratio too large",
+		},
+		{
+			name: "invalid proposal cancel dest",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelDest = "dest"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid proposal cancel destination address",
+		},
+		{
+			name: "valid message based params",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", "0.5", "0.667", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "duplicate message based params",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", "0.5", "0.667", "0.2"),
+					v1.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", "0.4", "0.6", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "duplicate message based params",
+		},
+		{
+			name: "empty message based params url",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("", "0.5", "0.667", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "message type url cannot be empty",
+		},
+		{
+			name: "message based params quorum > 1",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", "1.1", "0.667", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "quorom too large",
+		},
+		{
+			name: "message based params threshold not positive",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", "0.5", "0", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "vote threshold must be positive",
+		},
+		{
+			name: "unroutable message based params url",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					v1.NewMessageBasedParams("/cosmos.unknown.v1.MsgUnknown", "0.5", "0.667", "0.2"),
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "proposal message not recognized by router",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1067,7 +1358,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 			params.MinInitialDepositRatio = tc.minInitialDepositRatio.String()
 			govKeeper.SetParams(ctx, params)
 
-			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test")
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", false)
 			suite.Require().NoError(err)
 
 			// System under test
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,8 +12,10 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. Expedited
+// proposals have their own voting period, quorum, threshold and minimum deposit.
+// The proposer is recorded, being the only one allowed to cancel the proposal.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, expedited bool, proposer sdk.AccAddress) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -26,25 +29,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// perform a basic validation of the message
-		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		handler, err := keeper.validateProposalMsg(ctx, msg)
+		if err != nil {
+			return v1.Proposal{}, err
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -72,7 +59,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), expedited, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -95,6 +82,73 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// validateProposalMsg performs a basic validation of a proposal message, asserts
+// that the governance module account is its only signer and returns the handler
+// routing it.
+func (keeper Keeper) validateProposalMsg(ctx sdk.Context, msg sdk.Msg) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := keeper.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return handler, nil
+}
+
+// CancelProposal cancels a proposal on behalf of its proposer before the end of its
+// voting period. The proposal cancel ratio of the deposits is charged and the rest
+// refunded, then the votes and the proposal are deleted. Returns the charged coins.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) (sdk.Coins, error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProposer, "expected %s got %s", proposal.Proposer, proposer)
+	}
+
+	// Proposals can only be canceled in their deposit or voting period, before
+	// they are tallied at the end of the block of their voting end time.
+	if (proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod) ||
+		(proposal.VotingEndTime != nil && !proposal.VotingEndTime.After(ctx.BlockTime())) {
+		return nil, sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+	}
+
+	params := keeper.GetParams(ctx)
+	charges, err := keeper.ChargeDeposit(ctx, proposalID, params.ProposalCancelDest, params.ProposalCancelRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal is canceled by its proposer",
+		"proposal", proposalID,
+		"proposer", proposer,
+		"charges", charges.String(),
+	)
+
+	return charges, nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -241,7 +295,11 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetParams(ctx).VotingPeriod
+	params := keeper.GetParams(ctx)
+	votingPeriod := params.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = params.ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
@@ -7,11 +7,25 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
+// TallyFn tallies the votes of a proposal, returning whether the proposal
+// passes, whether its deposits are burned and the tally result. It must not
+// delete the votes, which is done by the keeper once they are tallied.
+type TallyFn func(ctx sdk.Context, keeper Keeper, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult)
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// Tally tallies the votes of a proposal with the tally function of the keeper,
+// DefaultTally unless set with SetTallyFn, and deletes them.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
+	passes, burnDeposits, tallyResults = keeper.tallyFn(ctx, keeper, proposal)
+	keeper.deleteVotes(ctx, proposal.Id)
+
+	return passes, burnDeposits, tallyResults
+}
+
+// TODO: Break into several smaller functions for clarity
+
+// DefaultTally iterates over the votes and updates the tally of a proposal based on the voting power of the
+// voters, which is their bonded stake. Delegators who don't vote inherit the vote of their validators.
+func DefaultTally(ctx sdk.Context, keeper Keeper, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -68,7 +82,6 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -89,39 +102,51 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
+	passes, burnDeposits = keeper.TallyOutcome(ctx, proposal, results, totalVotingPower, sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+
+	return passes, burnDeposits, tallyResults
+}
+
+// TallyOutcome returns whether a proposal passes and whether its deposits are burned, given the voting power
+// cast for each vote option, the total voting power cast and the total voting power which could have been
+// cast. The quorum, threshold and veto threshold params, or the expedited ones for an expedited proposal,
+// are applied, so that tally functions counting voting power differently can share them. The message based
+// params of the proposal messages apply when they are stricter.
+func (keeper Keeper) TallyOutcome(ctx sdk.Context, proposal v1.Proposal, results map[v1.VoteOption]sdk.Dec, totalVotingPower, maxVotingPower sdk.Dec) (passes bool, burnDeposits bool) {
+	msgURLs := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		msgURLs[i] = msg.TypeUrl
+	}
+	quorum, threshold, vetoThreshold := keeper.GetParams(ctx).ProposalTallyParams(msgURLs, proposal.Expedited)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+	if !maxVotingPower.IsPositive() {
+		return false, false
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
+	percentVoting := totalVotingPower.Quo(maxVotingPower)
 	if percentVoting.LT(quorum) {
-		return false, false, tallyResults
+		return false, false
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+		return false, false
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, true, tallyResults
+		return false, true
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+		return true, false
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false
 }
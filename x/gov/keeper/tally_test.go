@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestCustomTallyFn(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(10000000))
+
+	// tally the votes by the tokens held by the voters, out of the tokens held by addrs
+	tokenTally := func(ctx sdk.Context, k keeper.Keeper, proposal v1.Proposal) (bool, bool, v1.TallyResult) {
+		results := map[v1.VoteOption]sdk.Dec{
+			v1.OptionYes:        math.LegacyZeroDec(),
+			v1.OptionAbstain:    math.LegacyZeroDec(),
+			v1.OptionNo:         math.LegacyZeroDec(),
+			v1.OptionNoWithVeto: math.LegacyZeroDec(),
+		}
+		totalVotingPower := math.LegacyZeroDec()
+		k.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
+			votingPower := sdk.NewDecFromInt(bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(vote.Voter)).AmountOf(sdk.DefaultBondDenom))
+			for _, option := range vote.Options {
+				weight, _ := sdk.NewDecFromStr(option.Weight)
+				results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+			return false
+		})
+
+		maxVotingPower := math.LegacyZeroDec()
+		for _, addr := range addrs {
+			maxVotingPower = maxVotingPower.Add(sdk.NewDecFromInt(bankKeeper.GetAllBalances(ctx, addr).AmountOf(sdk.DefaultBondDenom)))
+		}
+
+		passes, burnDeposits := k.TallyOutcome(ctx, proposal, results, totalVotingPower, maxVotingPower)
+		return passes, burnDeposits, v1.NewTallyResultFromMap(results)
+	}
+	govKeeper.SetTallyFn(tokenTally)
+
+	testCases := []struct {
+		name      string
+		votes     []v1.VoteOption
+		expPasses bool
+		expBurn   bool
+	}{
+		{
+			name:      "no quorum",
+			votes:     []v1.VoteOption{v1.OptionYes},
+			expPasses: false,
+			expBurn:   false,
+		},
+		{
+			name:      "passes",
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPasses: true,
+			expBurn:   false,
+		},
+		{
+			name:      "vetoed",
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionNoWithVeto, v1.OptionNoWithVeto},
+			expPasses: false,
+			expBurn:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+			yesTokens := math.ZeroInt()
+			for i, option := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+				if option == v1.OptionYes {
+					yesTokens = yesTokens.Add(bankKeeper.GetAllBalances(ctx, addrs[i]).AmountOf(sdk.DefaultBondDenom))
+				}
+			}
+
+			proposal, ok := govKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			passes, burnDeposits, tallyResults := govKeeper.Tally(ctx, proposal)
+			require.Equal(t, tc.expPasses, passes)
+			require.Equal(t, tc.expBurn, burnDeposits)
+			require.Equal(t, yesTokens.String(), tallyResults.YesCount)
+
+			// the keeper deletes the votes once tallied
+			require.Empty(t, govKeeper.GetVotes(ctx, proposal.Id))
+		})
+	}
+}
+
+func TestSetTallyFnNil(t *testing.T) {
+	govKeeper, _, _, _, _, _ := setupGovKeeper(t)
+	require.Panics(t, func() { govKeeper.SetTallyFn(nil) })
+}
+
+func TestTallyOutcomeMessageBasedParams(t *testing.T) {
+	govKeeper, _, _, _, _, ctx := setupGovKeeper(t)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", false, addr)
+	require.NoError(t, err)
+
+	// 60% of the voting power votes yes
+	results := map[v1.VoteOption]sdk.Dec{
+		v1.OptionYes:        sdk.NewDec(6),
+		v1.OptionAbstain:    math.LegacyZeroDec(),
+		v1.OptionNo:         math.LegacyZeroDec(),
+		v1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+	totalVotingPower, maxVotingPower := sdk.NewDec(6), sdk.NewDec(10)
+
+	passes, _ := govKeeper.TallyOutcome(ctx, proposal, results, totalVotingPower, maxVotingPower)
+	require.True(t, passes)
+
+	// a stricter quorum for one of the messages applies to the whole proposal
+	params := govKeeper.GetParams(ctx)
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		v1.NewMessageBasedParams(sdk.MsgTypeURL(TestProposal[0]), "0.7", "0.5", "0.334"),
+	}
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	passes, _ = govKeeper.TallyOutcome(ctx, proposal, results, totalVotingPower, maxVotingPower)
+	require.False(t, passes)
+}
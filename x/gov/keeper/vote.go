@@ -123,3 +123,16 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes of a given proposalID from the store
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	var voters []sdk.AccAddress
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		voters = append(voters, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+
+	for _, voter := range voters {
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
+}
@@ -5,15 +5,7 @@ import (
 )
 
 func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
-	params := v1.NewParams(
-		oldState.DepositParams.MinDeposit,
-		*oldState.DepositParams.MaxDepositPeriod,
-		*oldState.VotingParams.VotingPeriod,
-		oldState.TallyParams.Quorum,
-		oldState.TallyParams.Threshold,
-		oldState.TallyParams.VetoThreshold,
-		v1.DefaultParams().MinInitialDepositRatio,
-	)
+	params := newParams(*oldState.DepositParams, *oldState.VotingParams, *oldState.TallyParams, v1.DefaultParams().MinInitialDepositRatio)
 
 	return &v1.GenesisState{
 		StartingProposalId: oldState.StartingProposalId,
@@ -36,11 +36,22 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
+	// - Params have expedited proposal params derived from the regular ones
 	expected := `{
 	"deposit_params": null,
 	"deposits": [],
 	"params": {
+		"expedited_min_deposit": [
+			{
+				"amount": "50000000",
+				"denom": "stake"
+			}
+		],
+		"expedited_quorum": "0.500000000000000000",
+		"expedited_threshold": "0.667000000000000000",
+		"expedited_voting_period": "86400s",
 		"max_deposit_period": "172800s",
+		"message_based_params": [],
 		"min_deposit": [
 			{
 				"amount": "10000000",
@@ -48,6 +59,8 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
@@ -18,15 +18,7 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 	legacySubspace.Get(ctx, govv1.ParamStoreKeyVotingParams, &vp)
 	legacySubspace.Get(ctx, govv1.ParamStoreKeyTallyParams, &tp)
 
-	params := govv1.NewParams(
-		dp.MinDeposit,
-		*dp.MaxDepositPeriod,
-		*vp.VotingPeriod,
-		tp.Quorum,
-		tp.Threshold,
-		tp.VetoThreshold,
-		sdk.ZeroDec().String(),
-	)
+	params := newParams(dp, vp, tp, sdk.ZeroDec().String())
 
 	bz, err := cdc.Marshal(&params)
 	if err != nil {
@@ -38,11 +30,47 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 	return nil
 }
 
+// newParams returns the params made of the legacy deposit, voting and tally
+// params, the expedited proposal params being derived from them and the proposal
+// cancellation params being the default ones.
+func newParams(dp govv1.DepositParams, vp govv1.VotingParams, tp govv1.TallyParams, minInitialDepositRatio string) govv1.Params {
+	expeditedVotingPeriod := govv1.DefaultExpeditedPeriod
+	if expeditedVotingPeriod >= *vp.VotingPeriod {
+		expeditedVotingPeriod = *vp.VotingPeriod / 2
+	}
+
+	expeditedQuorum := sdk.MaxDec(sdk.MustNewDecFromStr(tp.Quorum), govv1.DefaultExpeditedQuorum)
+
+	threshold := sdk.MustNewDecFromStr(tp.Threshold)
+	expeditedThreshold := govv1.DefaultExpeditedThreshold
+	if expeditedThreshold.LTE(threshold) {
+		expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+
+	return govv1.NewParams(
+		dp.MinDeposit,
+		sdk.Coins(dp.MinDeposit).MulInt(govv1.DefaultExpeditedMinDeposit.Quo(govv1.DefaultMinDepositTokens)),
+		*dp.MaxDepositPeriod,
+		*vp.VotingPeriod,
+		expeditedVotingPeriod,
+		tp.Quorum,
+		expeditedQuorum.String(),
+		tp.Threshold,
+		expeditedThreshold.String(),
+		tp.VetoThreshold,
+		minInitialDepositRatio,
+		govv1.DefaultProposalCancelRatio.String(),
+		govv1.DefaultProposalCancelDest,
+	)
+}
+
 // MigrateStore performs in-place store migrations from v3 (v0.46) to v4 (v0.47). The
 // migration includes:
 //
 // Params migrations from x/params to gov
 // Addition of the new min initial deposit ratio parameter that is set to 0 by default.
+// Addition of the new expedited proposal parameters, derived from the regular ones.
+// Addition of the new proposal cancellation parameters, set to their default values.
 func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.ParamSubspace, cdc codec.BinaryCodec) error {
 	return migrateParams(ctx, storeKey, legacySubspace, cdc)
 }
@@ -2,6 +2,7 @@ package v4_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -70,4 +71,35 @@ func TestMigrateStore(t *testing.T) {
 	require.Equal(t, legacySubspace.tp.Threshold, params.Threshold)
 	require.Equal(t, legacySubspace.tp.VetoThreshold, params.VetoThreshold)
 	require.Equal(t, sdk.ZeroDec().String(), params.MinInitialDepositRatio)
+	require.Equal(t, v1.DefaultParams().ExpeditedMinDeposit, params.ExpeditedMinDeposit)
+	require.Equal(t, v1.DefaultExpeditedPeriod, *params.ExpeditedVotingPeriod)
+	require.Equal(t, v1.DefaultExpeditedQuorum.String(), params.ExpeditedQuorum)
+	require.Equal(t, v1.DefaultExpeditedThreshold.String(), params.ExpeditedThreshold)
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), params.ProposalCancelRatio)
+	require.Equal(t, v1.DefaultProposalCancelDest, params.ProposalCancelDest)
+	require.NoError(t, params.ValidateBasic())
+}
+
+func TestMigrateStoreExpeditedParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(upgrade.AppModuleBasic{}, gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	oldParams := v1.DefaultParams()
+	votingPeriod := time.Hour * 12
+	oldParams.VotingPeriod = &votingPeriod
+	oldParams.Quorum = "0.600000000000000000"
+	oldParams.Threshold = "0.700000000000000000"
+	legacySubspace := newMockSubspace(oldParams)
+	err := v4.MigrateStore(ctx, govKey, legacySubspace, cdc)
+	require.NoError(t, err)
+
+	var params v1.Params
+	bz := store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.Equal(t, time.Hour*6, *params.ExpeditedVotingPeriod)
+	require.Equal(t, "0.600000000000000000", params.ExpeditedQuorum)
+	require.Equal(t, "0.850000000000000000", params.ExpeditedThreshold)
+	require.NoError(t, params.ValidateBasic())
 }
@@ -172,6 +172,12 @@ type govInputs struct {
 	BankKeeper    govtypes.BankKeeper
 	StakingKeeper govtypes.StakingKeeper
 
+	// DistributionKeeper is used to send proposal cancellation charges to the community pool
+	DistributionKeeper govtypes.DistributionKeeper `optional:"true"`
+
+	// TallyFn replaces the default tally function if provided
+	TallyFn keeper.TallyFn `optional:"true"`
+
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace govtypes.ParamSubspace
 }
@@ -201,10 +207,15 @@ func provideModule(in govInputs) govOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.StakingKeeper,
+		in.DistributionKeeper,
 		in.MsgServiceRouter,
 		kConfig,
 		authority.String(),
 	)
+	if in.TallyFn != nil {
+		k.SetTallyFn(in.TallyFn)
+	}
+
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
 	hr := v1beta1.HandlerRoute{Handler: v1beta1.ProposalHandler, RouteKey: govtypes.RouterKey}
 
@@ -42,18 +42,28 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
 	const (
-		tallyQuorum          = "0.400000000000000000"
-		tallyThreshold       = "0.539000000000000000"
-		tallyVetoThreshold   = "0.314000000000000000"
-		minInitialDepositDec = "0.590000000000000000"
+		tallyQuorum             = "0.466000000000000000"
+		tallyExpeditedQuorum    = "0.574000000000000000"
+		tallyThreshold          = "0.461000000000000000"
+		tallyExpeditedThreshold = "0.595000000000000000"
+		tallyVetoThreshold      = "0.327000000000000000"
+		minInitialDepositDec    = "0.880000000000000000"
+		proposalCancelRatio     = "0.050000000000000000"
 	)
 
 	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
-	require.Equal(t, "77h26m10s", govGenesis.Params.MaxDepositPeriod.String())
-	require.Equal(t, float64(275567), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, "4300stake", govGenesis.Params.ExpeditedMinDeposit[0].String())
+	require.Equal(t, "41h11m36s", govGenesis.Params.MaxDepositPeriod.String())
+	require.Equal(t, float64(135894), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, "4h31m47.28s", govGenesis.Params.ExpeditedVotingPeriod.String())
 	require.Equal(t, tallyQuorum, govGenesis.Params.Quorum)
+	require.Equal(t, tallyExpeditedQuorum, govGenesis.Params.ExpeditedQuorum)
 	require.Equal(t, tallyThreshold, govGenesis.Params.Threshold)
+	require.Equal(t, tallyExpeditedThreshold, govGenesis.Params.ExpeditedThreshold)
 	require.Equal(t, tallyVetoThreshold, govGenesis.Params.VetoThreshold)
+	require.Equal(t, minInitialDepositDec, govGenesis.Params.MinInitialDepositRatio)
+	require.Equal(t, proposalCancelRatio, govGenesis.Params.ProposalCancelRatio)
+	require.Equal(t, "", govGenesis.Params.ProposalCancelDest)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
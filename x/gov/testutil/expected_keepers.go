@@ -33,3 +33,8 @@ type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 	TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int
 }
+
+// DistributionKeeper defines the expected distribution keeper
+type DistributionKeeper interface {
+	types.DistributionKeeper
+}
@@ -12,6 +12,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	history "github.com/cosmos/cosmos-sdk/x/bank/history"
 	keeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -130,6 +131,22 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddSendRestrictionBypass mocks base method.
+func (m *MockBankKeeper) AddSendRestrictionBypass(moduleNames ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range moduleNames {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddSendRestrictionBypass", varargs...)
+}
+
+// AddSendRestrictionBypass indicates an expected call of AddSendRestrictionBypass.
+func (mr *MockBankKeeperMockRecorder) AddSendRestrictionBypass(moduleNames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSendRestrictionBypass", reflect.TypeOf((*MockBankKeeper)(nil).AddSendRestrictionBypass), moduleNames...)
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types1.QueryAllBalancesRequest) (*types1.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +162,18 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AppendSendRestriction mocks base method.
+func (m *MockBankKeeper) AppendSendRestriction(restriction types1.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AppendSendRestriction", restriction)
+}
+
+// AppendSendRestriction indicates an expected call of AppendSendRestriction.
+func (mr *MockBankKeeperMockRecorder) AppendSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).AppendSendRestriction), restriction)
+}
+
 // Balance mocks base method.
 func (m *MockBankKeeper) Balance(arg0 context.Context, arg1 *types1.QueryBalanceRequest) (*types1.QueryBalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +189,21 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceHistory mocks base method.
+func (m *MockBankKeeper) BalanceHistory(arg0 context.Context, arg1 *types1.QueryBalanceHistoryRequest) (*types1.QueryBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockBankKeeperMockRecorder) BalanceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).BalanceHistory), arg0, arg1)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -188,6 +232,18 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// ClearSendRestriction mocks base method.
+func (m *MockBankKeeper) ClearSendRestriction() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearSendRestriction")
+}
+
+// ClearSendRestriction indicates an expected call of ClearSendRestriction.
+func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx types.Context, delegatorAddr, moduleAccAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -216,6 +272,23 @@ func (mr *MockBankKeeperMockRecorder) DelegateCoinsFromAccountToModule(ctx, send
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).DelegateCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// DeleteDenomPolicies mocks base method.
+func (m *MockBankKeeper) DeleteDenomPolicies(ctx types.Context, denoms ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range denoms {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DeleteDenomPolicies", varargs...)
+}
+
+// DeleteDenomPolicies indicates an expected call of DeleteDenomPolicies.
+func (mr *MockBankKeeperMockRecorder) DeleteDenomPolicies(ctx interface{}, denoms ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, denoms...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDenomPolicies", reflect.TypeOf((*MockBankKeeper)(nil).DeleteDenomPolicies), varargs...)
+}
+
 // DeleteSendEnabled mocks base method.
 func (m *MockBankKeeper) DeleteSendEnabled(ctx types.Context, denoms ...string) {
 	m.ctrl.T.Helper()
@@ -263,6 +336,21 @@ func (mr *MockBankKeeperMockRecorder) DenomOwners(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwners", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwners), arg0, arg1)
 }
 
+// DenomPolicies mocks base method.
+func (m *MockBankKeeper) DenomPolicies(arg0 context.Context, arg1 *types1.QueryDenomPoliciesRequest) (*types1.QueryDenomPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomPolicies indicates an expected call of DenomPolicies.
+func (mr *MockBankKeeperMockRecorder) DenomPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomPolicies", reflect.TypeOf((*MockBankKeeper)(nil).DenomPolicies), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types1.QueryDenomsMetadataRequest) (*types1.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +408,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// GetAllDenomPolicies mocks base method.
+func (m *MockBankKeeper) GetAllDenomPolicies(ctx types.Context) []types1.DenomPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDenomPolicies", ctx)
+	ret0, _ := ret[0].([]types1.DenomPolicy)
+	return ret0
+}
+
+// GetAllDenomPolicies indicates an expected call of GetAllDenomPolicies.
+func (mr *MockBankKeeperMockRecorder) GetAllDenomPolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomPolicies", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomPolicies), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx types.Context) []types1.SendEnabled {
 	m.ctrl.T.Helper()
@@ -391,6 +493,21 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetDenomPolicy mocks base method.
+func (m *MockBankKeeper) GetDenomPolicy(ctx types.Context, denom string) (types1.DenomPolicy, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomPolicy", ctx, denom)
+	ret0, _ := ret[0].(types1.DenomPolicy)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomPolicy indicates an expected call of GetDenomPolicy.
+func (mr *MockBankKeeperMockRecorder) GetDenomPolicy(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomPolicy", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomPolicy), ctx, denom)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx types.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -518,6 +635,20 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, inputs, outputs inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, inputs, outputs)
 }
 
+// IsDenomPaused mocks base method.
+func (m *MockBankKeeper) IsDenomPaused(ctx types.Context, denom string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDenomPaused", ctx, denom)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDenomPaused indicates an expected call of IsDenomPaused.
+func (mr *MockBankKeeperMockRecorder) IsDenomPaused(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDenomPaused", reflect.TypeOf((*MockBankKeeper)(nil).IsDenomPaused), ctx, denom)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx types.Context, coin types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -601,6 +732,18 @@ func (mr *MockBankKeeperMockRecorder) IterateAllDenomMetaData(ctx, cb interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllDenomMetaData), ctx, cb)
 }
 
+// IterateDenomPolicies mocks base method.
+func (m *MockBankKeeper) IterateDenomPolicies(ctx types.Context, cb func(types1.DenomPolicy) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateDenomPolicies", ctx, cb)
+}
+
+// IterateDenomPolicies indicates an expected call of IterateDenomPolicies.
+func (mr *MockBankKeeperMockRecorder) IterateDenomPolicies(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDenomPolicies", reflect.TypeOf((*MockBankKeeper)(nil).IterateDenomPolicies), ctx, cb)
+}
+
 // IterateSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) IterateSendEnabledEntries(ctx types.Context, cb func(string, bool) bool) {
 	m.ctrl.T.Helper()
@@ -668,6 +811,18 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// PrependSendRestriction mocks base method.
+func (m *MockBankKeeper) PrependSendRestriction(restriction types1.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrependSendRestriction", restriction)
+}
+
+// PrependSendRestriction indicates an expected call of PrependSendRestriction.
+func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -751,6 +906,18 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetBalanceHistoryIndex mocks base method.
+func (m *MockBankKeeper) SetBalanceHistoryIndex(index *history.Index) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBalanceHistoryIndex", index)
+}
+
+// SetBalanceHistoryIndex indicates an expected call of SetBalanceHistoryIndex.
+func (mr *MockBankKeeperMockRecorder) SetBalanceHistoryIndex(index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceHistoryIndex", reflect.TypeOf((*MockBankKeeper)(nil).SetBalanceHistoryIndex), index)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx types.Context, denomMetaData types1.Metadata) {
 	m.ctrl.T.Helper()
@@ -763,6 +930,20 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetDenomPolicy mocks base method.
+func (m *MockBankKeeper) SetDenomPolicy(ctx types.Context, policy types1.DenomPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomPolicy indicates an expected call of SetDenomPolicy.
+func (mr *MockBankKeeperMockRecorder) SetDenomPolicy(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomPolicy", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomPolicy), ctx, policy)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx types.Context, params types1.Params) error {
 	m.ctrl.T.Helper()
@@ -1006,3 +1187,40 @@ func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
 }
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx types.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
@@ -8,6 +8,7 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
@@ -22,4 +23,10 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+
+	// didn't meet the expedited vote quorum or threshold, converted to a regular proposal
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected"
+
+	AttributeKeyProposer           = "proposer"
+	AttributeKeyCancellationCharge = "cancellation_charge" // part of the deposits charged on cancellation
 )
@@ -90,6 +90,46 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "expedited min deposit lower than min deposit",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedMinDeposit = params.MinDeposit
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited quorum lower than quorum",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedQuorum = "0.3"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedThreshold = params.Threshold
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited voting period not shorter than voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedVotingPeriod = params.VotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -251,6 +251,13 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited, with a shorter voting
+	// period and higher quorum and threshold. An expedited proposal which fails
+	// to pass is converted to a regular proposal.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter, allowed to cancel the
+	// proposal.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +363,20 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -498,10 +519,10 @@ func (m *Vote) GetMetadata() string {
 
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
+	//  Minimum deposit for a proposal to enter voting period.
 	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
+	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 }
 
@@ -554,7 +575,7 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
-	// Length of the voting period.
+	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
@@ -600,13 +621,13 @@ func (m *VotingParams) GetVotingPeriod() *time.Duration {
 
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
@@ -668,23 +689,46 @@ func (m *TallyParams) GetVetoThreshold() string {
 //
 // Since: cosmos-sdk 0.47
 type Params struct {
-	// Minimum deposit for a proposal to enter voting period.
+	//  Minimum deposit for a proposal to enter voting period.
 	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
+	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-	// Length of the voting period.
+	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	//  Length of the voting period of expedited proposals, shorter than the
+	//  voting period.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,8,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for the result of an
+	//  expedited proposal to be considered valid.
+	ExpeditedQuorum string `protobuf:"bytes,9,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass,
+	//  greater than the threshold. Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,10,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,11,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	//  The proportion of the deposits of a proposal which is charged when the
+	//  proposal is canceled by its proposer, the rest being refunded to the
+	//  depositors.
+	ProposalCancelRatio string `protobuf:"bytes,12,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	//  The address to which the cancellation charge of canceled proposals is
+	//  sent. The charge is sent to the community pool when it is the
+	//  distribution module address, and burned when it is empty.
+	ProposalCancelDest string `protobuf:"bytes,13,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	//  Tally params of the proposals containing specific message types. The
+	//  strictest params of the messages of a proposal apply to it, messages
+	//  without specific params being held to the regular ones.
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,14,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -769,6 +813,131 @@ func (m *Params) GetMinInitialDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedQuorum() string {
+	if m != nil {
+		return m.ExpeditedQuorum
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
+func (m *Params) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+// MessageBasedParams defines the tally params of the proposals containing a
+// specific message type.
+type MessageBasedParams struct {
+	//  Type URL of the message the params apply to.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -781,87 +950,101 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x73, 0xd3, 0x46,
-	0x14, 0x8f, 0x6c, 0xc5, 0x49, 0x5e, 0x62, 0xa3, 0x2e, 0x14, 0x94, 0x00, 0x76, 0xf0, 0xb4, 0x9d,
-	0x94, 0x3f, 0x76, 0x03, 0x43, 0x3b, 0x53, 0x2e, 0xb5, 0x63, 0x51, 0xc4, 0xd0, 0xd8, 0x95, 0x84,
-	0x19, 0x7a, 0xd1, 0xc8, 0x91, 0xb0, 0x77, 0x6a, 0x69, 0x5d, 0xed, 0xda, 0xe0, 0x8f, 0xd0, 0x1b,
-	0xc7, 0xce, 0xf4, 0x6b, 0x30, 0xed, 0x57, 0xe0, 0xd4, 0x61, 0x38, 0xf4, 0xcf, 0xc5, 0x6d, 0xe1,
-	0x96, 0x4f, 0xd1, 0xd1, 0x6a, 0x15, 0xdb, 0x8a, 0x99, 0xa4, 0x9c, 0x24, 0xbd, 0xf7, 0xfb, 0xbd,
-	0xf7, 0xf6, 0xbd, 0x9f, 0x76, 0x17, 0x2e, 0x1c, 0x10, 0xea, 0x13, 0x5a, 0xed, 0x92, 0x51, 0x75,
-	0xb4, 0x1b, 0x3d, 0x2a, 0x83, 0x90, 0x30, 0x82, 0xf2, 0xb1, 0xa3, 0x12, 0x59, 0x46, 0xbb, 0x5b,
-	0x45, 0x81, 0xeb, 0x38, 0xd4, 0xab, 0x8e, 0x76, 0x3b, 0x1e, 0x73, 0x76, 0xab, 0x07, 0x04, 0x07,
-	0x31, 0x7c, 0xeb, 0x5c, 0x97, 0x74, 0x09, 0x7f, 0xad, 0x46, 0x6f, 0xc2, 0x5a, 0xea, 0x12, 0xd2,
-	0xed, 0x7b, 0x55, 0xfe, 0xd5, 0x19, 0x3e, 0xa9, 0x32, 0xec, 0x7b, 0x94, 0x39, 0xfe, 0x40, 0x00,
-	0x36, 0xd3, 0x00, 0x27, 0x18, 0x0b, 0x57, 0x31, 0xed, 0x72, 0x87, 0xa1, 0xc3, 0x30, 0x49, 0x32,
-	0x6e, 0xc6, 0x15, 0xd9, 0x71, 0x52, 0x51, 0x2d, 0xff, 0x28, 0x13, 0x40, 0x8f, 0x3c, 0xdc, 0xed,
-	0x31, 0xcf, 0x6d, 0x13, 0xe6, 0x35, 0x07, 0x11, 0x0d, 0xed, 0x42, 0x8e, 0xf0, 0x37, 0x55, 0xda,
-	0x96, 0x76, 0x0a, 0x37, 0x37, 0x2b, 0x73, 0x4b, 0xac, 0x4c, 0xa1, 0x86, 0x00, 0xa2, 0x4f, 0x20,
-	0xf7, 0x94, 0x07, 0x52, 0x33, 0xdb, 0xd2, 0xce, 0x5a, 0xbd, 0xf0, 0xfa, 0xc5, 0x0d, 0x10, 0xac,
-	0x86, 0x77, 0x60, 0x08, 0x6f, 0xf9, 0x67, 0x09, 0x56, 0x1a, 0xde, 0x80, 0x50, 0xcc, 0x50, 0x09,
-	0xd6, 0x07, 0x21, 0x19, 0x10, 0xea, 0xf4, 0x6d, 0xec, 0xf2, 0x5c, 0xb2, 0x01, 0x89, 0x49, 0x77,
-	0xd1, 0xe7, 0xb0, 0xe6, 0xc6, 0x58, 0x12, 0x8a, 0xb8, 0xea, 0xeb, 0x17, 0x37, 0xce, 0x89, 0xb8,
-	0x35, 0xd7, 0x0d, 0x3d, 0x4a, 0x4d, 0x16, 0xe2, 0xa0, 0x6b, 0x4c, 0xa1, 0xe8, 0x0b, 0xc8, 0x39,
-	0x3e, 0x19, 0x06, 0x4c, 0xcd, 0x6e, 0x67, 0x77, 0xd6, 0xa7, 0xf5, 0x47, 0x33, 0xa9, 0x88, 0x99,
-	0x54, 0xf6, 0x08, 0x0e, 0xea, 0xf2, 0xcb, 0x49, 0x69, 0xc9, 0x10, 0xf0, 0xf2, 0xef, 0x32, 0xac,
-	0xb6, 0x44, 0x7e, 0x54, 0x80, 0xcc, 0x51, 0x55, 0x19, 0xec, 0xa2, 0xcf, 0x60, 0xd5, 0xf7, 0x28,
-	0x75, 0xba, 0x1e, 0x55, 0x33, 0x3c, 0xee, 0xb9, 0x4a, 0xdc, 0xf9, 0x4a, 0xd2, 0xf9, 0x4a, 0x2d,
-	0x18, 0x1b, 0x47, 0x28, 0x74, 0x1b, 0x72, 0x94, 0x39, 0x6c, 0x48, 0xd5, 0x2c, 0xef, 0xe3, 0xe5,
-	0x54, 0x1f, 0x93, 0x54, 0x26, 0x07, 0x19, 0x02, 0x8c, 0xee, 0x01, 0x7a, 0x82, 0x03, 0xa7, 0x6f,
-	0x33, 0xa7, 0xdf, 0x1f, 0xdb, 0xa1, 0x47, 0x87, 0x7d, 0xa6, 0xca, 0xdb, 0xd2, 0xce, 0xfa, 0xcd,
-	0xad, 0x54, 0x08, 0x2b, 0x82, 0x18, 0x1c, 0x61, 0x28, 0x9c, 0x35, 0x63, 0x41, 0x35, 0x58, 0xa7,
-	0xc3, 0x8e, 0x8f, 0x99, 0x1d, 0xc9, 0x49, 0x5d, 0x16, 0x21, 0xd2, 0x55, 0x5b, 0x89, 0xd6, 0xea,
-	0xf2, 0xf3, 0xbf, 0x4b, 0x92, 0x01, 0x31, 0x29, 0x32, 0xa3, 0xfb, 0xa0, 0x88, 0xc6, 0xda, 0x5e,
-	0xe0, 0xc6, 0x71, 0x72, 0xa7, 0x8c, 0x53, 0x10, 0x4c, 0x2d, 0x70, 0x79, 0xac, 0x06, 0xe4, 0x19,
-	0x61, 0x4e, 0xdf, 0x16, 0x76, 0x75, 0xe5, 0x74, 0xe3, 0xd9, 0xe0, 0xac, 0x44, 0x36, 0x0f, 0xe0,
-	0x83, 0x11, 0x61, 0x38, 0xe8, 0xda, 0x94, 0x39, 0xa1, 0x58, 0xda, 0xea, 0x29, 0x4b, 0x3a, 0x13,
-	0x53, 0xcd, 0x88, 0xc9, 0x6b, 0xba, 0x07, 0xc2, 0x34, 0x5d, 0xde, 0xda, 0x29, 0x63, 0xe5, 0x63,
-	0x62, 0xb2, 0xba, 0xad, 0x48, 0x1f, 0xcc, 0x71, 0x1d, 0xe6, 0xa8, 0x10, 0x89, 0xd5, 0x38, 0xfa,
-	0x2e, 0xff, 0x21, 0xc1, 0xfa, 0xec, 0x60, 0xae, 0xc1, 0xda, 0xd8, 0xa3, 0xf6, 0x01, 0x17, 0xa9,
-	0x74, 0xec, 0x8f, 0xd1, 0x03, 0x66, 0xac, 0x8e, 0x3d, 0xba, 0x17, 0xf9, 0xd1, 0x2d, 0xc8, 0x3b,
-	0x1d, 0xca, 0x1c, 0x1c, 0x08, 0x42, 0x66, 0x21, 0x61, 0x43, 0x80, 0x62, 0xd2, 0xa7, 0xb0, 0x1a,
-	0x10, 0x81, 0xcf, 0x2e, 0xc4, 0xaf, 0x04, 0x24, 0x86, 0xde, 0x01, 0x14, 0x10, 0xfb, 0x29, 0x66,
-	0x3d, 0x7b, 0xe4, 0xb1, 0x84, 0x24, 0x2f, 0x24, 0x9d, 0x09, 0xc8, 0x23, 0xcc, 0x7a, 0x6d, 0x8f,
-	0xc5, 0xe4, 0xf2, 0x2f, 0x12, 0xc8, 0xd1, 0x7e, 0x70, 0xf2, 0xdf, 0x5c, 0x81, 0xe5, 0x11, 0x61,
-	0xde, 0xc9, 0x7f, 0x72, 0x0c, 0x43, 0x77, 0x60, 0x25, 0xde, 0x5c, 0xa8, 0x2a, 0x73, 0x9d, 0x5c,
-	0x49, 0x69, 0xff, 0xf8, 0xce, 0x65, 0x24, 0x8c, 0xb9, 0x61, 0x2c, 0xcf, 0x0f, 0xe3, 0xbe, 0xbc,
-	0x9a, 0x55, 0xe4, 0xf2, 0x5f, 0x12, 0xe4, 0x85, 0xa4, 0x5a, 0x4e, 0xe8, 0xf8, 0x14, 0x3d, 0x86,
-	0x75, 0x1f, 0x07, 0x47, 0xe2, 0x94, 0x4e, 0x12, 0xe7, 0xe5, 0x48, 0x9c, 0x87, 0x93, 0xd2, 0x87,
-	0x33, 0xac, 0xeb, 0xc4, 0xc7, 0xcc, 0xf3, 0x07, 0x6c, 0x6c, 0x80, 0x8f, 0x83, 0x44, 0xb3, 0x3e,
-	0x20, 0xdf, 0x79, 0x96, 0x80, 0xec, 0x81, 0x17, 0x62, 0xe2, 0xf2, 0x46, 0x44, 0x19, 0xd2, 0x42,
-	0x6b, 0x88, 0xfd, 0xbb, 0xfe, 0xd1, 0xe1, 0xa4, 0x74, 0xe9, 0x38, 0x71, 0x9a, 0xe4, 0xa7, 0x48,
-	0x87, 0x8a, 0xef, 0x3c, 0x4b, 0x56, 0xc2, 0xfd, 0x65, 0x0b, 0x36, 0xda, 0x5c, 0x9b, 0x62, 0x65,
-	0x0d, 0x10, 0x5a, 0x4d, 0x32, 0x4b, 0x27, 0x65, 0x96, 0x79, 0xe4, 0x8d, 0x98, 0x25, 0xa2, 0xfe,
-	0x9b, 0x88, 0x58, 0x44, 0xfd, 0x12, 0x72, 0x3f, 0x0c, 0x49, 0x38, 0xf4, 0x85, 0x82, 0xcb, 0x87,
-	0x93, 0x92, 0x12, 0x5b, 0xa6, 0x15, 0xa6, 0xcf, 0x81, 0xd8, 0x8f, 0xf6, 0x60, 0x8d, 0xf5, 0x42,
-	0x8f, 0xf6, 0x48, 0xdf, 0x15, 0x82, 0xf8, 0xf8, 0x70, 0x52, 0x3a, 0x7b, 0x64, 0x7c, 0x67, 0x84,
-	0x29, 0x0f, 0x7d, 0x0b, 0x05, 0x2e, 0xd8, 0x69, 0xa4, 0x58, 0xe9, 0x57, 0x0f, 0x27, 0x25, 0x75,
-	0xde, 0xf3, 0xce, 0x70, 0xf9, 0x08, 0x67, 0x25, 0xb0, 0xf2, 0xaf, 0x59, 0xc8, 0x89, 0xe5, 0x7d,
-	0xf5, 0x3f, 0xe5, 0x10, 0xef, 0x55, 0xb3, 0x53, 0xff, 0xe6, 0xfd, 0xa6, 0x2e, 0x2f, 0x9e, 0xea,
-	0xf1, 0x29, 0x66, 0xdf, 0x63, 0x8a, 0xd1, 0x49, 0x2d, 0xa6, 0x26, 0x2f, 0x3e, 0xa9, 0xc5, 0x84,
-	0xae, 0xcf, 0x4e, 0x68, 0x79, 0x21, 0x74, 0x66, 0x14, 0xb7, 0x8f, 0x8d, 0x22, 0xb7, 0x90, 0x32,
-	0xdf, 0x6e, 0xa4, 0xc3, 0x66, 0xd4, 0x63, 0x1c, 0x60, 0x86, 0xa7, 0xe7, 0x82, 0xcd, 0xcb, 0x57,
-	0x57, 0x16, 0x46, 0x38, 0xef, 0xe3, 0x40, 0x8f, 0xf1, 0xa2, 0x3d, 0x46, 0x84, 0xbe, 0xfa, 0xa3,
-	0x04, 0x30, 0x73, 0x87, 0xb9, 0x08, 0x17, 0xda, 0x4d, 0x4b, 0xb3, 0x9b, 0x2d, 0x4b, 0x6f, 0xee,
-	0xdb, 0x0f, 0xf7, 0xcd, 0x96, 0xb6, 0xa7, 0xdf, 0xd5, 0xb5, 0x86, 0xb2, 0x84, 0xce, 0xc2, 0x99,
-	0x59, 0xe7, 0x63, 0xcd, 0x54, 0x24, 0x74, 0x01, 0xce, 0xce, 0x1a, 0x6b, 0x75, 0xd3, 0xaa, 0xe9,
-	0xfb, 0x4a, 0x06, 0x21, 0x28, 0xcc, 0x3a, 0xf6, 0x9b, 0x4a, 0x16, 0x5d, 0x02, 0x75, 0xde, 0x66,
-	0x3f, 0xd2, 0xad, 0x7b, 0x76, 0x5b, 0xb3, 0x9a, 0x8a, 0x7c, 0xf5, 0x37, 0x09, 0x0a, 0xf3, 0x87,
-	0x3b, 0x2a, 0xc1, 0xc5, 0x96, 0xd1, 0x6c, 0x35, 0xcd, 0xda, 0x03, 0xdb, 0xb4, 0x6a, 0xd6, 0x43,
-	0x33, 0x55, 0x53, 0x19, 0x8a, 0x69, 0x40, 0x43, 0x6b, 0x35, 0x4d, 0xdd, 0xb2, 0x5b, 0x9a, 0xa1,
-	0x37, 0x1b, 0x8a, 0x84, 0xae, 0xc0, 0xe5, 0x34, 0xa6, 0xdd, 0xb4, 0xf4, 0xfd, 0xaf, 0x13, 0x48,
-	0x06, 0x6d, 0xc1, 0xf9, 0x34, 0xa4, 0x55, 0x33, 0x4d, 0xad, 0x11, 0x17, 0x9d, 0xf6, 0x19, 0xda,
-	0x7d, 0x6d, 0xcf, 0xd2, 0x1a, 0x8a, 0xbc, 0x88, 0x79, 0xb7, 0xa6, 0x3f, 0xd0, 0x1a, 0xca, 0x72,
-	0x5d, 0x7b, 0xf9, 0xa6, 0x28, 0xbd, 0x7a, 0x53, 0x94, 0xfe, 0x79, 0x53, 0x94, 0x9e, 0xbf, 0x2d,
-	0x2e, 0xbd, 0x7a, 0x5b, 0x5c, 0xfa, 0xf3, 0x6d, 0x71, 0xe9, 0xbb, 0x6b, 0x5d, 0xcc, 0x7a, 0xc3,
-	0x4e, 0xe5, 0x80, 0xf8, 0xe2, 0x6a, 0x29, 0x1e, 0x37, 0xa8, 0xfb, 0x7d, 0xf5, 0x19, 0xbf, 0x2e,
-	0xb3, 0xf1, 0xc0, 0xa3, 0xd1, 0x5d, 0x38, 0xc7, 0x25, 0x7a, 0xeb, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xde, 0xdf, 0xa6, 0x05, 0x4c, 0x0b, 0x00, 0x00,
+	// 1401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0x25, 0x5a, 0x96, 0x47, 0xb6, 0xcc, 0x6f, 0xed, 0xc4, 0xb4, 0x93, 0x48, 0x8e, 0xf0,
+	0x7d, 0x1f, 0xdc, 0xfc, 0x91, 0xea, 0xa4, 0x69, 0xd1, 0xe6, 0xd0, 0x4a, 0x16, 0xd3, 0xc8, 0x48,
+	0x2c, 0x85, 0x62, 0x6c, 0xa4, 0x17, 0x82, 0x36, 0x37, 0x32, 0x51, 0x91, 0xab, 0x72, 0x57, 0x8e,
+	0xfd, 0x08, 0xbd, 0xe5, 0x58, 0xa0, 0x6f, 0xd0, 0x73, 0xd0, 0x43, 0x9f, 0x20, 0x87, 0xa2, 0x08,
+	0x72, 0x69, 0x7b, 0x71, 0xdb, 0xe4, 0xe6, 0xa7, 0x28, 0xc8, 0x5d, 0x8a, 0x12, 0xad, 0xc0, 0x4a,
+	0x4e, 0x12, 0x67, 0x7e, 0xbf, 0xd9, 0xd9, 0x99, 0xdf, 0xce, 0x92, 0xb0, 0xbc, 0x4f, 0xa8, 0x4b,
+	0x68, 0xa5, 0x43, 0x0e, 0x2b, 0x87, 0x1b, 0xc1, 0x4f, 0xb9, 0xe7, 0x13, 0x46, 0xd0, 0x3c, 0x77,
+	0x94, 0x03, 0xcb, 0xe1, 0xc6, 0x6a, 0x41, 0xe0, 0xf6, 0x2c, 0x8a, 0x2b, 0x87, 0x1b, 0x7b, 0x98,
+	0x59, 0x1b, 0x95, 0x7d, 0xe2, 0x78, 0x1c, 0xbe, 0xba, 0xd4, 0x21, 0x1d, 0x12, 0xfe, 0xad, 0x04,
+	0xff, 0x84, 0xb5, 0xd8, 0x21, 0xa4, 0xd3, 0xc5, 0x95, 0xf0, 0x69, 0xaf, 0xff, 0xb4, 0xc2, 0x1c,
+	0x17, 0x53, 0x66, 0xb9, 0x3d, 0x01, 0x58, 0x49, 0x02, 0x2c, 0xef, 0x58, 0xb8, 0x0a, 0x49, 0x97,
+	0xdd, 0xf7, 0x2d, 0xe6, 0x90, 0x68, 0xc5, 0x15, 0x9e, 0x91, 0xc9, 0x17, 0x15, 0xd9, 0x86, 0x0f,
+	0x25, 0x02, 0x68, 0x17, 0x3b, 0x9d, 0x03, 0x86, 0xed, 0x1d, 0xc2, 0x70, 0xb3, 0x17, 0xd0, 0xd0,
+	0x06, 0x64, 0x48, 0xf8, 0x4f, 0x95, 0xd6, 0xa4, 0xf5, 0xfc, 0xad, 0x95, 0xf2, 0xc8, 0x16, 0xcb,
+	0x31, 0x54, 0x17, 0x40, 0xf4, 0x7f, 0xc8, 0x3c, 0x0b, 0x03, 0xa9, 0xa9, 0x35, 0x69, 0x7d, 0xb6,
+	0x96, 0x7f, 0xfd, 0xe2, 0x26, 0x08, 0x56, 0x1d, 0xef, 0xeb, 0xc2, 0x5b, 0xfa, 0x51, 0x82, 0x99,
+	0x3a, 0xee, 0x11, 0xea, 0x30, 0x54, 0x84, 0x5c, 0xcf, 0x27, 0x3d, 0x42, 0xad, 0xae, 0xe9, 0xd8,
+	0xe1, 0x5a, 0xb2, 0x0e, 0x91, 0xa9, 0x61, 0xa3, 0x4f, 0x61, 0xd6, 0xe6, 0x58, 0xe2, 0x8b, 0xb8,
+	0xea, 0xeb, 0x17, 0x37, 0x97, 0x44, 0xdc, 0xaa, 0x6d, 0xfb, 0x98, 0xd2, 0x36, 0xf3, 0x1d, 0xaf,
+	0xa3, 0xc7, 0x50, 0xf4, 0x19, 0x64, 0x2c, 0x97, 0xf4, 0x3d, 0xa6, 0xa6, 0xd7, 0xd2, 0xeb, 0xb9,
+	0x38, 0xff, 0xa0, 0x27, 0x65, 0xd1, 0x93, 0xf2, 0x26, 0x71, 0xbc, 0x9a, 0xfc, 0xf2, 0xa4, 0x38,
+	0xa5, 0x0b, 0x78, 0xe9, 0xa7, 0x69, 0xc8, 0xb6, 0xc4, 0xfa, 0x28, 0x0f, 0xa9, 0x41, 0x56, 0x29,
+	0xc7, 0x46, 0x1f, 0x43, 0xd6, 0xc5, 0x94, 0x5a, 0x1d, 0x4c, 0xd5, 0x54, 0x18, 0x77, 0xa9, 0xcc,
+	0x2b, 0x5f, 0x8e, 0x2a, 0x5f, 0xae, 0x7a, 0xc7, 0xfa, 0x00, 0x85, 0xee, 0x40, 0x86, 0x32, 0x8b,
+	0xf5, 0xa9, 0x9a, 0x0e, 0xeb, 0x78, 0x25, 0x51, 0xc7, 0x68, 0xa9, 0x76, 0x08, 0xd2, 0x05, 0x18,
+	0xdd, 0x07, 0xf4, 0xd4, 0xf1, 0xac, 0xae, 0xc9, 0xac, 0x6e, 0xf7, 0xd8, 0xf4, 0x31, 0xed, 0x77,
+	0x99, 0x2a, 0xaf, 0x49, 0xeb, 0xb9, 0x5b, 0xab, 0x89, 0x10, 0x46, 0x00, 0xd1, 0x43, 0x84, 0xae,
+	0x84, 0xac, 0x21, 0x0b, 0xaa, 0x42, 0x8e, 0xf6, 0xf7, 0x5c, 0x87, 0x99, 0x81, 0x9c, 0xd4, 0x69,
+	0x11, 0x22, 0x99, 0xb5, 0x11, 0x69, 0xad, 0x26, 0x3f, 0xff, 0xab, 0x28, 0xe9, 0xc0, 0x49, 0x81,
+	0x19, 0x6d, 0x81, 0x22, 0x0a, 0x6b, 0x62, 0xcf, 0xe6, 0x71, 0x32, 0x13, 0xc6, 0xc9, 0x0b, 0xa6,
+	0xe6, 0xd9, 0x61, 0xac, 0x3a, 0xcc, 0x33, 0xc2, 0xac, 0xae, 0x29, 0xec, 0xea, 0xcc, 0x64, 0xed,
+	0x99, 0x0b, 0x59, 0x91, 0x6c, 0x1e, 0xc0, 0x7f, 0x0e, 0x09, 0x73, 0xbc, 0x8e, 0x49, 0x99, 0xe5,
+	0x8b, 0xad, 0x65, 0x27, 0x4c, 0x69, 0x81, 0x53, 0xdb, 0x01, 0x33, 0xcc, 0xe9, 0x3e, 0x08, 0x53,
+	0xbc, 0xbd, 0xd9, 0x09, 0x63, 0xcd, 0x73, 0x62, 0xb4, 0xbb, 0xd5, 0x40, 0x1f, 0xcc, 0xb2, 0x2d,
+	0x66, 0xa9, 0x10, 0x88, 0x55, 0x1f, 0x3c, 0xa3, 0xcb, 0x30, 0x8b, 0x8f, 0x7a, 0xd8, 0x76, 0x18,
+	0xb6, 0xd5, 0xdc, 0x9a, 0xb4, 0x9e, 0xd5, 0x63, 0x03, 0xfa, 0x04, 0xb2, 0x5c, 0xf5, 0xd8, 0x57,
+	0xe7, 0xce, 0x91, 0xf9, 0x00, 0x59, 0xfa, 0x5d, 0x82, 0xdc, 0x70, 0xb3, 0xaf, 0xc3, 0xec, 0x31,
+	0xa6, 0xe6, 0x7e, 0x28, 0x7c, 0xe9, 0xcc, 0x29, 0x6c, 0x78, 0x4c, 0xcf, 0x1e, 0x63, 0xba, 0x19,
+	0xf8, 0xd1, 0x6d, 0x98, 0xb7, 0xf6, 0x28, 0xb3, 0x1c, 0x4f, 0x10, 0x52, 0x63, 0x09, 0x73, 0x02,
+	0xc4, 0x49, 0x1f, 0x41, 0xd6, 0x23, 0x02, 0x9f, 0x1e, 0x8b, 0x9f, 0xf1, 0x08, 0x87, 0xde, 0x05,
+	0xe4, 0x11, 0xf3, 0x99, 0xc3, 0x0e, 0xcc, 0x43, 0xcc, 0x22, 0x92, 0x3c, 0x96, 0xb4, 0xe0, 0x91,
+	0x5d, 0x87, 0x1d, 0xec, 0x60, 0xc6, 0xc9, 0xa5, 0x9f, 0x25, 0x90, 0x83, 0x19, 0x73, 0xfe, 0x84,
+	0x28, 0xc3, 0xf4, 0x21, 0x61, 0xf8, 0xfc, 0xe9, 0xc0, 0x61, 0xe8, 0x2e, 0xcc, 0xf0, 0x81, 0x45,
+	0x55, 0x39, 0xd4, 0xde, 0xd5, 0xc4, 0x79, 0x3a, 0x3b, 0x0d, 0xf5, 0x88, 0x31, 0xd2, 0xe0, 0xe9,
+	0xd1, 0x06, 0x6f, 0xc9, 0xd9, 0xb4, 0x22, 0x97, 0xfe, 0x94, 0x60, 0x5e, 0xc8, 0xb4, 0x65, 0xf9,
+	0x96, 0x4b, 0xd1, 0x13, 0xc8, 0xb9, 0x8e, 0x37, 0x10, 0xbc, 0x74, 0x9e, 0xe0, 0xaf, 0x04, 0x82,
+	0x3f, 0x3d, 0x29, 0x5e, 0x18, 0x62, 0xdd, 0x20, 0xae, 0xc3, 0xb0, 0xdb, 0x63, 0xc7, 0x3a, 0xb8,
+	0x8e, 0x17, 0x9d, 0x03, 0x17, 0x90, 0x6b, 0x1d, 0x45, 0x20, 0xb3, 0x87, 0x7d, 0x87, 0xd8, 0x61,
+	0x21, 0x82, 0x15, 0x92, 0xe2, 0xad, 0x8b, 0x3b, 0xa1, 0xf6, 0xdf, 0xd3, 0x93, 0xe2, 0xe5, 0xb3,
+	0xc4, 0x78, 0x91, 0x1f, 0x02, 0x6d, 0x2b, 0xae, 0x75, 0x14, 0xed, 0x24, 0xf4, 0x97, 0x0c, 0x98,
+	0xdb, 0x09, 0xf5, 0x2e, 0x76, 0x56, 0x07, 0xa1, 0xff, 0x68, 0x65, 0xe9, 0xbc, 0x95, 0xe5, 0x30,
+	0xf2, 0x1c, 0x67, 0x89, 0xa8, 0xff, 0x44, 0x22, 0x16, 0x51, 0xbf, 0x80, 0xcc, 0x77, 0x7d, 0xe2,
+	0xf7, 0x5d, 0xa1, 0xe0, 0xd2, 0xe9, 0x49, 0x51, 0xe1, 0x96, 0x38, 0xc3, 0xe4, 0xdd, 0xc2, 0xfd,
+	0x68, 0x13, 0x66, 0xd9, 0x81, 0x8f, 0xe9, 0x01, 0xe9, 0xda, 0x42, 0x10, 0xff, 0x3b, 0x3d, 0x29,
+	0x2e, 0x0e, 0x8c, 0xef, 0x8c, 0x10, 0xf3, 0xd0, 0x23, 0xc8, 0x87, 0x82, 0x8d, 0x23, 0x71, 0xa5,
+	0x5f, 0x3b, 0x3d, 0x29, 0xaa, 0xa3, 0x9e, 0x77, 0x86, 0x9b, 0x0f, 0x70, 0x46, 0x04, 0x2b, 0xfd,
+	0x3a, 0x03, 0x19, 0xb1, 0xbd, 0xaf, 0xde, 0x53, 0x0e, 0x7c, 0xfe, 0x0d, 0x77, 0xfd, 0xe1, 0x87,
+	0x75, 0x5d, 0x1e, 0xdf, 0xd5, 0xb3, 0x5d, 0x4c, 0x7f, 0x40, 0x17, 0x83, 0xdb, 0x5f, 0x74, 0x4d,
+	0x1e, 0x7f, 0xfb, 0x8b, 0x0e, 0xdd, 0x18, 0xee, 0xd0, 0xf4, 0x58, 0xe8, 0x50, 0x2b, 0xee, 0x9c,
+	0x69, 0x45, 0x66, 0x2c, 0x65, 0xb4, 0xdc, 0xa8, 0x01, 0x2b, 0x41, 0x8d, 0x1d, 0xcf, 0x61, 0x4e,
+	0x7c, 0xd7, 0x98, 0x61, 0xfa, 0xea, 0xcc, 0xd8, 0x08, 0x17, 0x5d, 0xc7, 0x6b, 0x70, 0xbc, 0x28,
+	0x8f, 0x1e, 0xa0, 0xd1, 0x2e, 0x2c, 0x0f, 0xa6, 0xb4, 0x39, 0x5a, 0xa7, 0xec, 0x64, 0x75, 0xba,
+	0x30, 0xe0, 0xef, 0x0c, 0x17, 0xec, 0x73, 0x50, 0xe2, 0xc0, 0xa2, 0x74, 0xb3, 0x63, 0x53, 0x5b,
+	0x18, 0xe0, 0x1e, 0xf1, 0x1a, 0x7e, 0x09, 0x8b, 0x31, 0x35, 0x2e, 0x0d, 0x8c, 0x65, 0xa3, 0x01,
+	0x34, 0xae, 0x4f, 0x1b, 0xe2, 0xa4, 0xcc, 0x61, 0x35, 0xe6, 0x26, 0x53, 0x63, 0xbc, 0xfc, 0xc3,
+	0x58, 0x96, 0x35, 0xb8, 0x30, 0x98, 0xd4, 0xfb, 0x96, 0xb7, 0x8f, 0xbb, 0xa2, 0xe0, 0x73, 0x63,
+	0xf3, 0x5a, 0x8c, 0xc0, 0x9b, 0x21, 0x96, 0x57, 0x7b, 0x0b, 0x96, 0x92, 0x31, 0x6c, 0x4c, 0x99,
+	0x3a, 0x7f, 0xce, 0x6c, 0x47, 0xa3, 0xc1, 0xea, 0x98, 0x32, 0xf4, 0x04, 0x96, 0xc4, 0x6b, 0x98,
+	0x19, 0xec, 0xc3, 0x36, 0x7b, 0xe1, 0x01, 0x54, 0xf3, 0x63, 0xa7, 0xfe, 0x43, 0x0e, 0xad, 0x05,
+	0x48, 0x7e, 0x52, 0xc5, 0x5e, 0x91, 0x7b, 0xc6, 0x53, 0xfa, 0x45, 0x02, 0x74, 0x96, 0x80, 0x96,
+	0x61, 0xc6, 0xa5, 0x1d, 0xb3, 0xef, 0x77, 0xf9, 0xe8, 0xd2, 0x33, 0x2e, 0xed, 0x3c, 0xf6, 0xbb,
+	0x43, 0x87, 0x23, 0x35, 0xf9, 0xe1, 0x48, 0xbf, 0xff, 0xe1, 0x90, 0x27, 0x38, 0x1c, 0xd7, 0xbe,
+	0x97, 0x00, 0x86, 0xde, 0xf4, 0x2f, 0xc1, 0xf2, 0x4e, 0xd3, 0xd0, 0xcc, 0x66, 0xcb, 0x68, 0x34,
+	0xb7, 0xcd, 0xc7, 0xdb, 0xed, 0x96, 0xb6, 0xd9, 0xb8, 0xd7, 0xd0, 0xea, 0xca, 0x14, 0x5a, 0x84,
+	0x85, 0x61, 0xe7, 0x13, 0xad, 0xad, 0x48, 0x68, 0x19, 0x16, 0x87, 0x8d, 0xd5, 0x5a, 0xdb, 0xa8,
+	0x36, 0xb6, 0x95, 0x14, 0x42, 0x90, 0x1f, 0x76, 0x6c, 0x37, 0x95, 0x34, 0xba, 0x0c, 0xea, 0xa8,
+	0xcd, 0xdc, 0x6d, 0x18, 0xf7, 0xcd, 0x1d, 0xcd, 0x68, 0x2a, 0xf2, 0xb5, 0xdf, 0x24, 0xc8, 0x8f,
+	0xbe, 0x02, 0xa3, 0x22, 0x5c, 0x6a, 0xe9, 0xcd, 0x56, 0xb3, 0x5d, 0x7d, 0x60, 0xb6, 0x8d, 0xaa,
+	0xf1, 0xb8, 0x9d, 0xc8, 0xa9, 0x04, 0x85, 0x24, 0xa0, 0xae, 0xb5, 0x9a, 0xed, 0x86, 0x61, 0xb6,
+	0x34, 0xbd, 0xd1, 0xac, 0x2b, 0x12, 0xba, 0x0a, 0x57, 0x92, 0x98, 0x9d, 0xa6, 0xd1, 0xd8, 0xfe,
+	0x3a, 0x82, 0xa4, 0xd0, 0x2a, 0x5c, 0x4c, 0x42, 0x5a, 0xd5, 0x76, 0x5b, 0xab, 0xf3, 0xa4, 0x93,
+	0x3e, 0x5d, 0xdb, 0xd2, 0x36, 0x0d, 0xad, 0xae, 0xc8, 0xe3, 0x98, 0xf7, 0xaa, 0x8d, 0x07, 0x5a,
+	0x5d, 0x99, 0xae, 0x69, 0x2f, 0xdf, 0x14, 0xa4, 0x57, 0x6f, 0x0a, 0xd2, 0xdf, 0x6f, 0x0a, 0xd2,
+	0xf3, 0xb7, 0x85, 0xa9, 0x57, 0x6f, 0x0b, 0x53, 0x7f, 0xbc, 0x2d, 0x4c, 0x7d, 0x73, 0xbd, 0xe3,
+	0xb0, 0x83, 0xfe, 0x5e, 0x79, 0x9f, 0xb8, 0xe2, 0x03, 0x4c, 0xfc, 0xdc, 0xa4, 0xf6, 0xb7, 0x95,
+	0xa3, 0xf0, 0xa3, 0x92, 0x1d, 0xf7, 0x30, 0x0d, 0xbe, 0x18, 0x33, 0xe1, 0x30, 0xb9, 0xfd, 0xef,
+	0x00, 0x77, 0x50, 0x40, 0x74, 0x72, 0x0e, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -968,6 +1151,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1319,6 +1519,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExpeditedQuorum) > 0 {
+		i -= len(m.ExpeditedQuorum)
+		copy(dAtA[i:], m.ExpeditedQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedQuorum)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.MinInitialDepositRatio) > 0 {
 		i -= len(m.MinInitialDepositRatio)
 		copy(dAtA[i:], m.MinInitialDepositRatio)
@@ -1348,22 +1614,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1384,6 +1650,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1481,6 +1798,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1624,6 +1948,63 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2216,17 +2597,69 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3197,6 +3630,416 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
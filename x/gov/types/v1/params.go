@@ -10,7 +10,8 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
@@ -20,6 +21,11 @@ var (
 	DefaultThreshold              = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold          = sdk.NewDecWithPrec(334, 3)
 	DefaultMinInitialDepositRatio = sdk.ZeroDec()
+	DefaultExpeditedMinDeposit    = sdk.NewInt(50000000)
+	DefaultExpeditedQuorum        = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold     = sdk.NewDecWithPrec(667, 3)
+	DefaultProposalCancelRatio    = sdk.NewDecWithPrec(5, 1)
+	DefaultProposalCancelDest     = ""
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -47,17 +53,24 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 }
 
 func NewParams(
-	minDeposit sdk.Coins, maxDepositPeriod time.Duration, votingPeriod time.Duration,
-	quorum string, threshold string, vetoThreshold string, minInitialDepositRatio string,
+	minDeposit, expeditedMinDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
+	quorum, expeditedQuorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio string,
+	proposalCancelRatio, proposalCancelDest string,
 ) Params {
 	return Params{
 		MinDeposit:             minDeposit,
+		ExpeditedMinDeposit:    expeditedMinDeposit,
 		MaxDepositPeriod:       &maxDepositPeriod,
 		VotingPeriod:           &votingPeriod,
+		ExpeditedVotingPeriod:  &expeditedVotingPeriod,
 		Quorum:                 quorum,
+		ExpeditedQuorum:        expeditedQuorum,
 		Threshold:              threshold,
+		ExpeditedThreshold:     expeditedThreshold,
 		VetoThreshold:          vetoThreshold,
 		MinInitialDepositRatio: minInitialDepositRatio,
+		ProposalCancelRatio:    proposalCancelRatio,
+		ProposalCancelDest:     proposalCancelDest,
 	}
 }
 
@@ -65,20 +78,35 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDeposit)),
 		DefaultPeriod,
 		DefaultPeriod,
+		DefaultExpeditedPeriod,
 		DefaultQuorum.String(),
+		DefaultExpeditedQuorum.String(),
 		DefaultThreshold.String(),
+		DefaultExpeditedThreshold.String(),
 		DefaultVetoThreshold.String(),
 		DefaultMinInitialDepositRatio.String(),
+		DefaultProposalCancelRatio.String(),
+		DefaultProposalCancelDest,
 	)
 }
 
 func (p Params) ValidateBasic() error {
-	if minDeposit := sdk.Coins(p.MinDeposit); minDeposit.Empty() || !minDeposit.IsValid() {
+	minDeposit := sdk.Coins(p.MinDeposit)
+	if minDeposit.Empty() || !minDeposit.IsValid() {
 		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
 	}
 
+	expeditedMinDeposit := sdk.Coins(p.ExpeditedMinDeposit)
+	if expeditedMinDeposit.Empty() || !expeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", expeditedMinDeposit)
+	}
+	if expeditedMinDeposit.IsAllLTE(minDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than minimum deposit: %s", expeditedMinDeposit)
+	}
+
 	if p.MaxDepositPeriod == nil {
 		return fmt.Errorf("maximum deposit period must not be nil: %d", p.MaxDepositPeriod)
 	}
@@ -98,6 +126,17 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("quorom too large: %s", p.Quorum)
 	}
 
+	expeditedQuorum, err := sdk.NewDecFromStr(p.ExpeditedQuorum)
+	if err != nil {
+		return fmt.Errorf("invalid expedited quorum string: %w", err)
+	}
+	if expeditedQuorum.LT(quorum) {
+		return fmt.Errorf("expedited quorum must be greater than or equal to the quorum: %s", expeditedQuorum)
+	}
+	if expeditedQuorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited quorum too large: %s", expeditedQuorum)
+	}
+
 	threshold, err := sdk.NewDecFromStr(p.Threshold)
 	if err != nil {
 		return fmt.Errorf("invalid threshold string: %w", err)
@@ -109,6 +148,17 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+
 	vetoThreshold, err := sdk.NewDecFromStr(p.VetoThreshold)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
@@ -128,5 +178,146 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
 	}
 
+	if p.ExpeditedVotingPeriod == nil {
+		return fmt.Errorf("expedited voting period must not be nil: %d", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() >= p.VotingPeriod.Seconds() {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
+	if len(p.ProposalCancelDest) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
+	msgURLs := make(map[string]bool, len(p.MessageBasedParams))
+	for _, msgParams := range p.MessageBasedParams {
+		if msgURLs[msgParams.MsgUrl] {
+			return fmt.Errorf("duplicate message based params: %s", msgParams.MsgUrl)
+		}
+		msgURLs[msgParams.MsgUrl] = true
+
+		if err := msgParams.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid message based params %s: %w", msgParams.MsgUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// ProposalTallyParams returns the quorum, threshold and veto threshold of a
+// proposal with the given message type URLs. They are the strictest of the
+// params of its messages, being their message based params when they exist and
+// the regular params otherwise. The expedited quorum and threshold always apply
+// to expedited proposals.
+func (p Params) ProposalTallyParams(msgURLs []string, expedited bool) (quorum, threshold, vetoThreshold sdk.Dec) {
+	msgParams := make(map[string]MessageBasedParams, len(p.MessageBasedParams))
+	for _, params := range p.MessageBasedParams {
+		msgParams[params.MsgUrl] = params
+	}
+
+	var matched []MessageBasedParams
+	useRegular := expedited || len(msgURLs) == 0
+	for _, msgURL := range msgURLs {
+		params, ok := msgParams[msgURL]
+		if !ok {
+			useRegular = true
+			continue
+		}
+		matched = append(matched, params)
+	}
+
+	if useRegular {
+		quorumStr, thresholdStr := p.Quorum, p.Threshold
+		if expedited {
+			quorumStr, thresholdStr = p.ExpeditedQuorum, p.ExpeditedThreshold
+		}
+		matched = append(matched, NewMessageBasedParams("", quorumStr, thresholdStr, p.VetoThreshold))
+	}
+
+	for i, params := range matched {
+		q, _ := sdk.NewDecFromStr(params.Quorum)
+		t, _ := sdk.NewDecFromStr(params.Threshold)
+		v, _ := sdk.NewDecFromStr(params.VetoThreshold)
+		if i == 0 {
+			quorum, threshold, vetoThreshold = q, t, v
+			continue
+		}
+
+		// a higher quorum or threshold and a lower veto threshold are stricter
+		quorum = sdk.MaxDec(quorum, q)
+		threshold = sdk.MaxDec(threshold, t)
+		vetoThreshold = sdk.MinDec(vetoThreshold, v)
+	}
+
+	return quorum, threshold, vetoThreshold
+}
+
+// NewMessageBasedParams creates new tally params for the proposals containing
+// the message type with the given type URL.
+func NewMessageBasedParams(msgURL, quorum, threshold, vetoThreshold string) MessageBasedParams {
+	return MessageBasedParams{
+		MsgUrl:        msgURL,
+		Quorum:        quorum,
+		Threshold:     threshold,
+		VetoThreshold: vetoThreshold,
+	}
+}
+
+func (p MessageBasedParams) ValidateBasic() error {
+	if len(p.MsgUrl) == 0 {
+		return fmt.Errorf("message type url cannot be empty")
+	}
+
+	quorum, err := sdk.NewDecFromStr(p.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string: %w", err)
+	}
+	if quorum.IsNegative() {
+		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+	}
+	if quorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("quorom too large: %s", p.Quorum)
+	}
+
+	threshold, err := sdk.NewDecFromStr(p.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if !threshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", threshold)
+	}
+	if threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", threshold)
+	}
+
+	vetoThreshold, err := sdk.NewDecFromStr(p.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid vetoThreshold string: %w", err)
+	}
+	if !vetoThreshold.IsPositive() {
+		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
+	}
+	if vetoThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
+	}
+
 	return nil
 }
@@ -0,0 +1,108 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestProposalTallyParams(t *testing.T) {
+	const (
+		upgradeURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		spendURL   = "/cosmos.distribution.v1beta1.MsgCommunityPoolSpend"
+		sendURL    = "/cosmos.bank.v1beta1.MsgSend"
+	)
+
+	params := v1.DefaultParams()
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		v1.NewMessageBasedParams(upgradeURL, "0.6", "0.75", "0.2"),
+		v1.NewMessageBasedParams(spendURL, "0.2", "0.4", "0.5"),
+	}
+
+	testCases := []struct {
+		name             string
+		msgURLs          []string
+		expedited        bool
+		expQuorum        string
+		expThreshold     string
+		expVetoThreshold string
+	}{
+		{
+			name:             "no messages",
+			expQuorum:        "0.334",
+			expThreshold:     "0.5",
+			expVetoThreshold: "0.334",
+		},
+		{
+			name:             "message without specific params",
+			msgURLs:          []string{sendURL},
+			expQuorum:        "0.334",
+			expThreshold:     "0.5",
+			expVetoThreshold: "0.334",
+		},
+		{
+			name:             "stricter message based params",
+			msgURLs:          []string{upgradeURL},
+			expQuorum:        "0.6",
+			expThreshold:     "0.75",
+			expVetoThreshold: "0.2",
+		},
+		{
+			name:             "looser message based params",
+			msgURLs:          []string{spendURL, spendURL},
+			expQuorum:        "0.2",
+			expThreshold:     "0.4",
+			expVetoThreshold: "0.5",
+		},
+		{
+			name:             "looser message based params with a message without specific params",
+			msgURLs:          []string{spendURL, sendURL},
+			expQuorum:        "0.334",
+			expThreshold:     "0.5",
+			expVetoThreshold: "0.334",
+		},
+		{
+			name:             "strictest of the message based params",
+			msgURLs:          []string{spendURL, upgradeURL},
+			expQuorum:        "0.6",
+			expThreshold:     "0.75",
+			expVetoThreshold: "0.2",
+		},
+		{
+			name:             "expedited proposal",
+			msgURLs:          []string{sendURL},
+			expedited:        true,
+			expQuorum:        "0.5",
+			expThreshold:     "0.667",
+			expVetoThreshold: "0.334",
+		},
+		{
+			name:             "expedited proposal with stricter message based params",
+			msgURLs:          []string{upgradeURL},
+			expedited:        true,
+			expQuorum:        "0.6",
+			expThreshold:     "0.75",
+			expVetoThreshold: "0.2",
+		},
+		{
+			name:             "expedited proposal with looser message based params",
+			msgURLs:          []string{spendURL},
+			expedited:        true,
+			expQuorum:        "0.5",
+			expThreshold:     "0.667",
+			expVetoThreshold: "0.334",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			quorum, threshold, vetoThreshold := params.ProposalTallyParams(tc.msgURLs, tc.expedited)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expQuorum), quorum)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expThreshold), threshold)
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expVetoThreshold), vetoThreshold)
+		})
+	}
+}
@@ -23,7 +23,7 @@ const (
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time, expedited bool, proposer sdk.AccAddress) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -39,11 +39,22 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, dep
 		FinalTallyResult: &tally,
 		SubmitTime:       &submitTime,
 		DepositEndTime:   &depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer.String(),
 	}
 
 	return p, nil
 }
 
+// GetMinDepositFromParams returns the minimum deposit required for the
+// proposal to enter its voting period, which is higher for expedited proposals.
+func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
+	if p.Expedited {
+		return params.ExpeditedMinDeposit
+	}
+	return params.MinDeposit
+}
+
 // GetMessages returns the proposal messages
 func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
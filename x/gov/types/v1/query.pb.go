@@ -7,9 +7,12 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -890,6 +893,130 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QuerySimulateProposalRequest is the request type for the Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QuerySimulateProposalRequest struct {
+	// messages are the arbitrary messages of the proposal to simulate.
+	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *QuerySimulateProposalRequest) Reset()         { *m = QuerySimulateProposalRequest{} }
+func (m *QuerySimulateProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalRequest) ProtoMessage()    {}
+func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QuerySimulateProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalRequest.Merge(m, src)
+}
+func (m *QuerySimulateProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalRequest) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// QuerySimulateProposalResponse is the response type for the Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QuerySimulateProposalResponse struct {
+	// gas_used is the gas consumed by the execution of the messages.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// events are the events emitted by the messages which executed successfully.
+	Events []types1.Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+	// msg_responses are the responses of the messages which executed successfully.
+	MsgResponses []*types.Any `protobuf:"bytes,3,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	// error is the error of the first message which failed to execute, empty when
+	// all the messages executed successfully.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuerySimulateProposalResponse) Reset()         { *m = QuerySimulateProposalResponse{} }
+func (m *QuerySimulateProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalResponse) ProtoMessage()    {}
+func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QuerySimulateProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalResponse.Merge(m, src)
+}
+func (m *QuerySimulateProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateProposalResponse) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -907,73 +1034,87 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QuerySimulateProposalRequest)(nil), "cosmos.gov.v1.QuerySimulateProposalRequest")
+	proto.RegisterType((*QuerySimulateProposalResponse)(nil), "cosmos.gov.v1.QuerySimulateProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0xdc, 0x54,
-	0x14, 0x8e, 0x27, 0x8f, 0xce, 0x9c, 0x34, 0x01, 0x4e, 0x1f, 0x19, 0x4c, 0x99, 0x06, 0x87, 0x26,
-	0x81, 0x12, 0x5f, 0x26, 0x7d, 0x49, 0x50, 0x16, 0x0d, 0x25, 0x05, 0x89, 0x45, 0x98, 0x56, 0x2c,
-	0xd8, 0x44, 0x4e, 0xc6, 0x32, 0x16, 0x13, 0x5f, 0x77, 0xee, 0x9d, 0x11, 0x21, 0x8d, 0x90, 0x2a,
-	0x21, 0x58, 0x01, 0x12, 0x15, 0xf0, 0x43, 0xf8, 0x11, 0x2c, 0x2b, 0xd8, 0x20, 0x56, 0x28, 0xe1,
-	0x87, 0x20, 0xdf, 0x7b, 0xec, 0xb1, 0x1d, 0x8f, 0x33, 0x53, 0x55, 0xac, 0x22, 0xdf, 0xfb, 0x9d,
-	0xef, 0x7c, 0xe7, 0x79, 0x33, 0xf0, 0xf2, 0x2e, 0x17, 0x7b, 0x5c, 0x30, 0x8f, 0xf7, 0x59, 0xbf,
-	0xc9, 0x1e, 0xf6, 0xdc, 0xee, 0xbe, 0x1d, 0x76, 0xb9, 0xe4, 0x38, 0xa7, 0xaf, 0x6c, 0x8f, 0xf7,
-	0xed, 0x7e, 0xd3, 0x7c, 0x93, 0x90, 0x3b, 0x8e, 0x70, 0x35, 0x8e, 0xf5, 0x9b, 0x3b, 0xae, 0x74,
-	0x9a, 0x2c, 0x74, 0x3c, 0x3f, 0x70, 0xa4, 0xcf, 0x03, 0x6d, 0x6a, 0x5e, 0xf2, 0x38, 0xf7, 0x3a,
-	0x2e, 0x73, 0x42, 0x9f, 0x39, 0x41, 0xc0, 0xa5, 0xba, 0x14, 0x74, 0xbb, 0x90, 0xf5, 0x19, 0xf1,
-	0xeb, 0x0b, 0x12, 0xb3, 0xad, 0xbe, 0x18, 0xb9, 0x57, 0x1f, 0xd6, 0x2d, 0x38, 0xff, 0x49, 0xe4,
-	0x73, 0xab, 0xcb, 0x43, 0x2e, 0x9c, 0x4e, 0xcb, 0x7d, 0xd8, 0x73, 0x85, 0xc4, 0xcb, 0x30, 0x1b,
-	0xd2, 0xd1, 0xb6, 0xdf, 0xae, 0x1b, 0x8b, 0xc6, 0xea, 0x54, 0x0b, 0xe2, 0xa3, 0x8f, 0xda, 0xd6,
-	0xc7, 0x70, 0x21, 0x67, 0x28, 0x42, 0x1e, 0x08, 0x17, 0xaf, 0x41, 0x35, 0x86, 0x29, 0xb3, 0xd9,
-	0xf5, 0x05, 0x3b, 0x13, 0xb1, 0x9d, 0x98, 0x24, 0x40, 0xeb, 0x87, 0x4a, 0x8e, 0x4e, 0xc4, 0x42,
-	0x36, 0xe1, 0x85, 0x44, 0x88, 0x90, 0x8e, 0xec, 0x09, 0xc5, 0x3a, 0xbf, 0xfe, 0xea, 0x10, 0xd6,
-	0xfb, 0x0a, 0xd4, 0x9a, 0x0f, 0x33, 0xdf, 0x68, 0xc3, 0x74, 0x9f, 0x4b, 0xb7, 0x5b, 0xaf, 0x2c,
-	0x1a, 0xab, 0xb5, 0x8d, 0xfa, 0x1f, 0xbf, 0xad, 0x9d, 0x27, 0x82, 0x3b, 0xed, 0x76, 0xd7, 0x15,
-	0xe2, 0xbe, 0xec, 0xfa, 0x81, 0xd7, 0xd2, 0x30, 0xbc, 0x09, 0xb5, 0xb6, 0x1b, 0x72, 0xe1, 0x4b,
-	0xde, 0xad, 0x4f, 0x9e, 0x62, 0x33, 0x80, 0xe2, 0x26, 0xc0, 0xa0, 0x6c, 0xf5, 0x29, 0x95, 0x80,
-	0xe5, 0x58, 0x6a, 0x54, 0x63, 0x5b, 0xf7, 0x02, 0xd5, 0xd8, 0xde, 0x72, 0x3c, 0x97, 0x62, 0x6d,
-	0xa5, 0x2c, 0xad, 0x5f, 0x0d, 0xb8, 0x98, 0xcf, 0x08, 0x65, 0xf8, 0x06, 0xd4, 0xe2, 0xe0, 0xa2,
-	0x64, 0x4c, 0x96, 0xa5, 0x78, 0x80, 0xc4, 0x7b, 0x19, 0x65, 0x15, 0xa5, 0x6c, 0xe5, 0x54, 0x65,
-	0xda, 0x67, 0x46, 0xda, 0x2e, 0xbc, 0xa8, 0x94, 0x7d, 0xca, 0xa5, 0x3b, 0x6a, 0xbf, 0x8c, 0x9b,
-	0x7f, 0xeb, 0x36, 0xbc, 0x94, 0x72, 0x42, 0x91, 0xaf, 0xc0, 0x54, 0x74, 0x4b, 0x7d, 0x75, 0x2e,
-	0x17, 0xb4, 0x82, 0x2a, 0x80, 0xf5, 0x28, 0x65, 0x2d, 0x46, 0xd6, 0xb8, 0x59, 0x90, 0xa1, 0x67,
-	0xa9, 0xdd, 0x77, 0x06, 0x60, 0xda, 0x3d, 0xa9, 0x7f, 0x43, 0xa7, 0x20, 0xae, 0x59, 0xa1, 0x7c,
-	0x8d, 0x78, 0x7e, 0xb5, 0xba, 0x41, 0x4a, 0xb6, 0x9c, 0xae, 0xb3, 0x97, 0xc9, 0x84, 0x3a, 0xd8,
-	0x96, 0xfb, 0xa1, 0x4e, 0x67, 0x2d, 0x32, 0x8b, 0x8e, 0x1e, 0xec, 0x87, 0xae, 0xf5, 0x73, 0x05,
-	0xce, 0x65, 0xec, 0x28, 0x84, 0xbb, 0x30, 0xd7, 0xe7, 0xd2, 0x0f, 0xbc, 0x6d, 0x0d, 0xa6, 0x4a,
-	0xbc, 0x72, 0x32, 0x14, 0x3f, 0xf0, 0xb4, 0xed, 0x46, 0xa5, 0x6e, 0xb4, 0xce, 0xf6, 0x53, 0x27,
-	0x78, 0x0f, 0xe6, 0x69, 0x60, 0x62, 0x1a, 0x1d, 0xe1, 0xa5, 0x1c, 0xcd, 0x5d, 0x0d, 0x4a, 0xf1,
-	0xcc, 0xb5, 0xd3, 0x47, 0x78, 0x07, 0xce, 0x4a, 0xa7, 0xd3, 0xd9, 0x8f, 0x69, 0x26, 0x15, 0x8d,
-	0x99, 0xa3, 0x79, 0x10, 0x41, 0x52, 0x24, 0xb3, 0x72, 0x70, 0x80, 0x6b, 0x30, 0x43, 0xc6, 0x7a,
-	0x56, 0x2f, 0xe4, 0x27, 0x49, 0x27, 0x80, 0x40, 0x56, 0x40, 0x79, 0x21, 0x69, 0x23, 0xb7, 0x56,
-	0x66, 0x9d, 0x54, 0x46, 0x5e, 0x27, 0xd6, 0x87, 0xb4, 0x9f, 0x13, 0x7f, 0x54, 0x88, 0xb7, 0xe1,
-	0x0c, 0x81, 0xa8, 0x04, 0x17, 0x8b, 0x73, 0xd7, 0x8a, 0x61, 0xd6, 0xd7, 0x59, 0xa6, 0xff, 0x7f,
-	0x2a, 0x9e, 0x18, 0xb4, 0xe3, 0x07, 0x0a, 0x28, 0x98, 0x75, 0xa8, 0x92, 0xca, 0x78, 0x36, 0x86,
-	0x45, 0x93, 0xe0, 0x9e, 0xdf, 0x84, 0xbc, 0x03, 0x0b, 0x4a, 0x95, 0xea, 0x92, 0x96, 0x2b, 0x7a,
-	0x1d, 0x39, 0xc6, 0x23, 0x58, 0x3f, 0x69, 0x9b, 0x54, 0x68, 0x5a, 0xf5, 0x19, 0xd5, 0xa7, 0xb0,
-	0x29, 0xc9, 0x44, 0x03, 0xd7, 0xff, 0xae, 0xc2, 0xb4, 0xa2, 0xc3, 0x6f, 0x0c, 0xa8, 0xc6, 0x2b,
-	0x1c, 0x97, 0x72, 0x96, 0x45, 0xef, 0xb5, 0xf9, 0x7a, 0x39, 0x48, 0x6b, 0xb2, 0xec, 0xc7, 0x7f,
-	0xfe, 0xfb, 0x53, 0x65, 0x15, 0x97, 0x59, 0xf6, 0x5f, 0x85, 0xe4, 0x91, 0x60, 0x07, 0xa9, 0x80,
-	0x0f, 0xf1, 0x2b, 0xa8, 0x25, 0xcf, 0x0f, 0x96, 0xba, 0x88, 0xdb, 0xc9, 0xbc, 0x72, 0x0a, 0x8a,
-	0x94, 0x2c, 0x2a, 0x25, 0x26, 0xd6, 0x87, 0x29, 0xc1, 0x6f, 0x0d, 0x98, 0x8a, 0x56, 0x22, 0x5e,
-	0x2e, 0x62, 0x4c, 0xbd, 0x3d, 0xe6, 0xe2, 0x70, 0x00, 0x79, 0xbb, 0xad, 0xbc, 0xdd, 0xc4, 0xeb,
-	0xa3, 0xc5, 0xcd, 0xd4, 0x12, 0x66, 0x07, 0xea, 0x25, 0x3a, 0xc4, 0xc7, 0x06, 0x4c, 0xab, 0x4d,
-	0x8e, 0x43, 0x3d, 0x25, 0xe1, 0xbf, 0x56, 0x82, 0x20, 0x31, 0xd7, 0x95, 0x18, 0x1b, 0xdf, 0x1a,
-	0x47, 0x0c, 0x3e, 0x82, 0x19, 0xda, 0x58, 0x85, 0x2e, 0x32, 0xfb, 0xdd, 0xb4, 0xca, 0x20, 0x24,
-	0xe3, 0xaa, 0x92, 0x71, 0x05, 0x97, 0xf2, 0x32, 0x14, 0x8c, 0x1d, 0xa4, 0x1e, 0x88, 0x43, 0xfc,
-	0xc5, 0x80, 0x33, 0x34, 0x83, 0x58, 0x48, 0x9e, 0xdd, 0x87, 0xe6, 0x52, 0x29, 0x86, 0x14, 0xbc,
-	0xaf, 0x14, 0xbc, 0x87, 0xef, 0x8e, 0x98, 0x88, 0x78, 0xf6, 0xd9, 0x41, 0xb2, 0x1f, 0x0f, 0xf1,
-	0x7b, 0x03, 0xaa, 0xf1, 0x42, 0xc1, 0x32, 0xb7, 0xa2, 0x74, 0x54, 0xf2, 0x3b, 0xc9, 0xba, 0xa5,
-	0xc4, 0x35, 0x91, 0x8d, 0x29, 0x0e, 0x9f, 0x18, 0x30, 0x9b, 0x1a, 0x6e, 0x5c, 0x2e, 0x72, 0x77,
-	0x72, 0xd9, 0x98, 0x2b, 0xa7, 0xe2, 0x9e, 0xb1, 0x7f, 0xd4, 0x72, 0xd9, 0xf8, 0xe0, 0xf7, 0xa3,
-	0x86, 0xf1, 0xf4, 0xa8, 0x61, 0xfc, 0x73, 0xd4, 0x30, 0x7e, 0x3c, 0x6e, 0x4c, 0x3c, 0x3d, 0x6e,
-	0x4c, 0xfc, 0x75, 0xdc, 0x98, 0xf8, 0xec, 0xaa, 0xe7, 0xcb, 0xcf, 0x7b, 0x3b, 0xf6, 0x2e, 0xdf,
-	0x8b, 0x19, 0xf5, 0x9f, 0x35, 0xd1, 0xfe, 0x82, 0x7d, 0xa9, 0xe8, 0xa3, 0x2e, 0x10, 0xd1, 0xef,
-	0x92, 0x19, 0xf5, 0xb3, 0xe1, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xe4, 0xf6, 0xe9,
-	0xe0, 0x0c, 0x00, 0x00,
+	// 1163 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1c, 0x45,
+	0x13, 0xf6, 0xac, 0xbf, 0x76, 0xcb, 0x1f, 0x6f, 0xde, 0xce, 0x3a, 0x5e, 0x4f, 0x9c, 0xb5, 0x33,
+	0x26, 0xb6, 0x89, 0xed, 0x99, 0xd8, 0x71, 0x12, 0x11, 0xc2, 0xc1, 0x26, 0x71, 0x40, 0xe2, 0x60,
+	0xc6, 0x81, 0x03, 0x97, 0xd5, 0xd8, 0xdb, 0x0c, 0x23, 0x76, 0xa7, 0x37, 0xd3, 0xbd, 0x2b, 0x8c,
+	0x63, 0x21, 0x45, 0x42, 0x70, 0x02, 0x24, 0x22, 0x40, 0xe2, 0x6f, 0xf0, 0x07, 0xb8, 0xe5, 0x18,
+	0xc1, 0x85, 0x13, 0x42, 0x36, 0x3f, 0x82, 0x23, 0x9a, 0xee, 0x9a, 0xf5, 0xcc, 0x78, 0x67, 0xbd,
+	0x8e, 0x22, 0x4e, 0xf6, 0x74, 0x3f, 0xf5, 0xd4, 0x53, 0xd5, 0x55, 0xd5, 0xbd, 0x30, 0xb5, 0xc7,
+	0x78, 0x9d, 0x71, 0xcb, 0x65, 0x2d, 0xab, 0xb5, 0x6a, 0x3d, 0x6e, 0xd2, 0x60, 0xdf, 0x6c, 0x04,
+	0x4c, 0x30, 0x32, 0xa6, 0xb6, 0x4c, 0x97, 0xb5, 0xcc, 0xd6, 0xaa, 0x7e, 0x1d, 0x91, 0xbb, 0x0e,
+	0xa7, 0x0a, 0x67, 0xb5, 0x56, 0x77, 0xa9, 0x70, 0x56, 0xad, 0x86, 0xe3, 0x7a, 0xbe, 0x23, 0x3c,
+	0xe6, 0x2b, 0x53, 0xbd, 0xe8, 0x32, 0x97, 0xc9, 0x7f, 0xad, 0xf0, 0x3f, 0x5c, 0x9d, 0x76, 0x19,
+	0x73, 0x6b, 0xd4, 0x72, 0x1a, 0x9e, 0xe5, 0xf8, 0x3e, 0x13, 0xd2, 0x84, 0xe3, 0xee, 0x14, 0xee,
+	0xca, 0xaf, 0xdd, 0xe6, 0xc7, 0x96, 0xe3, 0xa3, 0x12, 0x7d, 0x32, 0x29, 0x32, 0x14, 0x84, 0x36,
+	0x6a, 0xa3, 0xa2, 0x5c, 0xa9, 0x0f, 0xdc, 0xba, 0x2c, 0xa8, 0x5f, 0xa5, 0x41, 0xdd, 0xf3, 0x85,
+	0xe5, 0xec, 0xee, 0x79, 0x96, 0xd8, 0x6f, 0x50, 0xdc, 0x34, 0xee, 0x40, 0xf1, 0xfd, 0x30, 0x82,
+	0xed, 0x80, 0x35, 0x18, 0x77, 0x6a, 0x36, 0x7d, 0xdc, 0xa4, 0x5c, 0x90, 0x19, 0x18, 0x69, 0xe0,
+	0x52, 0xc5, 0xab, 0x96, 0xb4, 0x59, 0x6d, 0x71, 0xc0, 0x86, 0x68, 0xe9, 0xdd, 0xaa, 0xf1, 0x1e,
+	0x4c, 0xa4, 0x0c, 0x79, 0x83, 0xf9, 0x9c, 0x92, 0x9b, 0x90, 0x8f, 0x60, 0xd2, 0x6c, 0x64, 0x6d,
+	0xd2, 0x4c, 0xe4, 0xcf, 0x6c, 0x9b, 0xb4, 0x81, 0xc6, 0xb7, 0xb9, 0x14, 0x1d, 0x8f, 0x84, 0x6c,
+	0xc1, 0xff, 0xda, 0x42, 0xb8, 0x70, 0x44, 0x93, 0x4b, 0xd6, 0xf1, 0xb5, 0x2b, 0x19, 0xac, 0x3b,
+	0x12, 0x64, 0x8f, 0x37, 0x12, 0xdf, 0xc4, 0x84, 0xc1, 0x16, 0x13, 0x34, 0x28, 0xe5, 0x66, 0xb5,
+	0xc5, 0xc2, 0x66, 0xe9, 0xb7, 0x5f, 0x56, 0x8a, 0x48, 0xb0, 0x51, 0xad, 0x06, 0x94, 0xf3, 0x1d,
+	0x11, 0x78, 0xbe, 0x6b, 0x2b, 0x18, 0xb9, 0x0d, 0x85, 0x2a, 0x6d, 0x30, 0xee, 0x09, 0x16, 0x94,
+	0xfa, 0xcf, 0xb0, 0x39, 0x81, 0x92, 0x2d, 0x80, 0x93, 0x22, 0x28, 0x0d, 0xc8, 0x04, 0xcc, 0x47,
+	0x52, 0xc3, 0x8a, 0x31, 0x55, 0x65, 0x61, 0xc5, 0x98, 0xdb, 0x8e, 0x4b, 0x31, 0x56, 0x3b, 0x66,
+	0x69, 0xfc, 0xa4, 0xc1, 0xa5, 0x74, 0x46, 0x30, 0xc3, 0xb7, 0xa0, 0x10, 0x05, 0x17, 0x26, 0xa3,
+	0xbf, 0x5b, 0x8a, 0x4f, 0x90, 0xe4, 0x61, 0x42, 0x59, 0x4e, 0x2a, 0x5b, 0x38, 0x53, 0x99, 0xf2,
+	0x99, 0x90, 0xb6, 0x07, 0x17, 0xa4, 0xb2, 0x0f, 0x99, 0xa0, 0xbd, 0xd6, 0xcb, 0x79, 0xf3, 0x6f,
+	0xdc, 0x83, 0xff, 0xc7, 0x9c, 0x60, 0xe4, 0x0b, 0x30, 0x10, 0xee, 0x62, 0x5d, 0x5d, 0x4c, 0x05,
+	0x2d, 0xa1, 0x12, 0x60, 0x3c, 0x89, 0x59, 0xf3, 0x9e, 0x35, 0x6e, 0x75, 0xc8, 0xd0, 0xcb, 0x9c,
+	0xdd, 0xd7, 0x1a, 0x90, 0xb8, 0x7b, 0x54, 0xff, 0xba, 0x4a, 0x41, 0x74, 0x66, 0x1d, 0xe5, 0x2b,
+	0xc4, 0xab, 0x3b, 0xab, 0x5b, 0xa8, 0x64, 0xdb, 0x09, 0x9c, 0x7a, 0x22, 0x13, 0x72, 0xa1, 0x12,
+	0xce, 0x02, 0x99, 0x89, 0x82, 0x0d, 0x6a, 0xe9, 0xd1, 0x7e, 0x83, 0x1a, 0x3f, 0xe4, 0xe0, 0x62,
+	0xc2, 0x0e, 0x43, 0xb8, 0x0f, 0x63, 0x2d, 0x26, 0x3c, 0xdf, 0xad, 0x28, 0x30, 0x9e, 0xc4, 0xe5,
+	0xd3, 0xa1, 0x78, 0xbe, 0xab, 0x6c, 0x37, 0x73, 0x25, 0xcd, 0x1e, 0x6d, 0xc5, 0x56, 0xc8, 0x43,
+	0x18, 0xc7, 0x86, 0x89, 0x68, 0x54, 0x84, 0xd3, 0x29, 0x9a, 0xfb, 0x0a, 0x14, 0xe3, 0x19, 0xab,
+	0xc6, 0x97, 0xc8, 0x06, 0x8c, 0x0a, 0xa7, 0x56, 0xdb, 0x8f, 0x68, 0xfa, 0x25, 0x8d, 0x9e, 0xa2,
+	0x79, 0x14, 0x42, 0x62, 0x24, 0x23, 0xe2, 0x64, 0x81, 0xac, 0xc0, 0x10, 0x1a, 0xab, 0x5e, 0x9d,
+	0x48, 0x77, 0x92, 0x4a, 0x00, 0x82, 0x0c, 0x1f, 0xf3, 0x82, 0xd2, 0x7a, 0x2e, 0xad, 0xc4, 0x38,
+	0xc9, 0xf5, 0x3c, 0x4e, 0x8c, 0x77, 0xa0, 0x98, 0xf4, 0x87, 0x07, 0x71, 0x03, 0x86, 0x11, 0x84,
+	0x47, 0x70, 0xa9, 0x73, 0xee, 0xec, 0x08, 0x66, 0x7c, 0x91, 0x64, 0xfa, 0xef, 0xbb, 0xe2, 0x99,
+	0x06, 0x13, 0x29, 0x05, 0x18, 0xcc, 0x1a, 0xe4, 0x51, 0x65, 0xd4, 0x1b, 0x59, 0xd1, 0xb4, 0x71,
+	0xaf, 0xae, 0x43, 0xee, 0xc2, 0xa4, 0x54, 0x25, 0xab, 0xc4, 0xa6, 0xbc, 0x59, 0x13, 0xe7, 0xb8,
+	0x04, 0x4b, 0xa7, 0x6d, 0xdb, 0x27, 0x34, 0x28, 0xeb, 0xac, 0xa4, 0x65, 0x17, 0x25, 0x9a, 0x28,
+	0xa0, 0xb1, 0x0d, 0xd3, 0x92, 0x6d, 0xc7, 0xab, 0x37, 0x6b, 0x8e, 0xa0, 0xe9, 0x3b, 0xf9, 0x06,
+	0xe4, 0xeb, 0x94, 0x73, 0xc7, 0x6d, 0x8f, 0x90, 0xa2, 0xa9, 0x9e, 0x0a, 0x66, 0xf4, 0x54, 0x30,
+	0x37, 0xfc, 0x7d, 0xbb, 0x8d, 0x32, 0x7e, 0xd5, 0xe0, 0x4a, 0x06, 0x25, 0xaa, 0x9c, 0x82, 0xbc,
+	0xeb, 0xf0, 0x4a, 0x93, 0xd3, 0x28, 0xbe, 0x61, 0xd7, 0xe1, 0x1f, 0x70, 0x5a, 0x25, 0xeb, 0x30,
+	0x44, 0x5b, 0xd4, 0x17, 0x61, 0x77, 0xaa, 0x33, 0x39, 0x79, 0x48, 0x98, 0xe1, 0x43, 0xc2, 0x7c,
+	0x10, 0x6e, 0x6f, 0x0e, 0x3c, 0xff, 0x73, 0xa6, 0xcf, 0x46, 0x2c, 0x79, 0x03, 0xc6, 0xea, 0xdc,
+	0xad, 0x04, 0xe8, 0x20, 0xec, 0xc9, 0x6c, 0xa5, 0xa3, 0x75, 0xee, 0x46, 0x52, 0x38, 0x29, 0xc2,
+	0x20, 0x0d, 0x02, 0x16, 0xc8, 0x4e, 0x2c, 0xd8, 0xea, 0x63, 0xed, 0x9f, 0x02, 0x0c, 0xca, 0x18,
+	0xc8, 0x97, 0x1a, 0xe4, 0xa3, 0x00, 0xc8, 0x5c, 0x2a, 0x9f, 0x9d, 0x5e, 0x31, 0xfa, 0x6b, 0xdd,
+	0x41, 0xca, 0xb1, 0x61, 0x3e, 0xfd, 0xfd, 0xef, 0xef, 0x73, 0x8b, 0x64, 0xde, 0x4a, 0xbe, 0xae,
+	0xda, 0x57, 0xa7, 0x75, 0x10, 0x2b, 0x83, 0x43, 0xf2, 0x39, 0x14, 0x22, 0x0e, 0x4e, 0xba, 0xba,
+	0x88, 0x9a, 0x4c, 0xbf, 0x76, 0x06, 0x0a, 0x95, 0xcc, 0x4a, 0x25, 0x3a, 0x29, 0x65, 0x29, 0x21,
+	0x5f, 0x69, 0x30, 0x10, 0x5e, 0x14, 0x64, 0xa6, 0x13, 0x63, 0xec, 0x46, 0xd6, 0x67, 0xb3, 0x01,
+	0xe8, 0xed, 0x9e, 0xf4, 0x76, 0x9b, 0xac, 0xf7, 0x16, 0xb7, 0x25, 0xaf, 0x26, 0xeb, 0x20, 0xfc,
+	0x13, 0x1c, 0x92, 0xa7, 0x1a, 0x0c, 0x86, 0x74, 0x9c, 0x64, 0x7a, 0x6a, 0x87, 0x7f, 0xb5, 0x0b,
+	0x02, 0xc5, 0xac, 0x4b, 0x31, 0x26, 0x59, 0x3e, 0x8f, 0x18, 0xf2, 0x04, 0x86, 0x70, 0x8e, 0x77,
+	0x74, 0x91, 0xb8, 0xf5, 0x74, 0xa3, 0x1b, 0x04, 0x65, 0x2c, 0x49, 0x19, 0xd7, 0xc8, 0x5c, 0x5a,
+	0x86, 0x84, 0x59, 0x07, 0xb1, 0x6b, 0xf3, 0x90, 0xfc, 0xa8, 0xc1, 0x30, 0x4e, 0x26, 0xd2, 0x91,
+	0x3c, 0x79, 0x4b, 0xe8, 0x73, 0x5d, 0x31, 0xa8, 0xe0, 0x6d, 0xa9, 0xe0, 0x2d, 0xf2, 0x66, 0x8f,
+	0x89, 0x88, 0x26, 0xa2, 0x75, 0xd0, 0xbe, 0x35, 0x0e, 0xc9, 0x37, 0x1a, 0xe4, 0x91, 0x98, 0x93,
+	0x6e, 0x6e, 0x79, 0xd7, 0x56, 0x49, 0x4f, 0x6a, 0xe3, 0x8e, 0x14, 0xb7, 0x4a, 0xac, 0x73, 0x8a,
+	0x23, 0xcf, 0x34, 0x18, 0x89, 0x8d, 0x3c, 0x32, 0xdf, 0xc9, 0xdd, 0xe9, 0x11, 0xac, 0x2f, 0x9c,
+	0x89, 0x7b, 0xc9, 0xfa, 0x91, 0x23, 0x97, 0xfc, 0xac, 0xc1, 0x85, 0xf4, 0x6c, 0x24, 0x4b, 0x9d,
+	0x7c, 0x66, 0x0c, 0x65, 0x7d, 0xb9, 0x37, 0x30, 0xaa, 0x5c, 0x96, 0x2a, 0xe7, 0xef, 0x6a, 0xd7,
+	0x8d, 0xab, 0x99, 0x42, 0x39, 0x5a, 0x6f, 0x3e, 0x78, 0x7e, 0x54, 0xd6, 0x5e, 0x1c, 0x95, 0xb5,
+	0xbf, 0x8e, 0xca, 0xda, 0x77, 0xc7, 0xe5, 0xbe, 0x17, 0xc7, 0xe5, 0xbe, 0x3f, 0x8e, 0xcb, 0x7d,
+	0x1f, 0x2d, 0xb9, 0x9e, 0xf8, 0xa4, 0xb9, 0x6b, 0xee, 0xb1, 0x7a, 0x44, 0xa3, 0xfe, 0xac, 0xf0,
+	0xea, 0xa7, 0xd6, 0x67, 0x92, 0x53, 0xfe, 0xcc, 0x0b, 0x7f, 0x99, 0x0e, 0xc9, 0x99, 0x7b, 0xf3,
+	0xdf, 0x01, 0x00, 0xd3, 0xe5, 0xdb, 0xf3, 0xe2, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1004,6 +1145,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against a branch of
+	// the current state, with the gov module account as signer, without
+	// persisting anything.
+	//
+	// Since: cosmos-sdk 0.47
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -1086,6 +1233,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/SimulateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1104,6 +1260,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against a branch of
+	// the current state, with the gov module account as signer, without
+	// persisting anything.
+	//
+	// Since: cosmos-sdk 0.47
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1296,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) SimulateProposal(ctx context.Context, req *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1283,6 +1448,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/SimulateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1319,6 +1502,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1972,6 +2159,106 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2242,6 +2529,49 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySimulateProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3947,6 +4277,259 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
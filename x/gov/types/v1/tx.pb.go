@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,8 @@ type MsgSubmitProposal struct {
 	Proposer       string        `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited.
+	Expedited bool `protobuf:"varint,5,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -103,6 +109,13 @@ func (m *MsgSubmitProposal) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -650,6 +663,125 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCancelProposal is the Msg/CancelProposal request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgCancelProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{12}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse defines the response structure for executing a
+// MsgCancelProposal message.
+//
+// Since: cosmos-sdk 0.47
+type MsgCancelProposalResponse struct {
+	ProposalId     uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	CanceledTime   time.Time `protobuf:"bytes,2,opt,name=canceled_time,json=canceledTime,proto3,stdtime" json:"canceled_time"`
+	CanceledHeight uint64    `protobuf:"varint,3,opt,name=canceled_height,json=canceledHeight,proto3" json:"canceled_height,omitempty"`
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{13}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
+func (m *MsgCancelProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledTime() time.Time {
+	if m != nil {
+		return m.CanceledTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
+	if m != nil {
+		return m.CanceledHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -663,62 +795,72 @@ func init() {
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1.MsgDepositResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.gov.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 797 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0x49, 0x9a, 0xc0, 0x4b, 0x09, 0xc2, 0x4a, 0xc1, 0xb1, 0x90, 0x09, 0xa9, 0x54, 0x45,
-	0x45, 0xd8, 0x04, 0xaa, 0x56, 0x82, 0xaa, 0x12, 0xa1, 0xa8, 0xad, 0xd4, 0xa8, 0xc8, 0xa8, 0x54,
-	0xaa, 0x56, 0x42, 0x4e, 0x3c, 0x3b, 0x58, 0x8b, 0x3d, 0x96, 0x67, 0x12, 0x91, 0xe3, 0xee, 0xde,
-	0x57, 0xfb, 0x53, 0xf6, 0xc0, 0x7d, 0x6f, 0x2b, 0xb4, 0x27, 0xb4, 0x27, 0x4e, 0x68, 0x05, 0x87,
-	0x95, 0xf6, 0xb4, 0x3f, 0x61, 0x65, 0xcf, 0xd8, 0x09, 0x71, 0x20, 0xec, 0x65, 0x4f, 0xb1, 0xdf,
-	0xfb, 0xbe, 0x37, 0xdf, 0xe7, 0x37, 0x6f, 0x26, 0xb0, 0xd0, 0x21, 0xd4, 0x25, 0xd4, 0xc0, 0xa4,
-	0x67, 0xf4, 0x1a, 0x06, 0x3b, 0xd5, 0xfd, 0x80, 0x30, 0x22, 0xcf, 0xf2, 0xb8, 0x8e, 0x49, 0x4f,
-	0xef, 0x35, 0x54, 0x4d, 0xc0, 0xda, 0x16, 0x45, 0x46, 0xaf, 0xd1, 0x46, 0xcc, 0x6a, 0x18, 0x1d,
-	0xe2, 0x78, 0x1c, 0xae, 0x2e, 0xde, 0x2e, 0x13, 0xb2, 0x78, 0xa2, 0x8c, 0x09, 0x26, 0xd1, 0xa3,
-	0x11, 0x3e, 0x89, 0x68, 0x85, 0xc3, 0x8f, 0x78, 0x42, 0x2c, 0x25, 0x52, 0x98, 0x10, 0x7c, 0x82,
-	0x8c, 0xe8, 0xad, 0xdd, 0x7d, 0x6c, 0x58, 0x5e, 0x7f, 0x64, 0x11, 0x97, 0xe2, 0x70, 0x11, 0x97,
-	0x62, 0x9e, 0xa8, 0x7d, 0x92, 0x60, 0xbe, 0x45, 0xf1, 0x41, 0xb7, 0xed, 0x3a, 0x6c, 0x3f, 0x20,
-	0x3e, 0xa1, 0xd6, 0x89, 0xbc, 0x0e, 0xd3, 0x2e, 0xa2, 0xd4, 0xc2, 0x88, 0x2a, 0x52, 0x35, 0x5b,
-	0x2f, 0x6e, 0x94, 0x75, 0x5e, 0x5c, 0x8f, 0x8b, 0xeb, 0x3b, 0x5e, 0xdf, 0x4c, 0x50, 0xf2, 0x9f,
-	0x30, 0xe7, 0x78, 0x0e, 0x73, 0xac, 0x93, 0x23, 0x1b, 0xf9, 0x84, 0x3a, 0x4c, 0x99, 0x8a, 0x88,
-	0x15, 0x5d, 0x68, 0x0c, 0xfd, 0xeb, 0xc2, 0xbf, 0xbe, 0x4b, 0x1c, 0xaf, 0x99, 0x3b, 0xbf, 0x5a,
-	0xce, 0x98, 0x25, 0xc1, 0xfb, 0x9d, 0xd3, 0xe4, 0x9f, 0x60, 0xda, 0x8f, 0x74, 0xa0, 0x40, 0xc9,
-	0x56, 0xa5, 0xfa, 0x4c, 0x53, 0x79, 0x77, 0xb6, 0x56, 0x16, 0x55, 0x76, 0x6c, 0x3b, 0x40, 0x94,
-	0x1e, 0xb0, 0xc0, 0xf1, 0xb0, 0x99, 0x20, 0x65, 0x35, 0x54, 0xcc, 0x2c, 0xdb, 0x62, 0x96, 0x92,
-	0x0b, 0x59, 0x66, 0xf2, 0xbe, 0x35, 0xfb, 0xec, 0xc3, 0xab, 0x1f, 0x13, 0x68, 0xed, 0x57, 0xa8,
-	0xa4, 0x1c, 0x9b, 0x88, 0xfa, 0xc4, 0xa3, 0x48, 0x5e, 0x86, 0xa2, 0x2f, 0x62, 0x47, 0x8e, 0xad,
-	0x48, 0x55, 0xa9, 0x9e, 0x33, 0x21, 0x0e, 0xfd, 0x65, 0xd7, 0x9e, 0x4a, 0x50, 0x6e, 0x51, 0xbc,
-	0x77, 0x8a, 0x3a, 0x7f, 0x23, 0x6c, 0x75, 0xfa, 0xbb, 0xc4, 0x63, 0xc8, 0x63, 0xf2, 0x36, 0x14,
-	0x3a, 0xfc, 0x31, 0x62, 0xdd, 0xf1, 0xc9, 0x9a, 0xc5, 0xb7, 0x67, 0x6b, 0x05, 0xc1, 0x31, 0x63,
-	0x86, 0xbc, 0x04, 0x33, 0x56, 0x97, 0x1d, 0x93, 0xc0, 0x61, 0x7d, 0x65, 0x2a, 0xd2, 0x3f, 0x08,
-	0x6c, 0x95, 0x42, 0x03, 0x83, 0xf7, 0x9a, 0x06, 0x4b, 0xe3, 0x24, 0xc4, 0x26, 0x6a, 0x6f, 0x24,
-	0x28, 0xb4, 0x28, 0x3e, 0x24, 0x0c, 0xc9, 0xeb, 0x63, 0x0c, 0x35, 0xe7, 0x3e, 0x5e, 0x2d, 0x0f,
-	0x87, 0x87, 0x1d, 0xca, 0x3a, 0x7c, 0xd3, 0x23, 0x0c, 0x05, 0x5c, 0xc7, 0x3d, 0x5f, 0x9f, 0xc3,
-	0xe4, 0x06, 0xe4, 0x89, 0xcf, 0x1c, 0xe2, 0x45, 0xed, 0x2a, 0x0d, 0x3a, 0xce, 0x07, 0x40, 0x0f,
-	0x65, 0xfc, 0x13, 0x01, 0x4c, 0x01, 0xbc, 0xb7, 0x5b, 0x10, 0x9a, 0xe5, 0xa5, 0x6b, 0xf3, 0x30,
-	0x27, 0x7c, 0x24, 0xde, 0x2e, 0xa5, 0x24, 0xf6, 0x1f, 0x72, 0xf0, 0x31, 0x43, 0xf6, 0x57, 0xf0,
-	0xb8, 0x0d, 0x05, 0x2e, 0x9d, 0x2a, 0xd9, 0x68, 0x5b, 0xaf, 0x8c, 0x98, 0x8c, 0xb5, 0x0c, 0x99,
-	0x8d, 0x19, 0x0f, 0x76, 0x5b, 0x81, 0xc5, 0x11, 0x67, 0x89, 0xeb, 0xd7, 0x12, 0x40, 0x8b, 0xe2,
-	0x78, 0x46, 0xbe, 0xdc, 0xf0, 0xcf, 0x30, 0x23, 0xe6, 0x92, 0x4c, 0x36, 0x3d, 0x80, 0xca, 0xbf,
-	0x40, 0xde, 0x72, 0x49, 0xd7, 0x63, 0xc2, 0xf7, 0xc4, 0x71, 0x16, 0x70, 0xb1, 0x67, 0x93, 0x42,
-	0xb5, 0x32, 0xc8, 0x03, 0x03, 0x89, 0xaf, 0x17, 0xbc, 0x9b, 0xff, 0xfa, 0xb6, 0xc5, 0xd0, 0xbe,
-	0x15, 0x58, 0x2e, 0x0d, 0xa5, 0x0e, 0x66, 0x41, 0x9a, 0x24, 0x35, 0x81, 0xca, 0x9b, 0x90, 0xf7,
-	0xa3, 0x0a, 0x91, 0xbf, 0xe2, 0xc6, 0x77, 0x23, 0x2d, 0xe2, 0xe5, 0x63, 0x99, 0x1c, 0x9a, 0x1a,
-	0x2d, 0xde, 0x83, 0x61, 0x3d, 0xb1, 0xd6, 0x8d, 0xe7, 0x39, 0xc8, 0xb6, 0x28, 0x96, 0x1f, 0x41,
-	0x69, 0xe4, 0xb8, 0xac, 0x8e, 0xac, 0x94, 0x3a, 0x5e, 0xd4, 0xfa, 0x24, 0x44, 0x72, 0x00, 0x21,
-	0x98, 0x4f, 0x9f, 0x2d, 0xdf, 0xa7, 0xe9, 0x29, 0x90, 0xba, 0xfa, 0x00, 0x50, 0xb2, 0xcc, 0x6f,
-	0x90, 0x8b, 0x8e, 0x87, 0x85, 0x34, 0x29, 0x8c, 0xab, 0xda, 0xf8, 0x78, 0xc2, 0x3f, 0x84, 0x6f,
-	0x6f, 0x8d, 0xe0, 0x1d, 0xf8, 0x38, 0xaf, 0xfe, 0x70, 0x7f, 0x3e, 0xa9, 0xfb, 0x07, 0x14, 0xe2,
-	0x4d, 0x5e, 0x49, 0x53, 0x44, 0x4a, 0x5d, 0xb9, 0x33, 0x35, 0x2c, 0xf0, 0xd6, 0xae, 0x1a, 0x23,
-	0x70, 0x38, 0x3f, 0x4e, 0xe0, 0xb8, 0x5d, 0xd0, 0xdc, 0x3b, 0xbf, 0xd6, 0xa4, 0x8b, 0x6b, 0x4d,
-	0x7a, 0x7f, 0xad, 0x49, 0x2f, 0x6f, 0xb4, 0xcc, 0xc5, 0x8d, 0x96, 0xb9, 0xbc, 0xd1, 0x32, 0xff,
-	0xaf, 0x62, 0x87, 0x1d, 0x77, 0xdb, 0x7a, 0x87, 0xb8, 0xe2, 0x5e, 0x16, 0x3f, 0x6b, 0xd4, 0x7e,
-	0x62, 0x9c, 0x46, 0x17, 0x3c, 0xeb, 0xfb, 0x88, 0x86, 0xff, 0x02, 0xf2, 0xd1, 0xa5, 0xb0, 0xf9,
-	0x39, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x33, 0x01, 0xac, 0x45, 0x08, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6b, 0x1b, 0x47,
+	0x14, 0xf6, 0xca, 0x8a, 0x65, 0x3f, 0xc7, 0x32, 0x5e, 0xd4, 0x64, 0xb5, 0x84, 0x95, 0xa2, 0x42,
+	0x2a, 0x1a, 0xbc, 0x1b, 0x39, 0xa5, 0x05, 0xa7, 0x14, 0x22, 0x37, 0x34, 0x81, 0x8a, 0x86, 0x4d,
+	0x9b, 0x42, 0x09, 0x98, 0x91, 0x76, 0x3a, 0x5e, 0xea, 0xdd, 0x59, 0x34, 0x23, 0x61, 0x1d, 0xdb,
+	0x63, 0x0f, 0x21, 0x3f, 0xa5, 0x87, 0xdc, 0x4b, 0x2f, 0x25, 0xf4, 0x14, 0x7a, 0xca, 0x29, 0x6d,
+	0xed, 0x43, 0xa1, 0xbf, 0xa2, 0xec, 0xcc, 0xec, 0x48, 0xde, 0x95, 0x2d, 0xfb, 0x92, 0x93, 0xb4,
+	0xef, 0x7d, 0xef, 0xcd, 0xf7, 0xbd, 0x37, 0xef, 0xed, 0xc2, 0xb5, 0x01, 0x65, 0x11, 0x65, 0x1e,
+	0xa1, 0x63, 0x6f, 0xdc, 0xf1, 0xf8, 0x91, 0x9b, 0x0c, 0x29, 0xa7, 0xe6, 0x86, 0xb4, 0xbb, 0x84,
+	0x8e, 0xdd, 0x71, 0xc7, 0x76, 0x14, 0xac, 0x8f, 0x18, 0xf6, 0xc6, 0x9d, 0x3e, 0xe6, 0xa8, 0xe3,
+	0x0d, 0x68, 0x18, 0x4b, 0xb8, 0x7d, 0xfd, 0x74, 0x9a, 0x34, 0x4a, 0x3a, 0x6a, 0x84, 0x12, 0x2a,
+	0xfe, 0x7a, 0xe9, 0x3f, 0x65, 0xad, 0x4b, 0xf8, 0xbe, 0x74, 0xa8, 0xa3, 0x94, 0x8b, 0x50, 0x4a,
+	0x0e, 0xb1, 0x27, 0x9e, 0xfa, 0xa3, 0xef, 0x3d, 0x14, 0x4f, 0x94, 0xab, 0x91, 0x77, 0xf1, 0x30,
+	0xc2, 0x8c, 0xa3, 0x28, 0xc9, 0xb1, 0x88, 0x18, 0x49, 0x59, 0x44, 0x8c, 0x48, 0x47, 0xeb, 0x79,
+	0x09, 0xb6, 0x7a, 0x8c, 0x3c, 0x19, 0xf5, 0xa3, 0x90, 0x3f, 0x1e, 0xd2, 0x84, 0x32, 0x74, 0x68,
+	0xde, 0x81, 0xd5, 0x08, 0x33, 0x86, 0x08, 0x66, 0x96, 0xd1, 0x5c, 0x6e, 0xaf, 0xef, 0xd4, 0x5c,
+	0x79, 0x84, 0x9b, 0x1d, 0xe1, 0xde, 0x8f, 0x27, 0xbe, 0x46, 0x99, 0x0f, 0x61, 0x33, 0x8c, 0x43,
+	0x1e, 0xa2, 0xc3, 0xfd, 0x00, 0x27, 0x94, 0x85, 0xdc, 0x2a, 0x89, 0xc0, 0xba, 0xab, 0x44, 0xa4,
+	0x05, 0x72, 0x55, 0x81, 0xdc, 0x3d, 0x1a, 0xc6, 0xdd, 0xf2, 0xab, 0xb7, 0x8d, 0x25, 0xbf, 0xaa,
+	0xe2, 0x3e, 0x97, 0x61, 0xe6, 0x47, 0xb0, 0x9a, 0x08, 0x1e, 0x78, 0x68, 0x2d, 0x37, 0x8d, 0xf6,
+	0x5a, 0xd7, 0xfa, 0xf3, 0xe5, 0x76, 0x4d, 0x65, 0xb9, 0x1f, 0x04, 0x43, 0xcc, 0xd8, 0x13, 0x3e,
+	0x0c, 0x63, 0xe2, 0x6b, 0xa4, 0x69, 0xa7, 0x8c, 0x39, 0x0a, 0x10, 0x47, 0x56, 0x39, 0x8d, 0xf2,
+	0xf5, 0xb3, 0x79, 0x03, 0xd6, 0xf0, 0x51, 0x82, 0x83, 0x90, 0xe3, 0xc0, 0xba, 0xd2, 0x34, 0xda,
+	0xab, 0xfe, 0xd4, 0xb0, 0xbb, 0xf1, 0xd3, 0xbf, 0xbf, 0x7c, 0xa8, 0x13, 0xb5, 0x3e, 0x85, 0x7a,
+	0xa1, 0x1e, 0x3e, 0x66, 0x09, 0x8d, 0x19, 0x36, 0x1b, 0xb0, 0x9e, 0x28, 0xdb, 0x7e, 0x18, 0x58,
+	0x46, 0xd3, 0x68, 0x97, 0x7d, 0xc8, 0x4c, 0x8f, 0x82, 0xd6, 0x8f, 0x06, 0xd4, 0x7a, 0x8c, 0x3c,
+	0x38, 0xc2, 0x83, 0x2f, 0x31, 0x41, 0x83, 0xc9, 0x1e, 0x8d, 0x39, 0x8e, 0xb9, 0x79, 0x0f, 0x2a,
+	0x03, 0xf9, 0x57, 0x44, 0x9d, 0x51, 0xd0, 0xee, 0xfa, 0x1f, 0x2f, 0xb7, 0x2b, 0x2a, 0xc6, 0xcf,
+	0x22, 0x52, 0x01, 0x68, 0xc4, 0x0f, 0xe8, 0x30, 0xe4, 0x13, 0xab, 0x24, 0xd4, 0x4d, 0x0d, 0xbb,
+	0xd5, 0x54, 0xc0, 0xf4, 0xb9, 0xe5, 0xc0, 0x8d, 0x79, 0x14, 0x32, 0x11, 0xad, 0xdf, 0x0d, 0xa8,
+	0xf4, 0x18, 0x79, 0x4a, 0x39, 0x36, 0xef, 0xcc, 0x11, 0xd4, 0xdd, 0xfc, 0xef, 0x6d, 0x63, 0xd6,
+	0x3c, 0xab, 0xd0, 0x74, 0xe1, 0xca, 0x98, 0x72, 0x3c, 0xb4, 0x4a, 0x0b, 0x7a, 0x23, 0x61, 0x66,
+	0x07, 0x56, 0x68, 0xc2, 0x43, 0x1a, 0x8b, 0x66, 0x56, 0xa7, 0xf7, 0x41, 0xce, 0x8f, 0x9b, 0xd2,
+	0xf8, 0x4a, 0x00, 0x7c, 0x05, 0x3c, 0xaf, 0x97, 0xbb, 0x90, 0x8a, 0x95, 0xa9, 0x5b, 0x5b, 0xb0,
+	0xa9, 0x74, 0x68, 0x6d, 0x6f, 0x0c, 0x6d, 0xfb, 0x16, 0x87, 0xe4, 0x80, 0xe3, 0xe0, 0x1d, 0x68,
+	0xbc, 0x07, 0x15, 0x49, 0x9d, 0x59, 0xcb, 0xe2, 0xd2, 0xdf, 0xcc, 0x89, 0xcc, 0xb8, 0xcc, 0x88,
+	0xcd, 0x22, 0x2e, 0xac, 0xb6, 0x0e, 0xd7, 0x73, 0xca, 0xb4, 0xea, 0x5f, 0x0d, 0x80, 0x1e, 0x23,
+	0xd9, 0x04, 0x5d, 0x5e, 0xf0, 0xc7, 0xb0, 0xa6, 0xa6, 0x96, 0x2e, 0x16, 0x3d, 0x85, 0x9a, 0x9f,
+	0xc0, 0x0a, 0x8a, 0xe8, 0x28, 0xe6, 0x4a, 0xf7, 0xc2, 0x61, 0x57, 0x70, 0x75, 0x67, 0x75, 0xa2,
+	0x56, 0x0d, 0xcc, 0xa9, 0x00, 0xad, 0xeb, 0xb9, 0xec, 0xe6, 0x37, 0x49, 0x80, 0x38, 0x7e, 0x8c,
+	0x86, 0x28, 0x62, 0x29, 0xd5, 0xe9, 0x2c, 0x18, 0x8b, 0xa8, 0x6a, 0xa8, 0x79, 0x17, 0x56, 0x12,
+	0x91, 0x41, 0xe8, 0x5b, 0xdf, 0x79, 0x2f, 0xd7, 0x22, 0x99, 0x3e, 0xa3, 0x29, 0xa1, 0x85, 0xd1,
+	0x92, 0x3d, 0x98, 0xe5, 0xa3, 0xb9, 0xfe, 0x6c, 0x88, 0x45, 0xba, 0x87, 0xe2, 0x01, 0x3e, 0x9c,
+	0x59, 0xa4, 0x97, 0x6d, 0xc5, 0xec, 0xfa, 0x2b, 0x5d, 0x74, 0xfd, 0xe5, 0x97, 0xd8, 0x6f, 0x06,
+	0xd4, 0x0b, 0x64, 0xf4, 0x16, 0xbb, 0x3c, 0xa9, 0x47, 0xb0, 0x31, 0x10, 0xb9, 0x70, 0xb0, 0x9f,
+	0xbe, 0x5a, 0x54, 0x0d, 0xed, 0xc2, 0x0e, 0xfb, 0x3a, 0x7b, 0xef, 0x74, 0x57, 0xd3, 0x42, 0xbe,
+	0xf8, 0xab, 0x61, 0xf8, 0x57, 0xb3, 0xd0, 0xd4, 0x69, 0x7e, 0x00, 0x9b, 0x3a, 0xd5, 0x81, 0xb8,
+	0xc8, 0x62, 0x31, 0x94, 0xfd, 0x6a, 0x66, 0x7e, 0x28, 0xac, 0x3b, 0xff, 0x94, 0x61, 0xb9, 0xc7,
+	0x88, 0xf9, 0x0c, 0xaa, 0xb9, 0xb7, 0x53, 0x33, 0xd7, 0xba, 0xc2, 0xbe, 0xb6, 0xdb, 0x8b, 0x10,
+	0xba, 0x16, 0x18, 0xb6, 0x8a, 0xcb, 0xfa, 0xfd, 0x62, 0x78, 0x01, 0x64, 0xdf, 0xbe, 0x00, 0x48,
+	0x1f, 0xf3, 0x19, 0x94, 0xc5, 0xbe, 0xbd, 0x56, 0x0c, 0x4a, 0xed, 0xb6, 0x33, 0xdf, 0xae, 0xe3,
+	0x9f, 0xc2, 0xd5, 0x53, 0x3b, 0xed, 0x0c, 0x7c, 0xe6, 0xb7, 0x6f, 0x9d, 0xef, 0xd7, 0x79, 0xbf,
+	0x80, 0x4a, 0xb6, 0x35, 0xea, 0xc5, 0x10, 0xe5, 0xb2, 0x6f, 0x9e, 0xe9, 0x9a, 0x25, 0x78, 0x6a,
+	0x4c, 0xe7, 0x10, 0x9c, 0xf5, 0xdb, 0xb7, 0xce, 0xf7, 0xeb, 0xbc, 0xcf, 0xa0, 0x9a, 0x1b, 0xa9,
+	0x39, 0xdd, 0x3f, 0x8d, 0xb0, 0xdb, 0x8b, 0x10, 0x59, 0xf6, 0xee, 0x83, 0x57, 0xc7, 0x8e, 0xf1,
+	0xfa, 0xd8, 0x31, 0xfe, 0x3e, 0x76, 0x8c, 0x17, 0x27, 0xce, 0xd2, 0xeb, 0x13, 0x67, 0xe9, 0xcd,
+	0x89, 0xb3, 0xf4, 0xdd, 0x6d, 0x12, 0xf2, 0x83, 0x51, 0xdf, 0x1d, 0xd0, 0x48, 0x7d, 0x85, 0xa9,
+	0x9f, 0x6d, 0x16, 0xfc, 0xe0, 0x1d, 0x89, 0xcf, 0x39, 0x3e, 0x49, 0x30, 0x4b, 0xbf, 0xf9, 0x56,
+	0xc4, 0xfd, 0xbf, 0xfb, 0xff, 0x00, 0x72, 0x77, 0xf1, 0xaf, 0x33, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -749,6 +891,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelProposal defines a method to cancel a proposal by its proposer
+	// before the end of its voting period.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 }
 
 type msgClient struct {
@@ -813,6 +960,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -831,6 +987,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelProposal defines a method to cancel a proposal by its proposer
+	// before the end of its voting period.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -855,6 +1016,9 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -968,6 +1132,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -996,6 +1178,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1021,6 +1207,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1443,6 +1639,82 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanceledHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanceledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1480,6 +1752,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1643,6 +1918,39 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CanceledHeight != 0 {
+		n += 1 + sovTx(uint64(m.CanceledHeight))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1810,6 +2118,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2837,6 +3165,228 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CanceledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledHeight", wireType)
+			}
+			m.CanceledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanceledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
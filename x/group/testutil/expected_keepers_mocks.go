@@ -172,6 +172,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomPolicies mocks base method.
+func (m *MockBankKeeper) SetDenomPolicies(arg0 context.Context, arg1 *types1.MsgSetDenomPolicies) (*types1.MsgSetDenomPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*types1.MsgSetDenomPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomPolicies indicates an expected call of SetDenomPolicies.
+func (mr *MockBankKeeperMockRecorder) SetDenomPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomPolicies", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomPolicies), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types1.MsgSetSendEnabled) (*types1.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
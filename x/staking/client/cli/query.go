@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryDelegatorPendingEpochMsgs(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
@@ -631,6 +632,52 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// GetCmdQueryDelegatorPendingEpochMsgs implements the command to query the
+// staking messages of a delegator queued on the current epoch.
+func GetCmdQueryDelegatorPendingEpochMsgs() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pending-epoch-msgs [delegator-addr]",
+		Short: "Query the staking messages of a delegator queued on the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations, undelegations, redelegations and validator edits of a delegator queued for execution at the end of the current epoch.
+
+Example:
+$ %s query staking pending-epoch-msgs %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorPendingEpochMsgsRequest{DelegatorAddr: delAddr.String()}
+
+			res, err := queryClient.DelegatorPendingEpochMsgs(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHistoricalInfo implements the historical info query command
 func GetCmdQueryHistoricalInfo() *cobra.Command {
 	cmd := &cobra.Command{
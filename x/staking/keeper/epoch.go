@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// queuesEpochMsgs returns true if the staking messages handled in the given
+// context must be queued on the current epoch rather than executed.
+func (k Keeper) queuesEpochMsgs(ctx sdk.Context) bool {
+	return k.epochingKeeper != nil && !epochingtypes.IsQueuedExecution(ctx)
+}
+
+// executesEpochMsgs returns true if the staking messages handled in the given
+// context are executed at the end of an epoch, after having been queued.
+func (k Keeper) executesEpochMsgs(ctx sdk.Context) bool {
+	return k.epochingKeeper != nil && epochingtypes.IsQueuedExecution(ctx)
+}
+
+// queueEpochMsg queues a staking message on the current epoch.
+func (k Keeper) queueEpochMsg(ctx sdk.Context, msg sdk.Msg, sender string) error {
+	actionID, err := k.epochingKeeper.QueueMsg(ctx, k.epochIdentifier, msg)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueEpochMsg,
+			sdk.NewAttribute(types.AttributeKeyEpochActionID, strconv.FormatUint(actionID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+
+	return nil
+}
+
+// GetEpochDelegationLock returns the tokens of the given denom locked by the
+// delegations of a delegator queued on the current epoch.
+func (k Keeper) GetEpochDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetEpochDelegationLockKey(delAddr, denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetEpochDelegationLock sets the tokens of the given denom locked by the
+// delegations of a delegator queued on the current epoch.
+func (k Keeper) SetEpochDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetEpochDelegationLockKey(delAddr, amount.Denom)
+
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&sdk.IntProto{Int: amount.Amount}))
+}
+
+// IterateEpochDelegationLocks iterates over the tokens locked by the
+// delegations queued on the current epoch.
+func (k Keeper) IterateEpochDelegationLocks(ctx sdk.Context, cb func(delAddr sdk.AccAddress, amount sdk.Coin) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochDelegationLockKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, denom := types.ParseEpochDelegationLockKey(iterator.Key())
+
+		ip := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &ip)
+
+		if cb(delAddr, sdk.NewCoin(denom, ip.Int)) {
+			break
+		}
+	}
+}
+
+// lockEpochDelegation moves the tokens of a queued delegation from the
+// delegator account to the epoch delegation pool. The tokens are delegated,
+// rather than sent, so that vesting accounts can delegate their locked tokens.
+func (k Keeper) lockEpochDelegation(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.EpochDelegationPoolName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	locked := k.GetEpochDelegationLock(ctx, delAddr, amount.Denom)
+	k.SetEpochDelegationLock(ctx, delAddr, sdk.NewCoin(amount.Denom, locked.Add(amount.Amount)))
+
+	return nil
+}
+
+// unlockEpochDelegation moves the tokens of a queued delegation from the epoch
+// delegation pool back to the delegator account.
+func (k Keeper) unlockEpochDelegation(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) error {
+	locked := k.GetEpochDelegationLock(ctx, delAddr, amount.Denom)
+	if locked.LT(amount.Amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientEpochLockedTokens, "%s%s locked, %s required", locked, amount.Denom, amount)
+	}
+
+	k.SetEpochDelegationLock(ctx, delAddr, sdk.NewCoin(amount.Denom, locked.Sub(amount.Amount)))
+
+	return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.EpochDelegationPoolName, delAddr, sdk.NewCoins(amount))
+}
+
+// refundEpochDelegations returns the tokens still locked at the end of an
+// epoch, i.e. the ones of the queued delegations that failed, to their
+// delegators.
+func (k Keeper) refundEpochDelegations(ctx sdk.Context) error {
+	type lock struct {
+		delAddr sdk.AccAddress
+		amount  sdk.Coin
+	}
+
+	var locks []lock
+	k.IterateEpochDelegationLocks(ctx, func(delAddr sdk.AccAddress, amount sdk.Coin) bool {
+		locks = append(locks, lock{delAddr: delAddr, amount: amount})
+		return false
+	})
+
+	for _, l := range locks {
+		if err := k.unlockEpochDelegation(ctx, l.delAddr, l.amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pendingEpochUnbonds returns the tokens a delegator has queued for unbonding
+// or redelegation from a validator on the current epoch.
+func (k Keeper) pendingEpochUnbonds(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) math.Int {
+	total := math.ZeroInt()
+	k.epochingKeeper.IteratePendingActions(ctx, k.epochIdentifier, func(action epochingtypes.QueuedAction) bool {
+		msg, err := action.GetMsg()
+		if err != nil {
+			return false
+		}
+
+		switch msg := msg.(type) {
+		case *types.MsgUndelegate:
+			if msg.DelegatorAddress == delAddr.String() && msg.ValidatorAddress == valAddr.String() {
+				total = total.Add(msg.Amount.Amount)
+			}
+		case *types.MsgBeginRedelegate:
+			if msg.DelegatorAddress == delAddr.String() && msg.ValidatorSrcAddress == valAddr.String() {
+				total = total.Add(msg.Amount.Amount)
+			}
+		}
+
+		return false
+	})
+
+	return total
+}
+
+// unbondAmountWithPending returns the tokens to check against the delegation of
+// a delegator to a validator when unbonding or redelegating them: the ones
+// already queued from the delegation are added when queuing a new message.
+func (k Keeper) unbondAmountWithPending(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) math.Int {
+	if !k.queuesEpochMsgs(ctx) {
+		return amt
+	}
+
+	return amt.Add(k.pendingEpochUnbonds(ctx, delAddr, valAddr))
+}
+
+// GetDelegatorPendingEpochMsgs returns the actions of the staking messages of
+// a delegator queued on the current epoch, in execution order. The edits of
+// the validator operated by the delegator are included.
+func (k Keeper) GetDelegatorPendingEpochMsgs(ctx sdk.Context, delAddr sdk.AccAddress) []epochingtypes.QueuedAction {
+	actions := []epochingtypes.QueuedAction{}
+	if k.epochingKeeper == nil {
+		return actions
+	}
+
+	delegator := delAddr.String()
+	operator := sdk.ValAddress(delAddr).String()
+	k.epochingKeeper.IteratePendingActions(ctx, k.epochIdentifier, func(action epochingtypes.QueuedAction) bool {
+		msg, err := action.GetMsg()
+		if err != nil {
+			return false
+		}
+
+		var match bool
+		switch msg := msg.(type) {
+		case *types.MsgDelegate:
+			match = msg.DelegatorAddress == delegator
+		case *types.MsgUndelegate:
+			match = msg.DelegatorAddress == delegator
+		case *types.MsgBeginRedelegate:
+			match = msg.DelegatorAddress == delegator
+		case *types.MsgEditValidator:
+			match = msg.ValidatorAddress == operator
+		}
+
+		if match {
+			actions = append(actions, action)
+		}
+
+		return false
+	})
+
+	return actions
+}
+
+// EpochHooks returns the epoching hooks of the staking module, which refund
+// the tokens locked by the queued delegations that failed at the end of each
+// epoch. They must be registered on the epoching keeper when staking messages
+// are deferred to epochs.
+func (k *Keeper) EpochHooks() epochingtypes.EpochHooks {
+	return epochHooks{k: k}
+}
+
+type epochHooks struct {
+	k *Keeper
+}
+
+var _ epochingtypes.EpochHooks = epochHooks{}
+
+func (h epochHooks) BeforeEpochStart(_ sdk.Context, _ string, _ uint64) error {
+	return nil
+}
+
+func (h epochHooks) AfterEpochEnd(ctx sdk.Context, identifier string, _ uint64) error {
+	if h.k.epochingKeeper == nil || identifier != h.k.epochIdentifier {
+		return nil
+	}
+
+	return h.k.refundEpochDelegations(ctx)
+}
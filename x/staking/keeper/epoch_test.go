@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const epochIdentifier = "day"
+
+var epochDelegationAcc = authtypes.NewEmptyModuleAccount(stakingtypes.EpochDelegationPoolName)
+
+// enableEpochs defers the staking messages to a mocked epoching keeper, and
+// returns the actions it queues.
+func (s *KeeperTestSuite) enableEpochs() *[]epochingtypes.QueuedAction {
+	ctrl := gomock.NewController(s.T())
+	epochingKeeper := stakingtestutil.NewMockEpochingKeeper(ctrl)
+
+	queue := &[]epochingtypes.QueuedAction{}
+	epochingKeeper.EXPECT().QueueMsg(gomock.Any(), epochIdentifier, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, identifier string, msg sdk.Msg) (uint64, error) {
+			action, err := epochingtypes.NewQueuedAction(uint64(len(*queue)+1), identifier, 1, msg)
+			if err != nil {
+				return 0, err
+			}
+
+			*queue = append(*queue, action)
+			return action.Id, nil
+		},
+	).AnyTimes()
+	epochingKeeper.EXPECT().IteratePendingActions(gomock.Any(), epochIdentifier, gomock.Any()).Do(
+		func(_ sdk.Context, _ string, cb func(epochingtypes.QueuedAction) bool) {
+			for _, action := range *queue {
+				if cb(action) {
+					return
+				}
+			}
+		},
+	).AnyTimes()
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.EpochDelegationPoolName).Return(epochDelegationAcc.GetAddress())
+	s.stakingKeeper.SetEpochingKeeper(epochingKeeper, epochIdentifier)
+
+	return queue
+}
+
+func (s *KeeperTestSuite) TestEpochDelegate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	queue := s.enableEpochs()
+
+	addrDels, addrVals := createValAddrs(1)
+	keeper.SetValidator(ctx, teststaking.NewValidator(s.T(), addrVals[0], PKs[0]))
+
+	amount := sdk.NewCoin(keeper.BondDenom(ctx), keeper.TokensFromConsensusPower(ctx, 10))
+	msg := stakingtypes.NewMsgDelegate(addrDels[0], addrVals[0], amount)
+
+	// the delegated tokens are locked and the delegation queued
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[0], stakingtypes.EpochDelegationPoolName, sdk.NewCoins(amount))
+	_, err := msgServer.Delegate(ctx, msg)
+	require.NoError(err)
+	require.Len(*queue, 1)
+	require.Equal(amount.Amount, keeper.GetEpochDelegationLock(ctx, addrDels[0], amount.Denom))
+	_, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(found)
+
+	res, err := s.queryClient.DelegatorPendingEpochMsgs(ctx, &stakingtypes.QueryDelegatorPendingEpochMsgsRequest{DelegatorAddr: addrDels[0].String()})
+	require.NoError(err)
+	require.Len(res.Actions, 1)
+
+	// the tokens are unlocked and delegated when the message is executed
+	execCtx := epochingtypes.WithQueuedExecution(ctx)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.EpochDelegationPoolName, addrDels[0], sdk.NewCoins(amount))
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[0], stakingtypes.NotBondedPoolName, sdk.NewCoins(amount))
+	_, err = msgServer.Delegate(execCtx, msg)
+	require.NoError(err)
+	require.True(keeper.GetEpochDelegationLock(ctx, addrDels[0], amount.Denom).IsZero())
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(found)
+	require.Equal(amount.Amount, delegation.Shares.RoundInt())
+
+	// a delegation cannot be executed without locked tokens
+	_, err = msgServer.Delegate(execCtx, msg)
+	require.ErrorIs(err, stakingtypes.ErrInsufficientEpochLockedTokens)
+}
+
+func (s *KeeperTestSuite) TestEpochDelegationRefund() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.enableEpochs()
+
+	addrDels, addrVals := createValAddrs(1)
+	keeper.SetValidator(ctx, teststaking.NewValidator(s.T(), addrVals[0], PKs[0]))
+
+	amount := sdk.NewCoin(keeper.BondDenom(ctx), keeper.TokensFromConsensusPower(ctx, 10))
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[0], stakingtypes.EpochDelegationPoolName, sdk.NewCoins(amount)).Times(2)
+	_, err := msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrDels[0], addrVals[0], amount))
+	require.NoError(err)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrDels[0], addrVals[0], amount))
+	require.NoError(err)
+	require.Equal(amount.Amount.MulRaw(2), keeper.GetEpochDelegationLock(ctx, addrDels[0], amount.Denom))
+
+	// the end of other epochs does not refund the locked tokens
+	hooks := keeper.EpochHooks()
+	require.NoError(hooks.AfterEpochEnd(ctx, "week", 1))
+	require.Equal(amount.Amount.MulRaw(2), keeper.GetEpochDelegationLock(ctx, addrDels[0], amount.Denom))
+
+	// the tokens of the delegations that were not executed are refunded
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.EpochDelegationPoolName, addrDels[0], sdk.NewCoins(amount.AddAmount(amount.Amount)))
+	require.NoError(hooks.AfterEpochEnd(ctx, epochIdentifier, 1))
+	require.True(keeper.GetEpochDelegationLock(ctx, addrDels[0], amount.Denom).IsZero())
+}
+
+func (s *KeeperTestSuite) TestEpochUnbond() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	queue := s.enableEpochs()
+
+	addrDels, addrVals := createValAddrs(2)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := teststaking.NewValidator(s.T(), addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	bondDenom := keeper.BondDenom(ctx)
+	amount := sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 6))
+
+	_, err := msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addrDels[0], addrVals[0], amount))
+	require.NoError(err)
+	require.Len(*queue, 1)
+
+	// the queued unbondings are accounted for
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addrDels[0], addrVals[0], amount))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(addrDels[0], addrVals[0], addrVals[1], amount))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the redelegations are checked when queued
+	amount = sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 2))
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(addrDels[0], addrVals[0], addrVals[0], amount))
+	require.ErrorIs(err, stakingtypes.ErrSelfRedelegation)
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(addrDels[0], addrVals[0], addrVals[1], amount))
+	require.ErrorIs(err, stakingtypes.ErrBadRedelegationDst)
+
+	keeper.SetValidator(ctx, teststaking.NewValidator(s.T(), addrVals[1], PKs[1]))
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(addrDels[0], addrVals[0], addrVals[1], amount))
+	require.NoError(err)
+	require.Len(*queue, 2)
+
+	// nothing is unbonded until the messages are executed
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(found)
+	require.Equal(issuedShares, delegation.Shares)
+
+	res, err := s.queryClient.DelegatorPendingEpochMsgs(ctx, &stakingtypes.QueryDelegatorPendingEpochMsgsRequest{DelegatorAddr: addrDels[0].String()})
+	require.NoError(err)
+	require.Len(res.Actions, 2)
+	for i, action := range res.Actions {
+		require.Equal((*queue)[i].Id, action.Id)
+	}
+
+	res, err = s.queryClient.DelegatorPendingEpochMsgs(ctx, &stakingtypes.QueryDelegatorPendingEpochMsgsRequest{DelegatorAddr: addrDels[1].String()})
+	require.NoError(err)
+	require.Empty(res.Actions)
+}
+
+func (s *KeeperTestSuite) TestEpochEditValidator() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	queue := s.enableEpochs()
+
+	_, addrVals := createValAddrs(1)
+	validator := teststaking.NewValidator(s.T(), addrVals[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	description := stakingtypes.NewDescription("moniker", "", "", "", "")
+	msg := stakingtypes.NewMsgEditValidator(addrVals[0], description, nil, nil)
+
+	_, err := msgServer.EditValidator(ctx, msg)
+	require.NoError(err)
+	require.Len(*queue, 1)
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	require.NotEqual("moniker", validator.Description.Moniker)
+
+	// the operator account sees the edit as pending
+	res, err := s.queryClient.DelegatorPendingEpochMsgs(ctx, &stakingtypes.QueryDelegatorPendingEpochMsgsRequest{DelegatorAddr: sdk.AccAddress(addrVals[0]).String()})
+	require.NoError(err)
+	require.Len(res.Actions, 1)
+
+	_, err = msgServer.EditValidator(epochingtypes.WithQueuedExecution(ctx), msg)
+	require.NoError(err)
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	require.Equal("moniker", validator.Description.Moniker)
+}
@@ -316,6 +316,25 @@ func (k Querier) DelegatorValidator(c context.Context, req *types.QueryDelegator
 	return &types.QueryDelegatorValidatorResponse{Validator: validator}, nil
 }
 
+// DelegatorPendingEpochMsgs queries the staking messages of a delegator queued for execution at the end of the current epoch
+func (k Querier) DelegatorPendingEpochMsgs(c context.Context, req *types.QueryDelegatorPendingEpochMsgsRequest) (*types.QueryDelegatorPendingEpochMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorPendingEpochMsgsResponse{Actions: k.GetDelegatorPendingEpochMsgs(ctx, delAddr)}, nil
+}
+
 // DelegatorUnbondingDelegations queries all unbonding delegations of a given delegator address
 func (k Querier) DelegatorUnbondingDelegations(c context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest) (*types.QueryDelegatorUnbondingDelegationsResponse, error) {
 	if req == nil {
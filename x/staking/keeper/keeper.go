@@ -27,6 +27,11 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	authority  string
+
+	// epochingKeeper and epochIdentifier are set when staking messages are
+	// deferred to the end of the current epoch
+	epochingKeeper  types.EpochingKeeper
+	epochIdentifier string
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -75,6 +80,28 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetEpochingKeeper defers the delegations, undelegations, redelegations and
+// validator edits to the end of the current epoch of the given identifier, so
+// that the validator set only changes once per epoch. The messages are checked
+// against the current state when submitted, the delegated tokens being locked
+// in the epoch delegation pool until the end of the epoch.
+//
+// The epoch delegation pool module account must be set with the Staking
+// permission, and the hooks returned by EpochHooks must be registered on the
+// epoching keeper for the tokens locked by failing delegations to be refunded.
+func (k *Keeper) SetEpochingKeeper(ek types.EpochingKeeper, epochIdentifier string) {
+	if k.epochingKeeper != nil {
+		panic("cannot set epoching keeper twice")
+	}
+
+	if addr := k.authKeeper.GetModuleAddress(types.EpochDelegationPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EpochDelegationPoolName))
+	}
+
+	k.epochingKeeper = ek
+	k.epochIdentifier = epochIdentifier
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
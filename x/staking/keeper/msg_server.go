@@ -170,8 +170,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		}
 
 		// call the before-modification hook since we're about to update the commission
-		if err := k.BeforeValidatorModified(ctx, valAddr); err != nil {
-			return nil, err
+		if !k.queuesEpochMsgs(ctx) {
+			if err := k.BeforeValidatorModified(ctx, valAddr); err != nil {
+				return nil, err
+			}
 		}
 
 		validator.Commission = commission
@@ -189,6 +191,15 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.MinSelfDelegation = *msg.MinSelfDelegation
 	}
 
+	// the edit is applied, and checked again, at the end of the epoch
+	if k.queuesEpochMsgs(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, msg.ValidatorAddress); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgEditValidatorResponse{}, nil
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -232,6 +243,30 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
+	// the delegated tokens are locked until the delegation is executed at the
+	// end of the epoch
+	if k.queuesEpochMsgs(ctx) {
+		if validator.InvalidExRate() {
+			return nil, types.ErrDelegatorShareExRateInvalid
+		}
+
+		if err := k.lockEpochDelegation(ctx, delegatorAddress, msg.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.queueEpochMsg(ctx, msg, msg.DelegatorAddress); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgDelegateResponse{}, nil
+	}
+
+	if k.executesEpochMsgs(ctx) {
+		if err := k.unlockEpochDelegation(ctx, delegatorAddress, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
@@ -278,7 +313,7 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
+		ctx, delegatorAddress, valSrcAddr, k.unbondAmountWithPending(ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount),
 	)
 	if err != nil {
 		return nil, err
@@ -296,6 +331,24 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// the redelegation is checked again at the end of the epoch, the
+	// completion time only being known then
+	if k.queuesEpochMsgs(ctx) {
+		if valSrcAddr.Equals(valDstAddr) {
+			return nil, types.ErrSelfRedelegation
+		}
+
+		if _, found := k.GetValidator(ctx, valDstAddr); !found {
+			return nil, types.ErrBadRedelegationDst
+		}
+
+		if err := k.queueEpochMsg(ctx, msg, msg.DelegatorAddress); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgBeginRedelegateResponse{}, nil
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -347,7 +400,7 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, addr, msg.Amount.Amount,
+		ctx, delegatorAddress, addr, k.unbondAmountWithPending(ctx, delegatorAddress, addr, msg.Amount.Amount),
 	)
 	if err != nil {
 		return nil, err
@@ -360,6 +413,16 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	// the unbonding is checked again at the end of the epoch, the completion
+	// time only being known then
+	if k.queuesEpochMsgs(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, msg.DelegatorAddress); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgUndelegateResponse{}, nil
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -33,7 +34,8 @@ func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens math.Int) {
 	}
 }
 
-// burnBondedTokens removes coins from the bonded pool module account
+// burnBondedTokens removes coins from the bonded pool module account. Slashing
+// cannot fail, so the burn is not subject to the DenomPolicy of the bond denom.
 func (k Keeper) burnBondedTokens(ctx sdk.Context, amt math.Int) error {
 	if !amt.IsPositive() {
 		// skip as no coins need to be burned
@@ -42,10 +44,12 @@ func (k Keeper) burnBondedTokens(ctx sdk.Context, amt math.Int) error {
 
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
 
-	return k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
+	return k.bankKeeper.BurnCoins(banktypes.WithDenomPolicyBypass(ctx), types.BondedPoolName, coins)
 }
 
-// burnNotBondedTokens removes coins from the not bonded pool module account
+// burnNotBondedTokens removes coins from the not bonded pool module account.
+// Slashing cannot fail, so the burn is not subject to the DenomPolicy of the
+// bond denom.
 func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt math.Int) error {
 	if !amt.IsPositive() {
 		// skip as no coins need to be burned
@@ -54,7 +58,7 @@ func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt math.Int) error {
 
 	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
 
-	return k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
+	return k.bankKeeper.BurnCoins(banktypes.WithDenomPolicyBypass(ctx), types.NotBondedPoolName, coins)
 }
 
 // TotalBondedTokens total staking tokens supply which is bonded
@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 
@@ -187,7 +188,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(provideModuleBasic, provideModule),
-		appmodule.Invoke(invokeSetStakingHooks),
+		appmodule.Invoke(invokeSetStakingHooks, invokeSetEpochingKeeper),
 	)
 }
 
@@ -215,6 +216,7 @@ type stakingOutputs struct {
 
 	StakingKeeper *keeper.Keeper
 	Module        runtime.AppModuleWrapper
+	EpochHooks    epochingtypes.EpochHooksWrapper
 }
 
 func provideModule(in stakingInputs) stakingOutputs {
@@ -232,7 +234,11 @@ func provideModule(in stakingInputs) stakingOutputs {
 		authority.String(),
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
-	return stakingOutputs{StakingKeeper: k, Module: runtime.WrapAppModule(m)}
+	return stakingOutputs{
+		StakingKeeper: k,
+		Module:        runtime.WrapAppModule(m),
+		EpochHooks:    epochingtypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
+	}
 }
 
 func invokeSetStakingHooks(
@@ -274,6 +280,26 @@ func invokeSetStakingHooks(
 	return nil
 }
 
+// invokeSetEpochingKeeper defers the staking messages to the end of the epochs
+// of the configured identifier, if any.
+func invokeSetEpochingKeeper(
+	config *modulev1.Module,
+	keeper *keeper.Keeper,
+	epochingKeeper types.EpochingKeeper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || config == nil || config.EpochIdentifier == "" {
+		return nil
+	}
+
+	if epochingKeeper == nil {
+		return fmt.Errorf("the epoching module is required to defer staking messages to the %s epochs", config.EpochIdentifier)
+	}
+
+	keeper.SetEpochingKeeper(epochingKeeper, config.EpochIdentifier)
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the staking module.
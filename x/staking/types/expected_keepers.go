@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
 // DistributionKeeper expected distribution keeper (noalias)
@@ -41,6 +42,13 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// EpochingKeeper defines the expected epoching keeper, used to defer staking
+// messages to the end of the current epoch (noalias)
+type EpochingKeeper interface {
+	QueueMsg(ctx sdk.Context, identifier string, msg sdk.Msg) (uint64, error)
+	IteratePendingActions(ctx sdk.Context, identifier string, cb func(action epochingtypes.QueuedAction) (stop bool))
+}
+
 // ValidatorSet expected properties for the set of all validators (noalias)
 type ValidatorSet interface {
 	// iterate through validators by operator address, execute func for each validator
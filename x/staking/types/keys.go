@@ -49,6 +49,8 @@ var (
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	EpochDelegationLockKey = []byte{0x61} // prefix for the tokens locked by the delegations queued on the current epoch
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +350,26 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetEpochDelegationLocksKey creates the prefix for the tokens of a delegator
+// locked by the delegations queued on the current epoch.
+func GetEpochDelegationLocksKey(delAddr sdk.AccAddress) []byte {
+	return append(EpochDelegationLockKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetEpochDelegationLockKey creates the key for the tokens of the given denom
+// of a delegator locked by the delegations queued on the current epoch.
+// VALUE: sdk.IntProto
+func GetEpochDelegationLockKey(delAddr sdk.AccAddress, denom string) []byte {
+	return append(GetEpochDelegationLocksKey(delAddr), []byte(denom)...)
+}
+
+// ParseEpochDelegationLockKey returns the delegator address and the denom
+// from an EpochDelegationLockKey.
+func ParseEpochDelegationLockKey(key []byte) (sdk.AccAddress, string) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := key[1]
+	kv.AssertKeyAtLeastLength(key, 2+int(addrLen))
+
+	return sdk.AccAddress(key[2 : 2+addrLen]), string(key[2+addrLen:])
+}
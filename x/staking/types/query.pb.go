@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1130,6 +1131,96 @@ func (m *QueryDelegatorValidatorResponse) GetValidator() Validator {
 	return Validator{}
 }
 
+// QueryDelegatorPendingEpochMsgsRequest is request type for the
+// Query/DelegatorPendingEpochMsgs RPC method.
+type QueryDelegatorPendingEpochMsgsRequest struct {
+	// delegator_addr defines the delegator address to query for.
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+}
+
+func (m *QueryDelegatorPendingEpochMsgsRequest) Reset()         { *m = QueryDelegatorPendingEpochMsgsRequest{} }
+func (m *QueryDelegatorPendingEpochMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingEpochMsgsRequest) ProtoMessage()    {}
+func (*QueryDelegatorPendingEpochMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{22}
+}
+func (m *QueryDelegatorPendingEpochMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPendingEpochMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPendingEpochMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorPendingEpochMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingEpochMsgsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorPendingEpochMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPendingEpochMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingEpochMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorPendingEpochMsgsRequest proto.InternalMessageInfo
+
+// QueryDelegatorPendingEpochMsgsResponse is response type for the
+// Query/DelegatorPendingEpochMsgs RPC method.
+type QueryDelegatorPendingEpochMsgsResponse struct {
+	// actions defines the queued actions of the delegator's staking messages,
+	// in execution order.
+	Actions []types.QueuedAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) Reset() {
+	*m = QueryDelegatorPendingEpochMsgsResponse{}
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPendingEpochMsgsResponse) ProtoMessage()    {}
+func (*QueryDelegatorPendingEpochMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{23}
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPendingEpochMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPendingEpochMsgsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPendingEpochMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorPendingEpochMsgsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) GetActions() []types.QueuedAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 // QueryHistoricalInfoRequest is request type for the Query/HistoricalInfo RPC
 // method.
 type QueryHistoricalInfoRequest struct {
@@ -1141,7 +1232,7 @@ func (m *QueryHistoricalInfoRequest) Reset()         { *m = QueryHistoricalInfoR
 func (m *QueryHistoricalInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoRequest) ProtoMessage()    {}
 func (*QueryHistoricalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{22}
+	return fileDescriptor_f270127f442bbcd8, []int{24}
 }
 func (m *QueryHistoricalInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1279,7 @@ func (m *QueryHistoricalInfoResponse) Reset()         { *m = QueryHistoricalInfo
 func (m *QueryHistoricalInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoResponse) ProtoMessage()    {}
 func (*QueryHistoricalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{23}
+	return fileDescriptor_f270127f442bbcd8, []int{25}
 }
 func (m *QueryHistoricalInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,7 +1323,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{24}
+	return fileDescriptor_f270127f442bbcd8, []int{26}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1362,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{25}
+	return fileDescriptor_f270127f442bbcd8, []int{27}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1406,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{26}
+	return fileDescriptor_f270127f442bbcd8, []int{28}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,7 +1445,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{27}
+	return fileDescriptor_f270127f442bbcd8, []int{29}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,6 +1504,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorValidatorRequest)(nil), "cosmos.staking.v1beta1.QueryDelegatorValidatorRequest")
 	proto.RegisterType((*QueryDelegatorValidatorResponse)(nil), "cosmos.staking.v1beta1.QueryDelegatorValidatorResponse")
+	proto.RegisterType((*QueryDelegatorPendingEpochMsgsRequest)(nil), "cosmos.staking.v1beta1.QueryDelegatorPendingEpochMsgsRequest")
+	proto.RegisterType((*QueryDelegatorPendingEpochMsgsResponse)(nil), "cosmos.staking.v1beta1.QueryDelegatorPendingEpochMsgsResponse")
 	proto.RegisterType((*QueryHistoricalInfoRequest)(nil), "cosmos.staking.v1beta1.QueryHistoricalInfoRequest")
 	proto.RegisterType((*QueryHistoricalInfoResponse)(nil), "cosmos.staking.v1beta1.QueryHistoricalInfoResponse")
 	proto.RegisterType((*QueryPoolRequest)(nil), "cosmos.staking.v1beta1.QueryPoolRequest")
@@ -1426,92 +1519,98 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1b, 0x55,
-	0x17, 0xf7, 0x4d, 0xf3, 0x45, 0x5f, 0x4f, 0xd5, 0xaa, 0x5c, 0xbb, 0x21, 0x9d, 0x04, 0x3b, 0x1d,
-	0x55, 0x25, 0x8f, 0xc6, 0x43, 0x92, 0x92, 0x86, 0x0a, 0x68, 0x13, 0x4a, 0x21, 0x0a, 0x82, 0xd4,
-	0x15, 0x11, 0x4f, 0x59, 0x63, 0xcf, 0x74, 0x3c, 0xaa, 0x33, 0xe3, 0xce, 0x9d, 0x44, 0x2d, 0x51,
-	0x36, 0x2c, 0x50, 0x57, 0x08, 0x89, 0x3d, 0xea, 0x82, 0x15, 0x14, 0xa9, 0x8b, 0x20, 0xb1, 0xea,
-	0x12, 0x75, 0x81, 0x50, 0x00, 0x21, 0xd1, 0x0d, 0xa0, 0x04, 0x89, 0xfe, 0x11, 0x08, 0x21, 0xcf,
-	0x9c, 0x79, 0x38, 0xf3, 0xb4, 0xe3, 0x48, 0xe9, 0x2a, 0xf6, 0x9d, 0xf3, 0xf8, 0xfd, 0xce, 0xe3,
-	0xce, 0x39, 0x0e, 0xf0, 0x55, 0x9d, 0xad, 0xe8, 0x4c, 0x60, 0xa6, 0x78, 0x43, 0xd5, 0x14, 0x61,
-	0x6d, 0xb2, 0x22, 0x9b, 0xe2, 0xa4, 0x70, 0x73, 0x55, 0x36, 0x6e, 0x17, 0x1b, 0x86, 0x6e, 0xea,
-	0xb4, 0xdf, 0x96, 0x29, 0xa2, 0x4c, 0x11, 0x65, 0xb8, 0x31, 0xd4, 0xad, 0x88, 0x4c, 0xb6, 0x15,
-	0x5c, 0xf5, 0x86, 0xa8, 0xa8, 0x9a, 0x68, 0xaa, 0xba, 0x66, 0xdb, 0xe0, 0x72, 0x8a, 0xae, 0xe8,
-	0xd6, 0x47, 0xa1, 0xf9, 0x09, 0x4f, 0x87, 0x14, 0x5d, 0x57, 0xea, 0xb2, 0x20, 0x36, 0x54, 0x41,
-	0xd4, 0x34, 0xdd, 0xb4, 0x54, 0x18, 0x3e, 0x3d, 0x1d, 0x81, 0xcd, 0xc1, 0x61, 0x4b, 0x9d, 0xb4,
-	0xa5, 0xca, 0xb6, 0x71, 0x84, 0x6a, 0x3f, 0x1a, 0x44, 0x03, 0x0e, 0x36, 0x3f, 0x2b, 0xfe, 0x16,
-	0xf4, 0x5f, 0x6d, 0x7e, 0x5d, 0x16, 0xeb, 0xaa, 0x24, 0x9a, 0xba, 0xc1, 0x4a, 0xf2, 0xcd, 0x55,
-	0x99, 0x99, 0xb4, 0x1f, 0xfa, 0x98, 0x29, 0x9a, 0xab, 0x6c, 0x80, 0x0c, 0x93, 0x91, 0xc3, 0x25,
-	0xfc, 0x46, 0xaf, 0x00, 0x78, 0xbc, 0x06, 0x7a, 0x86, 0xc9, 0xc8, 0x91, 0xa9, 0x33, 0x45, 0xf4,
-	0xd8, 0x0c, 0x42, 0xd1, 0xb6, 0x8f, 0x38, 0x8b, 0x4b, 0xa2, 0x22, 0xa3, 0xcd, 0x92, 0x4f, 0x93,
-	0xff, 0x9a, 0xc0, 0xd3, 0x01, 0xd7, 0xac, 0xa1, 0x6b, 0x4c, 0xa6, 0xaf, 0x01, 0xac, 0xb9, 0xa7,
-	0x03, 0x64, 0xf8, 0xd0, 0xc8, 0x91, 0xa9, 0x53, 0xc5, 0xf0, 0x04, 0x14, 0x5d, 0xfd, 0xf9, 0xde,
-	0x87, 0xbf, 0x17, 0x32, 0x25, 0x9f, 0x6a, 0xd3, 0x50, 0x00, 0xec, 0xb3, 0x89, 0x60, 0x6d, 0x14,
-	0x2d, 0x68, 0xdf, 0x81, 0x13, 0xad, 0x60, 0x9d, 0x30, 0x5d, 0x84, 0x63, 0xae, 0xbf, 0xb2, 0x28,
-	0x49, 0x86, 0x1d, 0xae, 0xf9, 0x81, 0x9f, 0x37, 0x27, 0x72, 0xe8, 0x68, 0x4e, 0x92, 0x0c, 0x99,
-	0xb1, 0x6b, 0xa6, 0xa1, 0x6a, 0x4a, 0xe9, 0xa8, 0x2b, 0xdf, 0x3c, 0xe7, 0xcb, 0xbb, 0x33, 0xe0,
-	0x46, 0xe1, 0x55, 0x38, 0xec, 0x8a, 0x5a, 0x56, 0xdb, 0x08, 0x82, 0xa7, 0xd9, 0x0c, 0xf4, 0x70,
-	0xab, 0x87, 0xcb, 0x72, 0x5d, 0x56, 0xec, 0x22, 0xeb, 0x16, 0x8d, 0xae, 0x95, 0xc5, 0x63, 0x02,
-	0xa7, 0x62, 0xd0, 0x62, 0x68, 0x3e, 0x82, 0x9c, 0xe4, 0x1e, 0x97, 0x0d, 0x3c, 0x76, 0x4a, 0x65,
-	0x2c, 0x2a, 0x4a, 0x9e, 0x29, 0xc7, 0xd2, 0xfc, 0x60, 0x33, 0x5c, 0x5f, 0xfd, 0x51, 0xc8, 0x06,
-	0x9f, 0xb1, 0x52, 0x56, 0x0a, 0x1e, 0x76, 0xaf, 0xa6, 0x36, 0x09, 0x8c, 0xb6, 0x52, 0x7d, 0x5b,
-	0xab, 0xe8, 0x9a, 0xa4, 0x6a, 0xca, 0x41, 0xce, 0xd0, 0x23, 0x02, 0x63, 0x69, 0x60, 0x63, 0xaa,
-	0x2a, 0x90, 0x5d, 0x75, 0x9e, 0x07, 0x32, 0x35, 0x1e, 0x95, 0xa9, 0x10, 0x93, 0x58, 0xd9, 0xd4,
-	0xb5, 0xb6, 0x0f, 0x29, 0xf9, 0x92, 0x60, 0x37, 0xfa, 0xab, 0xc1, 0x8d, 0x3f, 0x56, 0x43, 0xea,
-	0xf8, 0xbb, 0xf2, 0x56, 0xfc, 0x83, 0x09, 0xec, 0x69, 0x2b, 0x81, 0x17, 0xfe, 0x7f, 0xe7, 0x6e,
-	0x21, 0xf3, 0xf8, 0x6e, 0x21, 0xc3, 0xaf, 0xe1, 0xd5, 0x19, 0xac, 0x59, 0xfa, 0x3e, 0x64, 0x43,
-	0x3a, 0x03, 0xaf, 0x8f, 0x36, 0x1a, 0xa3, 0x44, 0x83, 0xb5, 0xcf, 0x7f, 0x43, 0xa0, 0x60, 0x39,
-	0x0e, 0x49, 0xcf, 0x41, 0x8c, 0xd3, 0x0a, 0xde, 0x7c, 0xa1, 0x70, 0x31, 0x60, 0x0b, 0xd0, 0x67,
-	0x57, 0x14, 0xc6, 0xa8, 0x83, 0x92, 0x44, 0x03, 0xfc, 0xb7, 0xce, 0x4d, 0x7b, 0xd9, 0x21, 0x14,
-	0xde, 0xc7, 0x7b, 0x8b, 0x4f, 0x97, 0xfa, 0xd8, 0x17, 0xa6, 0x9f, 0x9c, 0x3b, 0x37, 0x1c, 0x37,
-	0x06, 0xaa, 0xda, 0xb5, 0x3b, 0xd7, 0x8e, 0xda, 0xfe, 0x5e, 0xae, 0x0f, 0x9c, 0xcb, 0xd5, 0xe5,
-	0x94, 0x70, 0xb9, 0x1e, 0xb4, 0xa4, 0xb8, 0xd7, 0x6c, 0x02, 0x81, 0x27, 0xf1, 0x9a, 0x7d, 0xd0,
-	0x03, 0x27, 0x2d, 0x6e, 0x25, 0x59, 0xda, 0x97, 0x64, 0x50, 0x66, 0x54, 0xcb, 0x6d, 0xde, 0x22,
-	0xc7, 0x99, 0x51, 0x5d, 0xde, 0xf5, 0xc6, 0xa4, 0x12, 0x33, 0x77, 0xdb, 0x39, 0x94, 0x64, 0x47,
-	0x62, 0xe6, 0x72, 0xcc, 0x9b, 0xb7, 0xb7, 0x0b, 0xc5, 0xb1, 0x45, 0x80, 0x0b, 0x0b, 0x20, 0x16,
-	0x83, 0x0a, 0xfd, 0x86, 0x1c, 0xd3, 0xac, 0x67, 0xa3, 0xea, 0xc1, 0x6f, 0x6e, 0x57, 0xbb, 0x9e,
-	0x30, 0xe4, 0xfd, 0x9e, 0x86, 0x0a, 0xad, 0xf5, 0x1e, 0xdc, 0x49, 0x0e, 0x60, 0x9b, 0x6e, 0x06,
-	0xee, 0xfc, 0x27, 0x62, 0x9f, 0xb9, 0x47, 0x20, 0x1f, 0x01, 0xfb, 0x20, 0xbe, 0xc8, 0x6b, 0x91,
-	0xb5, 0xd1, 0xed, 0x6d, 0xe9, 0x1c, 0x36, 0xd6, 0xeb, 0x2a, 0x33, 0x75, 0x43, 0xad, 0x8a, 0xf5,
-	0x05, 0xed, 0xba, 0xee, 0x5b, 0x8a, 0x6b, 0xb2, 0xaa, 0xd4, 0x4c, 0xcb, 0xc3, 0xa1, 0x12, 0x7e,
-	0xe3, 0xdf, 0x85, 0xc1, 0x50, 0x2d, 0xc4, 0x76, 0x01, 0x7a, 0x6b, 0x2a, 0x33, 0x11, 0xd6, 0x99,
-	0x28, 0x58, 0xbb, 0xb4, 0x2d, 0x1d, 0x9e, 0xc2, 0x71, 0xcb, 0xf4, 0x92, 0xae, 0xd7, 0x11, 0x06,
-	0xbf, 0x08, 0x4f, 0xf9, 0xce, 0xd0, 0xc9, 0x0c, 0xf4, 0x36, 0x74, 0xbd, 0x8e, 0x4e, 0x86, 0xa2,
-	0x9c, 0x34, 0x75, 0x90, 0xb6, 0x25, 0xcf, 0xe7, 0x80, 0xda, 0xc6, 0x44, 0x43, 0x5c, 0x71, 0x5a,
-	0x8d, 0xbf, 0x06, 0xd9, 0x96, 0x53, 0x74, 0xf2, 0x22, 0xf4, 0x35, 0xac, 0x13, 0x74, 0x93, 0x8f,
-	0x74, 0x63, 0x49, 0x39, 0x03, 0x92, 0xad, 0x33, 0x75, 0xbf, 0x1f, 0xfe, 0x67, 0x59, 0xa5, 0x5f,
-	0x10, 0x00, 0xaf, 0x51, 0x68, 0x31, 0xca, 0x4c, 0xf8, 0x8f, 0x13, 0x9c, 0x90, 0x5a, 0x1e, 0x27,
-	0x57, 0xe1, 0xce, 0xdf, 0xf7, 0xc7, 0xc8, 0xc7, 0xbf, 0xfc, 0xf5, 0x79, 0xcf, 0x69, 0xca, 0x0b,
-	0x11, 0xbf, 0xa9, 0xf8, 0x3a, 0xed, 0x1e, 0x81, 0xc3, 0xae, 0x1d, 0x3a, 0x91, 0xce, 0x9f, 0x03,
-	0xaf, 0x98, 0x56, 0x1c, 0xd1, 0x5d, 0xf2, 0xd0, 0x3d, 0x4f, 0xa7, 0x93, 0xd1, 0x09, 0xeb, 0xad,
-	0x8d, 0xb5, 0x41, 0x1f, 0x11, 0xc8, 0x85, 0x6d, 0xcc, 0x74, 0x36, 0x1d, 0x94, 0xe0, 0x4c, 0xc4,
-	0xbd, 0xd0, 0x81, 0x26, 0xf2, 0x79, 0xc3, 0xe3, 0x33, 0x47, 0x2f, 0x76, 0xc0, 0x47, 0xf0, 0xbd,
-	0xd5, 0xe8, 0xbf, 0x04, 0x9e, 0x89, 0xdd, 0x35, 0xe9, 0x5c, 0x3a, 0xa8, 0x31, 0x13, 0x20, 0x37,
-	0xbf, 0x17, 0x13, 0x48, 0x7b, 0xd9, 0xa3, 0xbd, 0x48, 0x17, 0x3a, 0xa1, 0xed, 0x8d, 0x70, 0xfe,
-	0x00, 0xfc, 0x40, 0x00, 0x3c, 0x7f, 0x09, 0xcd, 0x12, 0xd8, 0xc8, 0x12, 0x9a, 0x25, 0x38, 0x9f,
-	0xf3, 0x1f, 0x7a, 0x3c, 0x4a, 0x74, 0x69, 0x8f, 0xe9, 0x13, 0xd6, 0x5b, 0xdf, 0x22, 0x1b, 0xf4,
-	0x1f, 0x02, 0xd9, 0x90, 0x38, 0xd2, 0xf3, 0xb1, 0x38, 0xa3, 0x57, 0x4e, 0x6e, 0xb6, 0x7d, 0x45,
-	0x64, 0x6a, 0x78, 0x4c, 0x15, 0x2a, 0x77, 0x9b, 0x69, 0x68, 0x3a, 0xe9, 0x8f, 0x04, 0x72, 0x61,
-	0x8b, 0x56, 0x42, 0xab, 0xc6, 0xec, 0x94, 0x09, 0xad, 0x1a, 0xb7, 0xd5, 0xf1, 0x73, 0x5e, 0x04,
-	0x66, 0xe8, 0xb9, 0xa8, 0x08, 0xc4, 0xe6, 0xb3, 0xd9, 0x9f, 0xb1, 0x4b, 0x4a, 0x42, 0x7f, 0xa6,
-	0xd9, 0xd0, 0x12, 0xfa, 0x33, 0xd5, 0x8e, 0x94, 0xb2, 0x3f, 0x5d, 0x7a, 0x29, 0x13, 0xca, 0xe8,
-	0xf7, 0x04, 0x8e, 0xb6, 0x0c, 0xe2, 0x74, 0x32, 0x16, 0x6d, 0xd8, 0xd6, 0xc3, 0x4d, 0xb5, 0xa3,
-	0x82, 0x84, 0xde, 0xf4, 0x08, 0xbd, 0x42, 0xe7, 0x3a, 0x21, 0x64, 0xb4, 0xc0, 0xfe, 0x95, 0x40,
-	0x36, 0x64, 0x8e, 0x4d, 0xe8, 0xcc, 0xe8, 0x81, 0x9d, 0x9b, 0x6d, 0x5f, 0x11, 0xa9, 0x2d, 0x7a,
-	0xd4, 0x2e, 0xd1, 0x97, 0x3b, 0xa1, 0xe6, 0x7b, 0x99, 0xef, 0x10, 0xa0, 0x41, 0x67, 0x74, 0xa6,
-	0x4d, 0x74, 0x0e, 0xab, 0xf3, 0x6d, 0xeb, 0x21, 0xa9, 0x0f, 0x3c, 0x52, 0x57, 0xe9, 0x5b, 0x7b,
-	0x23, 0x15, 0x9c, 0x01, 0xbe, 0x23, 0x70, 0xac, 0x75, 0x84, 0xa4, 0xf1, 0x45, 0x15, 0x3a, 0xe3,
-	0x72, 0xd3, 0x6d, 0xe9, 0x20, 0xb3, 0x97, 0x3c, 0x66, 0x53, 0xf4, 0xb9, 0x28, 0x66, 0x35, 0x57,
-	0xb9, 0xac, 0x6a, 0xd7, 0x75, 0x61, 0xdd, 0x1e, 0x9f, 0x37, 0xe8, 0x27, 0x04, 0x7a, 0x9b, 0x83,
-	0x29, 0x1d, 0x89, 0x75, 0xee, 0x9b, 0x81, 0xb9, 0xd1, 0x14, 0x92, 0x08, 0x6e, 0xd4, 0x03, 0x97,
-	0xa7, 0x43, 0x51, 0xe0, 0x9a, 0xc3, 0x30, 0xfd, 0x94, 0x40, 0x9f, 0x3d, 0xba, 0xd2, 0xb1, 0x78,
-	0x07, 0xfe, 0x69, 0x99, 0x1b, 0x4f, 0x25, 0x8b, 0x70, 0xc6, 0x3d, 0x38, 0xc3, 0x34, 0x1f, 0x09,
-	0xc7, 0x1e, 0xa0, 0xaf, 0x3c, 0xdc, 0xce, 0x93, 0xad, 0xed, 0x3c, 0xf9, 0x73, 0x3b, 0x4f, 0x3e,
-	0xdb, 0xc9, 0x67, 0xb6, 0x76, 0xf2, 0x99, 0xdf, 0x76, 0xf2, 0x99, 0xf7, 0xce, 0x2a, 0xaa, 0x59,
-	0x5b, 0xad, 0x14, 0xab, 0xfa, 0x8a, 0x63, 0xc3, 0xfe, 0x33, 0xc1, 0xa4, 0x1b, 0xc2, 0x2d, 0xd7,
-	0xa0, 0x79, 0xbb, 0x21, 0xb3, 0x4a, 0x9f, 0xf5, 0xff, 0xbe, 0xe9, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x75, 0x72, 0xd6, 0x83, 0xeb, 0x1c, 0x00, 0x00,
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x8f, 0x14, 0x55,
+	0x17, 0x9f, 0x3b, 0xcc, 0x37, 0x9f, 0x1c, 0x02, 0xc1, 0xdb, 0xc3, 0x30, 0x14, 0xd8, 0xdd, 0x54,
+	0x00, 0x87, 0x01, 0xba, 0x64, 0x40, 0x40, 0x22, 0x8f, 0x1e, 0x01, 0x21, 0xf8, 0x18, 0x9a, 0x38,
+	0xf1, 0x99, 0x4e, 0x75, 0x57, 0x51, 0x5d, 0xa1, 0xa7, 0xaa, 0xa9, 0x5b, 0x4d, 0x40, 0xc2, 0xc6,
+	0x85, 0x61, 0x65, 0x4c, 0x5c, 0x6b, 0x58, 0xb8, 0x52, 0x4c, 0x5c, 0x60, 0xe2, 0x8a, 0xa5, 0x61,
+	0x61, 0x0c, 0x6a, 0x4c, 0x64, 0xa3, 0x86, 0x31, 0x91, 0xf8, 0x37, 0x18, 0x63, 0xaa, 0xea, 0xd4,
+	0xab, 0xeb, 0xd9, 0x3d, 0x3d, 0xc9, 0xb0, 0x9a, 0xee, 0x5b, 0xf7, 0x9c, 0xf3, 0xfb, 0x9d, 0x57,
+	0x9d, 0xd3, 0x03, 0x7c, 0x53, 0x67, 0x8b, 0x3a, 0x13, 0x98, 0x29, 0x5e, 0x56, 0x35, 0x45, 0xb8,
+	0xba, 0xbf, 0x21, 0x9b, 0xe2, 0x7e, 0xe1, 0x4a, 0x57, 0x36, 0xae, 0x57, 0x3a, 0x86, 0x6e, 0xea,
+	0x74, 0xd2, 0xb9, 0x53, 0xc1, 0x3b, 0x15, 0xbc, 0xc3, 0xcd, 0xa0, 0x6c, 0x43, 0x64, 0xb2, 0x23,
+	0xe0, 0x89, 0x77, 0x44, 0x45, 0xd5, 0x44, 0x53, 0xd5, 0x35, 0x47, 0x07, 0x37, 0xa1, 0xe8, 0x8a,
+	0x6e, 0x7f, 0x14, 0xac, 0x4f, 0x78, 0xba, 0x4d, 0xd1, 0x75, 0xa5, 0x2d, 0x0b, 0x62, 0x47, 0x15,
+	0x44, 0x4d, 0xd3, 0x4d, 0x5b, 0x84, 0xe1, 0xd3, 0x1d, 0x09, 0xd8, 0x5c, 0x1c, 0xce, 0xad, 0x2d,
+	0xce, 0xad, 0xba, 0xa3, 0x1c, 0xa1, 0x3a, 0x8f, 0xb6, 0xa2, 0x02, 0x17, 0x5b, 0x90, 0x15, 0xb7,
+	0x0b, 0x1f, 0xca, 0x1d, 0xbd, 0xd9, 0x0a, 0xaa, 0x77, 0x0f, 0x9c, 0x7b, 0xfc, 0x35, 0x98, 0xbc,
+	0x60, 0x89, 0x2d, 0x88, 0x6d, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x35, 0xf9, 0x4a, 0x57, 0x66, 0x26,
+	0x9d, 0x84, 0x71, 0x66, 0x8a, 0x66, 0x97, 0x4d, 0x91, 0x32, 0x99, 0x5e, 0x5b, 0xc3, 0x6f, 0xf4,
+	0x0c, 0x80, 0xcf, 0x7f, 0x6a, 0xb4, 0x4c, 0xa6, 0xd7, 0xcd, 0xee, 0xaa, 0x20, 0x32, 0xcb, 0x59,
+	0x15, 0x07, 0x07, 0x1a, 0xac, 0xcc, 0x8b, 0x8a, 0x8c, 0x3a, 0x6b, 0x01, 0x49, 0xfe, 0x4b, 0x02,
+	0x9b, 0x23, 0xa6, 0x59, 0x47, 0xd7, 0x98, 0x4c, 0x5f, 0x06, 0xb8, 0xea, 0x9d, 0x4e, 0x91, 0xf2,
+	0x9a, 0xe9, 0x75, 0xb3, 0xdb, 0x2b, 0xf1, 0x81, 0xaa, 0x78, 0xf2, 0x73, 0x63, 0xf7, 0x7f, 0x2b,
+	0x8d, 0xd4, 0x02, 0xa2, 0x96, 0xa2, 0x08, 0xd8, 0x67, 0x33, 0xc1, 0x3a, 0x28, 0x42, 0x68, 0xdf,
+	0x84, 0x4d, 0x61, 0xb0, 0xae, 0x9b, 0x4e, 0xc0, 0x06, 0xcf, 0x5e, 0x5d, 0x94, 0x24, 0xc3, 0x71,
+	0xd7, 0xdc, 0xd4, 0x4f, 0x77, 0xf7, 0x4d, 0xa0, 0xa1, 0xaa, 0x24, 0x19, 0x32, 0x63, 0x17, 0x4d,
+	0x43, 0xd5, 0x94, 0xda, 0x7a, 0xef, 0xbe, 0x75, 0xce, 0xd7, 0x7b, 0x23, 0xe0, 0x79, 0xe1, 0x34,
+	0xac, 0xf5, 0xae, 0xda, 0x5a, 0xfb, 0x70, 0x82, 0x2f, 0x69, 0x39, 0xba, 0x1c, 0xb6, 0x70, 0x4a,
+	0x6e, 0xcb, 0x8a, 0x93, 0x8c, 0xc3, 0xa2, 0x31, 0xb4, 0xb4, 0x78, 0x4c, 0x60, 0x7b, 0x0a, 0x5a,
+	0x74, 0xcd, 0xfb, 0x30, 0x21, 0x79, 0xc7, 0x75, 0x03, 0x8f, 0xdd, 0x54, 0x99, 0x49, 0xf2, 0x92,
+	0xaf, 0xca, 0xd5, 0x34, 0xb7, 0xd5, 0x72, 0xd7, 0x17, 0xbf, 0x97, 0x0a, 0xd1, 0x67, 0xac, 0x56,
+	0x90, 0xa2, 0x87, 0xc3, 0xcb, 0xa9, 0xbb, 0x04, 0x76, 0x87, 0xa9, 0xbe, 0xa1, 0x35, 0x74, 0x4d,
+	0x52, 0x35, 0x65, 0x35, 0x47, 0xe8, 0x21, 0x81, 0x99, 0x3c, 0xb0, 0x31, 0x54, 0x0d, 0x28, 0x74,
+	0xdd, 0xe7, 0x91, 0x48, 0xed, 0x49, 0x8a, 0x54, 0x8c, 0x4a, 0xcc, 0x6c, 0xea, 0x69, 0x5b, 0x81,
+	0x90, 0x7c, 0x4e, 0xb0, 0x1a, 0x83, 0xd9, 0xe0, 0xf9, 0x1f, 0xb3, 0x21, 0xb7, 0xff, 0xbd, 0xfb,
+	0xb6, 0xff, 0xa3, 0x01, 0x1c, 0xed, 0x2b, 0x80, 0x47, 0x9f, 0xba, 0x75, 0xbb, 0x34, 0xf2, 0xf8,
+	0x76, 0x69, 0x84, 0xbf, 0x0a, 0x9b, 0x23, 0x28, 0xd1, 0xdd, 0xef, 0x40, 0x21, 0xa6, 0x32, 0xb0,
+	0x7d, 0xf4, 0x51, 0x18, 0x35, 0x1a, 0xcd, 0x7d, 0xfe, 0x2b, 0x02, 0x25, 0xdb, 0x70, 0x4c, 0x78,
+	0x56, 0xa3, 0x9f, 0x16, 0xa1, 0x9c, 0x0c, 0x17, 0x1d, 0x76, 0x0e, 0xc6, 0x9d, 0x8c, 0x42, 0x1f,
+	0x0d, 0x90, 0x92, 0xa8, 0x80, 0xff, 0xc6, 0xed, 0xb4, 0xa7, 0x5c, 0x42, 0xf1, 0x75, 0xbc, 0x3c,
+	0xff, 0x0c, 0xa9, 0x8e, 0x03, 0x6e, 0xfa, 0xd1, 0xed, 0xb9, 0xf1, 0xb8, 0xd1, 0x51, 0xcd, 0xa1,
+	0xf5, 0x5c, 0xc7, 0x6b, 0x2b, 0xdb, 0x5c, 0xef, 0xb9, 0xcd, 0xd5, 0xe3, 0x94, 0xd1, 0x5c, 0x57,
+	0x5b, 0x50, 0xbc, 0x36, 0x9b, 0x41, 0xe0, 0x49, 0x6c, 0xb3, 0xf7, 0x46, 0x61, 0x8b, 0xcd, 0xad,
+	0x26, 0x4b, 0x2b, 0x12, 0x0c, 0xca, 0x8c, 0x66, 0xbd, 0xcf, 0x2e, 0xb2, 0x91, 0x19, 0xcd, 0x85,
+	0x9e, 0x37, 0x26, 0x95, 0x98, 0xd9, 0xab, 0x67, 0x4d, 0x96, 0x1e, 0x89, 0x99, 0x0b, 0x29, 0x6f,
+	0xde, 0xb1, 0x21, 0x24, 0xc7, 0x03, 0x02, 0x5c, 0x9c, 0x03, 0x31, 0x19, 0x54, 0x98, 0x34, 0xe4,
+	0x94, 0x62, 0xdd, 0x9b, 0x94, 0x0f, 0x41, 0x75, 0x3d, 0xe5, 0xba, 0xc9, 0x90, 0x57, 0x7a, 0x1a,
+	0x2a, 0x85, 0xf3, 0x3d, 0xba, 0x93, 0xac, 0xc2, 0x32, 0xbd, 0x1b, 0xe9, 0xf9, 0x4f, 0xc4, 0x3e,
+	0x73, 0x87, 0x40, 0x31, 0x01, 0xf6, 0x6a, 0x7c, 0x91, 0xb7, 0x12, 0x73, 0x63, 0xd8, 0xdb, 0x92,
+	0x01, 0x3b, 0xc3, 0x96, 0xe6, 0x65, 0xbb, 0x0b, 0x9e, 0xb6, 0xf6, 0xe6, 0x57, 0x99, 0x32, 0xb4,
+	0x5c, 0x0c, 0xb0, 0xd3, 0x61, 0x57, 0x96, 0x4d, 0x8f, 0xe4, 0xff, 0xc5, 0xa6, 0x5d, 0xeb, 0x98,
+	0x45, 0x3b, 0x5d, 0x8a, 0xde, 0x5e, 0xef, 0x72, 0xbc, 0xd0, 0x95, 0xbb, 0xb2, 0x54, 0x6d, 0x06,
+	0x7a, 0xba, 0x2b, 0xcb, 0x1f, 0xc4, 0xee, 0x71, 0x56, 0x65, 0xa6, 0x6e, 0xa8, 0x4d, 0xb1, 0x7d,
+	0x4e, 0xbb, 0xa4, 0x07, 0x36, 0xff, 0x96, 0xac, 0x2a, 0x2d, 0xd3, 0x66, 0xb4, 0xa6, 0x86, 0xdf,
+	0xf8, 0xb7, 0x60, 0x6b, 0xac, 0x14, 0x62, 0x3b, 0x0a, 0x63, 0x2d, 0x95, 0x99, 0x53, 0x24, 0x5c,
+	0x55, 0xbd, 0xbe, 0xef, 0x91, 0xb6, 0x65, 0x78, 0x0a, 0x1b, 0x6d, 0xd5, 0xf3, 0xba, 0xde, 0x46,
+	0x18, 0xfc, 0x79, 0x78, 0x3a, 0x70, 0x86, 0x46, 0x0e, 0xc1, 0x58, 0x47, 0xd7, 0xdb, 0x68, 0x64,
+	0x5b, 0x92, 0x11, 0x4b, 0x06, 0x49, 0xdb, 0xf7, 0xf9, 0x09, 0xa0, 0x8e, 0x32, 0xd1, 0x10, 0x17,
+	0xdd, 0x18, 0xf2, 0x17, 0xa1, 0x10, 0x3a, 0x45, 0x23, 0x2f, 0xc2, 0x78, 0xc7, 0x3e, 0x41, 0x33,
+	0xc5, 0x44, 0x33, 0xf6, 0x2d, 0x77, 0x0a, 0x74, 0x64, 0x66, 0x3f, 0x9d, 0x82, 0xff, 0xd9, 0x5a,
+	0xe9, 0x67, 0x04, 0xc0, 0xef, 0x06, 0xb4, 0x92, 0xa4, 0x26, 0xfe, 0x17, 0x18, 0x4e, 0xc8, 0x7d,
+	0x1f, 0xc7, 0x73, 0xe1, 0xd6, 0x5f, 0x5f, 0xcf, 0x90, 0x0f, 0x7e, 0xfe, 0xf3, 0x93, 0xd1, 0x1d,
+	0x94, 0x17, 0x12, 0x7e, 0x60, 0x0a, 0xb4, 0x93, 0x3b, 0x04, 0xd6, 0x7a, 0x7a, 0xe8, 0xbe, 0x7c,
+	0xf6, 0x5c, 0x78, 0x95, 0xbc, 0xd7, 0x11, 0xdd, 0x49, 0x1f, 0xdd, 0xf3, 0xf4, 0x40, 0x36, 0x3a,
+	0xe1, 0x46, 0xb8, 0x7b, 0xdc, 0xa4, 0x0f, 0x09, 0x4c, 0xc4, 0xfd, 0x2c, 0x40, 0x8f, 0xe4, 0x83,
+	0x12, 0x1d, 0xfc, 0xb8, 0x17, 0x06, 0x90, 0x44, 0x3e, 0xaf, 0xf8, 0x7c, 0xaa, 0xf4, 0xc4, 0x00,
+	0x7c, 0x84, 0xc0, 0xab, 0x9b, 0xfe, 0x4b, 0xe0, 0x99, 0xd4, 0x85, 0x9a, 0x56, 0xf3, 0x41, 0x4d,
+	0x19, 0x73, 0xb9, 0xb9, 0xe5, 0xa8, 0x40, 0xda, 0x0b, 0x3e, 0xed, 0xf3, 0xf4, 0xdc, 0x20, 0xb4,
+	0xfd, 0x39, 0x35, 0xe8, 0x80, 0xef, 0x09, 0x80, 0x6f, 0x2f, 0xa3, 0x58, 0x22, 0x6b, 0x27, 0x27,
+	0xe4, 0xbe, 0x8f, 0x3c, 0xde, 0xf3, 0x79, 0xd4, 0xe8, 0xfc, 0x32, 0xc3, 0x27, 0xdc, 0x08, 0xbf,
+	0x0b, 0x6e, 0xd2, 0x7f, 0x08, 0x14, 0x62, 0xfc, 0x48, 0x0f, 0xa7, 0xe2, 0x4c, 0xde, 0xab, 0xb9,
+	0x23, 0xfd, 0x0b, 0x22, 0x53, 0xc3, 0x67, 0xaa, 0x50, 0x79, 0xd8, 0x4c, 0x63, 0xc3, 0x49, 0x7f,
+	0x20, 0x30, 0x11, 0xb7, 0x4d, 0x66, 0x94, 0x6a, 0xca, 0xe2, 0x9c, 0x51, 0xaa, 0x69, 0xab, 0x2b,
+	0x5f, 0xf5, 0x3d, 0x70, 0x88, 0x1e, 0x4c, 0xf2, 0x40, 0x6a, 0x3c, 0xad, 0xfa, 0x4c, 0xdd, 0xc4,
+	0x32, 0xea, 0x33, 0xcf, 0x1a, 0x9a, 0x51, 0x9f, 0xb9, 0x16, 0xc1, 0x9c, 0xf5, 0xe9, 0xd1, 0xcb,
+	0x19, 0x50, 0x46, 0xbf, 0x23, 0xb0, 0x3e, 0xb4, 0x6d, 0xd0, 0xfd, 0xa9, 0x68, 0xe3, 0x56, 0x3b,
+	0x6e, 0xb6, 0x1f, 0x11, 0x24, 0xf4, 0x9a, 0x4f, 0xe8, 0x25, 0x5a, 0x1d, 0x84, 0x90, 0x11, 0x82,
+	0xfd, 0x0b, 0x81, 0x42, 0xcc, 0xb0, 0x9e, 0x51, 0x99, 0xc9, 0x5b, 0x09, 0x77, 0xa4, 0x7f, 0x41,
+	0xa4, 0x76, 0xde, 0xa7, 0x76, 0x92, 0x1e, 0x1f, 0x84, 0x5a, 0xe0, 0x65, 0xbe, 0x44, 0x80, 0x46,
+	0x8d, 0xd1, 0x43, 0x7d, 0xa2, 0x73, 0x59, 0x1d, 0xee, 0x5b, 0x0e, 0x49, 0xbd, 0xeb, 0x93, 0xba,
+	0x40, 0x5f, 0x5f, 0x1e, 0xa9, 0xe8, 0x0c, 0xf0, 0x37, 0x81, 0x2d, 0x89, 0x73, 0x32, 0x3d, 0x96,
+	0x0f, 0x74, 0xc2, 0x4c, 0xcf, 0x1d, 0x1f, 0x54, 0xdc, 0x4d, 0x55, 0x9b, 0xf5, 0x59, 0x7a, 0x66,
+	0x10, 0xd6, 0x1d, 0x47, 0x6b, 0xdd, 0x9e, 0xe4, 0xeb, 0x8b, 0x16, 0x9d, 0x6f, 0x09, 0x6c, 0x08,
+	0xcf, 0xcb, 0x34, 0xbd, 0x82, 0x62, 0x07, 0x7a, 0xee, 0x40, 0x5f, 0x32, 0xc8, 0xe5, 0x98, 0x1f,
+	0xc6, 0x59, 0xfa, 0x5c, 0x12, 0xa1, 0x96, 0x27, 0x5c, 0x57, 0xb5, 0x4b, 0xba, 0x70, 0xc3, 0xd9,
+	0x15, 0x6e, 0xd2, 0x0f, 0x09, 0x8c, 0x59, 0x53, 0x38, 0x9d, 0x4e, 0x35, 0x1e, 0x18, 0xf8, 0xb9,
+	0xdd, 0x39, 0x6e, 0x22, 0xb8, 0xdd, 0x3e, 0xb8, 0x22, 0xdd, 0x96, 0x04, 0xce, 0x9a, 0xfc, 0xe9,
+	0x47, 0x04, 0xc6, 0x9d, 0x39, 0x9d, 0xce, 0xa4, 0x1b, 0x08, 0xae, 0x06, 0xdc, 0x9e, 0x5c, 0x77,
+	0x11, 0xce, 0x1e, 0x1f, 0x4e, 0x99, 0x16, 0x13, 0xe1, 0x38, 0xdb, 0xc2, 0x99, 0xfb, 0x8f, 0x8a,
+	0xe4, 0xc1, 0xa3, 0x22, 0xf9, 0xe3, 0x51, 0x91, 0x7c, 0xbc, 0x54, 0x1c, 0x79, 0xb0, 0x54, 0x1c,
+	0xf9, 0x75, 0xa9, 0x38, 0xf2, 0xf6, 0x5e, 0x45, 0x35, 0x5b, 0xdd, 0x46, 0xa5, 0xa9, 0x2f, 0xba,
+	0x3a, 0x9c, 0x3f, 0xfb, 0x98, 0x74, 0x59, 0xb8, 0xe6, 0x29, 0x34, 0xaf, 0x77, 0x64, 0xd6, 0x18,
+	0xb7, 0xff, 0x83, 0x7b, 0xe0, 0xbf, 0x01, 0x00, 0x3f, 0x83, 0x9a, 0x1e, 0xe5, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1552,6 +1651,9 @@ type QueryClient interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
+	// DelegatorPendingEpochMsgs queries the staking messages of a delegator
+	// queued for execution at the end of the current epoch.
+	DelegatorPendingEpochMsgs(ctx context.Context, in *QueryDelegatorPendingEpochMsgsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEpochMsgsResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
@@ -1667,6 +1769,15 @@ func (c *queryClient) DelegatorValidator(ctx context.Context, in *QueryDelegator
 	return out, nil
 }
 
+func (c *queryClient) DelegatorPendingEpochMsgs(ctx context.Context, in *QueryDelegatorPendingEpochMsgsRequest, opts ...grpc.CallOption) (*QueryDelegatorPendingEpochMsgsResponse, error) {
+	out := new(QueryDelegatorPendingEpochMsgsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/DelegatorPendingEpochMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error) {
 	out := new(QueryHistoricalInfoResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/HistoricalInfo", in, out, opts...)
@@ -1722,6 +1833,9 @@ type QueryServer interface {
 	// DelegatorValidator queries validator info for given delegator validator
 	// pair.
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
+	// DelegatorPendingEpochMsgs queries the staking messages of a delegator
+	// queued for execution at the end of the current epoch.
+	DelegatorPendingEpochMsgs(context.Context, *QueryDelegatorPendingEpochMsgsRequest) (*QueryDelegatorPendingEpochMsgsResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
 	// Pool queries the pool info.
@@ -1767,6 +1881,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorValidator(ctx context.Context, req *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidator not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorPendingEpochMsgs(ctx context.Context, req *QueryDelegatorPendingEpochMsgsRequest) (*QueryDelegatorPendingEpochMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPendingEpochMsgs not implemented")
+}
 func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
@@ -1979,6 +2096,24 @@ func _Query_DelegatorValidator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorPendingEpochMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPendingEpochMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPendingEpochMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/DelegatorPendingEpochMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPendingEpochMsgs(ctx, req.(*QueryDelegatorPendingEpochMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoricalInfoRequest)
 	if err := dec(in); err != nil {
@@ -2081,6 +2216,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidator",
 			Handler:    _Query_DelegatorValidator_Handler,
 		},
+		{
+			MethodName: "DelegatorPendingEpochMsgs",
+			Handler:    _Query_DelegatorPendingEpochMsgs_Handler,
+		},
 		{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
@@ -3024,6 +3163,73 @@ func (m *QueryDelegatorValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorPendingEpochMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingEpochMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingEpochMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryHistoricalInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3580,6 +3786,34 @@ func (m *QueryDelegatorValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorPendingEpochMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPendingEpochMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryHistoricalInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6140,6 +6374,172 @@ func (m *QueryDelegatorValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorPendingEpochMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingEpochMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingEpochMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorPendingEpochMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorPendingEpochMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorPendingEpochMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, types.QueuedAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryHistoricalInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
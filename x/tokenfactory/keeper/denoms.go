@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom, and
+// whether the denom exists.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata sets the authority metadata of a factory denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+	return nil
+}
+
+// IterateAuthorityMetadata iterates over all the factory denoms and their
+// authority metadata, in denom order, until cb returns true.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomAuthorityMetadataKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the factory denoms created by the given
+// account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorDenomsKey(creator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, charging the
+// denom creation fee to the creator, which becomes the admin of the denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s already exists in x/bank", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.createDenom(ctx, creator, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// createDenom stores a factory denom with its authority metadata, indexes it
+// under its creator and registers its x/bank metadata if it has none yet.
+func (k Keeper) createDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := k.SetAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreatorDenomKey(creator, denom), []byte{})
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+		})
+	}
+
+	return nil
+}
+
+// Mint mints coins of a factory denom to the given account.
+func (k Keeper) Mint(ctx sdk.Context, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if _, found := k.GetAuthorityMetadata(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins)
+}
+
+// Burn burns coins of a factory denom from the given account.
+func (k Keeper) Burn(ctx sdk.Context, amount sdk.Coin, burnFrom sdk.AccAddress) error {
+	if _, found := k.GetAuthorityMetadata(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", amount.Denom)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnFrom, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
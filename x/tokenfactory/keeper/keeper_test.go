@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+
+	moduleAddr = authtypes.NewModuleAddress(types.ModuleName)
+)
+
+// fakeBankKeeper is an in-memory types.BankKeeper, module accounts being
+// addressed by their module address.
+type fakeBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+	metadata map[string]banktypes.Metadata
+}
+
+func newFakeBankKeeper() *fakeBankKeeper {
+	return &fakeBankKeeper{balances: map[string]sdk.Coins{}, metadata: map[string]banktypes.Metadata{}}
+}
+
+func (bk *fakeBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk *fakeBankKeeper) MintCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	bk.supply = bk.supply.Add(amt...)
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	bk.balances[addr] = bk.balances[addr].Add(amt...)
+	return nil
+}
+
+func (bk *fakeBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := bk.send(authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	bk.supply = bk.supply.Sub(amt...)
+	return nil
+}
+
+func (bk *fakeBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (bk *fakeBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *fakeBankKeeper) HasSupply(_ sdk.Context, denom string) bool {
+	return bk.supply.AmountOf(denom).IsPositive()
+}
+
+func (bk *fakeBankKeeper) GetDenomMetaData(_ sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := bk.metadata[denom]
+	return metadata, found
+}
+
+func (bk *fakeBankKeeper) SetDenomMetaData(_ sdk.Context, denomMetaData banktypes.Metadata) {
+	bk.metadata[denomMetaData.Base] = denomMetaData
+}
+
+func (bk *fakeBankKeeper) SpendableCoins(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+// fakeDistrKeeper sends the funded coins to the distribution module account.
+type fakeDistrKeeper struct {
+	bk *fakeBankKeeper
+}
+
+func (dk fakeDistrKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return dk.bk.send(sender, authtypes.NewModuleAddress("distribution"), amount)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	bankKeeper  *fakeBankKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModuleBasic{})
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+
+	s.ctx = testCtx.Ctx
+	s.bankKeeper = newFakeBankKeeper()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, s.bankKeeper, fakeDistrKeeper{s.bankKeeper}, authority)
+	s.keeper.InitGenesis(s.ctx, types.DefaultGenesisState())
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// createDenom creates the given subdenom of alice.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(alice, subdenom))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	require := s.Require()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(s.keeper.SetParams(s.ctx, types.NewParams(fee)))
+
+	// alice cannot pay the fee
+	_, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(alice, "token"))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.bankKeeper.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
+	denom := s.createDenom("token")
+	require.Equal("factory/"+alice.String()+"/token", denom)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), s.bankKeeper.balances[alice.String()])
+	require.Equal(fee, s.bankKeeper.balances[authtypes.NewModuleAddress("distribution").String()])
+
+	metadata, found := s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	require.True(found)
+	require.Equal(alice.String(), metadata.Admin)
+
+	bankMetadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.NoError(bankMetadata.Validate())
+	require.Equal(denom, bankMetadata.Base)
+
+	// a denom cannot be created twice
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(alice, "token"))
+	require.ErrorIs(err, types.ErrDenomExists)
+
+	// the same subdenom is available to other creators
+	require.NoError(s.keeper.SetParams(s.ctx, types.DefaultParams()))
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(bob, "token"))
+	require.NoError(err)
+	require.Equal("factory/"+bob.String()+"/token", res.NewTokenDenom)
+
+	// denoms already known to x/bank cannot be created
+	taken, err := types.GetTokenDenom(alice.String(), "taken")
+	require.NoError(err)
+	s.bankKeeper.supply = sdk.NewCoins(sdk.NewInt64Coin(taken, 1))
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(alice, "taken"))
+	require.ErrorIs(err, types.ErrDenomExists)
+}
+
+func (s *KeeperTestSuite) TestMintAndBurn() {
+	require := s.Require()
+
+	denom := s.createDenom("token")
+
+	// mint to the admin by default
+	_, err := s.msgServer.Mint(s.ctx, types.NewMsgMint(alice, sdk.NewInt64Coin(denom, 100), nil))
+	require.NoError(err)
+	require.Equal(int64(100), s.bankKeeper.balances[alice.String()].AmountOf(denom).Int64())
+
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(alice, sdk.NewInt64Coin(denom, 20), bob))
+	require.NoError(err)
+	require.Equal(int64(20), s.bankKeeper.balances[bob.String()].AmountOf(denom).Int64())
+	require.Equal(int64(120), s.bankKeeper.supply.AmountOf(denom).Int64())
+	require.True(s.bankKeeper.balances[moduleAddr.String()].IsZero())
+
+	// only the admin can mint and burn
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(bob, sdk.NewInt64Coin(denom, 1), nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(bob, sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// unknown denoms cannot be minted
+	unknown, err := types.GetTokenDenom(alice.String(), "unknown")
+	require.NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(alice, sdk.NewInt64Coin(unknown, 1), nil))
+	require.ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(alice, sdk.NewInt64Coin(denom, 40)))
+	require.NoError(err)
+	require.Equal(int64(60), s.bankKeeper.balances[alice.String()].AmountOf(denom).Int64())
+	require.Equal(int64(80), s.bankKeeper.supply.AmountOf(denom).Int64())
+
+	// the admin burns from its own balance only
+	_, err = s.msgServer.Burn(s.ctx, types.NewMsgBurn(alice, sdk.NewInt64Coin(denom, 61)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	require := s.Require()
+
+	denom := s.createDenom("token")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(bob, denom, bob))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(alice, denom, bob))
+	require.NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(alice, sdk.NewInt64Coin(denom, 1), nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(bob, sdk.NewInt64Coin(denom, 1), nil))
+	require.NoError(err)
+
+	// an empty admin leaves the denom without an admin for good
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(bob, denom, nil))
+	require.NoError(err)
+	metadata, found := s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	require.True(found)
+	require.Empty(metadata.Admin)
+
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(bob, sdk.NewInt64Coin(denom, 1), nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	require := s.Require()
+
+	denom := s.createDenom("token")
+	metadata := banktypes.Metadata{
+		Description: "my token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "token",
+		Name:    "Token",
+		Symbol:  "TKN",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(s.ctx, types.NewMsgSetDenomMetadata(bob, metadata))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, types.NewMsgSetDenomMetadata(alice, metadata))
+	require.NoError(err)
+	bankMetadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.Equal(metadata, bankMetadata)
+
+	invalid := metadata
+	invalid.Name = ""
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, types.NewMsgSetDenomMetadata(alice, invalid))
+	require.ErrorIs(err, types.ErrInvalidMetadata)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: alice.String(), Params: params})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	require.NoError(err)
+	require.Equal(params, s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	require := s.Require()
+
+	denomA := s.createDenom("a")
+	denomB := s.createDenom("b")
+
+	params, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.True(params.Params.DenomCreationFee.IsZero())
+
+	metadata, err := s.queryClient.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denomA})
+	require.NoError(err)
+	require.Equal(alice.String(), metadata.AuthorityMetadata.Admin)
+
+	_, err = s.queryClient.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	require.Error(err)
+
+	denoms, err := s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{
+		Creator:    alice.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal([]string{denomA}, denoms.Denoms)
+	require.Equal(uint64(2), denoms.Pagination.Total)
+
+	denoms, err = s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{
+		Creator:    alice.String(),
+		Pagination: &query.PageRequest{Key: denoms.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]string{denomB}, denoms.Denoms)
+
+	denoms, err = s.queryClient.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: bob.String()})
+	require.NoError(err)
+	require.Empty(denoms.Denoms)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+
+	denomA := s.createDenom("a")
+	denomB := s.createDenom("b")
+	_, err := s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(alice, denomB, bob))
+	require.NoError(err)
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(s.keeper.SetParams(s.ctx, params))
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	require.Equal(types.NewGenesisState(params, []types.GenesisDenom{
+		{Denom: denomA, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: alice.String()}},
+		{Denom: denomB, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: bob.String()}},
+	}), genesis)
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.ctx, genesis)
+	require.Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+	require.Equal([]string{denomA, denomB}, s.keeper.GetDenomsFromCreator(s.ctx, alice))
+	_, found := s.bankKeeper.GetDenomMetaData(s.ctx, denomB)
+	require.True(found)
+}
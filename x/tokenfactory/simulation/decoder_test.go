@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModuleBasic{}).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := sdk.AccAddress("creator_____________")
+	denom := "factory/" + creator.String() + "/token"
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	metadata := types.DenomAuthorityMetadata{Admin: creator.String()}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.DenomAuthorityMetadataKey(denom), Value: cdc.MustMarshal(&metadata)},
+			{Key: types.CreatorDenomKey(creator, denom), Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"DenomAuthorityMetadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"CreatorDenom", fmt.Sprintf("%X\n%X", kvPairs.Pairs[2].Key, kvPairs.Pairs[2].Key)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
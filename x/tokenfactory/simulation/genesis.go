@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Simulation parameter constants
+const DenomCreationFee = "denom_creation_fee"
+
+// RandomDenomCreationFee returns a random denom creation fee in the bond
+// denom, which is zero half of the time.
+func RandomDenomCreationFee(r *rand.Rand) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.Coins{}
+	}
+
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1000)+1)))
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory,
+// without any factory denom.
+func RandomizedGenState(simState *module.SimulationState) {
+	var denomCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &denomCreationFee, simState.Rand,
+		func(r *rand.Rand) { denomCreationFee = RandomDenomCreationFee(r) },
+	)
+
+	tokenfactoryGenesis := types.NewGenesisState(types.NewParams(denomCreationFee), []types.GenesisDenom{})
+
+	bz, err := json.MarshalIndent(tokenfactoryGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated tokenfactory parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
@@ -0,0 +1,282 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec // these are not hardcoded credentials.
+const (
+	OpWeightMsgCreateDenom      = "op_weight_msg_create_denom"
+	OpWeightMsgMint             = "op_weight_msg_tf_mint"
+	OpWeightMsgBurn             = "op_weight_msg_tf_burn"
+	OpWeightMsgChangeAdmin      = "op_weight_msg_change_admin"
+	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+
+	DefaultWeightMsgCreateDenom      int = 50
+	DefaultWeightMsgMint             int = 100
+	DefaultWeightMsgBurn             int = 50
+	DefaultWeightMsgChangeAdmin      int = 10
+	DefaultWeightMsgSetDenomMetadata int = 20
+)
+
+var (
+	TypeMsgCreateDenom      = sdk.MsgTypeURL(&types.MsgCreateDenom{})
+	TypeMsgMint             = sdk.MsgTypeURL(&types.MsgMint{})
+	TypeMsgBurn             = sdk.MsgTypeURL(&types.MsgBurn{})
+	TypeMsgChangeAdmin      = sdk.MsgTypeURL(&types.MsgChangeAdmin{})
+	TypeMsgSetDenomMetadata = sdk.MsgTypeURL(&types.MsgSetDenomMetadata{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDenom      int
+		weightMsgMint             int
+		weightMsgBurn             int
+		weightMsgChangeAdmin      int
+		weightMsgSetDenomMetadata int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = DefaultWeightMsgCreateDenom
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = DefaultWeightMsgMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = DefaultWeightMsgBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeAdmin = DefaultWeightMsgChangeAdmin
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDenomMetadata = DefaultWeightMsgSetDenomMetadata
+		},
+	)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), tx.DefaultSignModes)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDenom,
+			SimulateMsgCreateDenom(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChangeAdmin,
+			SimulateMsgChangeAdmin(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetDenomMetadata,
+			SimulateMsgSetDenomMetadata(txConfig, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with a random subdenom.
+func SimulateMsgCreateDenom(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgCreateDenom(creator.Address, simtypes.RandStringOfLength(r, 10))
+
+		denom, err := types.GetTokenDenom(msg.Sender, msg.Subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, err.Error()), nil, nil
+		}
+		if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		fee := k.GetParams(ctx).DenomCreationFee
+		if !bk.SpendableCoins(ctx, creator.Address).IsAllGTE(fee) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, "insufficient funds for the denom creation fee"), nil, nil
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, creator, msg, TypeMsgCreateDenom, fee)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint of a random amount of a denom
+// administered by one of the accounts, minted to its admin or to a random
+// account, so that admins hold coins to burn.
+func SimulateMsgMint(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "no administered denom"), nil, nil
+		}
+
+		mintTo := admin
+		if r.Intn(2) == 0 {
+			mintTo, _ = simtypes.RandomAcc(r, accs)
+		}
+		amount := sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000)))
+		msg := types.NewMsgMint(admin.Address, amount, mintTo.Address)
+
+		return deliver(r, app, ctx, txConfig, ak, bk, admin, msg, TypeMsgMint, nil)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn of a random part of the balance of its
+// admin in a denom administered by one of the accounts.
+func SimulateMsgBurn(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "no administered denom"), nil, nil
+		}
+
+		balance := bk.SpendableCoins(ctx, admin.Address).AmountOf(denom)
+		amount := simtypes.RandomAmount(r, balance)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "nothing to burn"), nil, nil
+		}
+
+		coin := sdk.NewCoin(denom, amount)
+		msg := types.NewMsgBurn(admin.Address, coin)
+
+		return deliver(r, app, ctx, txConfig, ak, bk, admin, msg, TypeMsgBurn, sdk.NewCoins(coin))
+	}
+}
+
+// SimulateMsgChangeAdmin generates a MsgChangeAdmin handing a denom
+// administered by one of the accounts over to a random account.
+func SimulateMsgChangeAdmin(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgChangeAdmin, "no administered denom"), nil, nil
+		}
+
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgChangeAdmin(admin.Address, denom, newAdmin.Address)
+
+		return deliver(r, app, ctx, txConfig, ak, bk, admin, msg, TypeMsgChangeAdmin, nil)
+	}
+}
+
+// SimulateMsgSetDenomMetadata generates a MsgSetDenomMetadata with random
+// metadata for a denom administered by one of the accounts.
+func SimulateMsgSetDenomMetadata(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetDenomMetadata, "no administered denom"), nil, nil
+		}
+
+		display := simtypes.RandStringOfLength(r, 8)
+		msg := types.NewMsgSetDenomMetadata(admin.Address, banktypes.Metadata{
+			Description: simtypes.RandStringOfLength(r, 20),
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: display, Exponent: 6},
+			},
+			Base:    denom,
+			Display: display,
+			Name:    simtypes.RandStringOfLength(r, 10),
+			Symbol:  simtypes.RandStringOfLength(r, 4),
+		})
+
+		return deliver(r, app, ctx, txConfig, ak, bk, admin, msg, TypeMsgSetDenomMetadata, nil)
+	}
+}
+
+// randomAdministeredDenom returns a random factory denom whose admin is one
+// of the given accounts, along with the admin account.
+func randomAdministeredDenom(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (string, simtypes.Account, bool) {
+	var (
+		denoms []string
+		admins []simtypes.Account
+	)
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		adminAddr, err := sdk.AccAddressFromBech32(metadata.Admin)
+		if err != nil {
+			return false
+		}
+
+		if admin, found := simtypes.FindAccount(accs, adminAddr); found {
+			denoms = append(denoms, denom)
+			admins = append(admins, admin)
+		}
+		return false
+	})
+
+	if len(denoms) == 0 {
+		return "", simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(denoms))
+	return denoms[i], admins[i], true
+}
+
+// deliver generates, signs and delivers a transaction with the given message
+// signed by the given account, with random fees leaving room for the coins
+// spent in the message.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txConfig client.TxConfig,
+	ak types.AccountKeeper, bk types.BankKeeper, signer simtypes.Account, msg sdk.Msg, msgType string, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the factory denoms.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the bech32 address of a
+	// creator. It leaves room for the 128 characters of a denom with the
+	// longest subdenom.
+	MaxCreatorLength = 59 + MaxHrpLength
+
+	// MaxHrpLength is the maximum length of a bech32 human readable part
+	// supported in factory denoms.
+	MaxHrpLength = 16
+)
+
+// GetTokenDenom returns the factory denom factory/{creator}/{subdenom}. The
+// subdenom may be empty, but the resulting denom must be a valid sdk denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s contains a '/'", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into the address of its creator
+// and its subdenom. The subdenom may contain slashes.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s does not have the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	if parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s does not start with %s/", denom, ModuleDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", parts[1], err)
+	}
+
+	return creator, parts[2], nil
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var creator = sdk.AccAddress("creator_____________")
+
+func TestGetTokenDenom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+	}{
+		{"valid", creator.String(), "token", "factory/" + creator.String() + "/token"},
+		{"empty subdenom", creator.String(), "", "factory/" + creator.String() + "/"},
+		{"subdenom with slashes", creator.String(), "a/b", "factory/" + creator.String() + "/a/b"},
+		{"max subdenom length", creator.String(), strings.Repeat("a", types.MaxSubdenomLength), "factory/" + creator.String() + "/" + strings.Repeat("a", types.MaxSubdenomLength)},
+		{"subdenom too long", creator.String(), strings.Repeat("a", types.MaxSubdenomLength+1), ""},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "token", ""},
+		{"creator with slash", "a/b", "token", ""},
+		{"invalid characters", creator.String(), "tok*en", ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expDenom == "" {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	testCases := []struct {
+		name        string
+		denom       string
+		expSubdenom string
+		expPass     bool
+	}{
+		{"valid", "factory/" + creator.String() + "/token", "token", true},
+		{"subdenom with slashes", "factory/" + creator.String() + "/a/b", "a/b", true},
+		{"empty subdenom", "factory/" + creator.String() + "/", "", true},
+		{"not a factory denom", "stake", "", false},
+		{"missing subdenom", "factory/" + creator.String(), "", false},
+		{"wrong prefix", "ibc/" + creator.String() + "/token", "", false},
+		{"invalid creator", "factory/creator/token", "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			addr, subdenom, err := types.DeconstructDenom(tc.denom)
+			if !tc.expPass {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator, addr)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
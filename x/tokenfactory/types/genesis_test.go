@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	denom := "factory/" + creator.String() + "/token"
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			malleate: func(_ *types.GenesisState) {},
+			expPass:  true,
+		},
+		{
+			name: "valid genesis state",
+			malleate: func(genState *types.GenesisState) {
+				genState.Params = types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+				genState.FactoryDenoms = []types.GenesisDenom{
+					{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator.String()}},
+					{Denom: denom + "2"},
+				}
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid denom creation fee",
+			malleate: func(genState *types.GenesisState) {
+				genState.Params.DenomCreationFee = sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate denom",
+			malleate: func(genState *types.GenesisState) {
+				genState.FactoryDenoms = []types.GenesisDenom{{Denom: denom}, {Denom: denom}}
+			},
+			expPass: false,
+		},
+		{
+			name: "not a factory denom",
+			malleate: func(genState *types.GenesisState) {
+				genState.FactoryDenoms = []types.GenesisDenom{{Denom: "stake"}}
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid admin",
+			malleate: func(genState *types.GenesisState) {
+				genState.FactoryDenoms = []types.GenesisDenom{
+					{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "admin"}},
+				}
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			tc.malleate(genState)
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+var (
+	ParamsKey                       = []byte{0x01}
+	DenomAuthorityMetadataKeyPrefix = []byte{0x02}
+	CreatorDenomsKeyPrefix          = []byte{0x03}
+)
+
+// DenomAuthorityMetadataKey returns the store key of the authority metadata
+// of the given factory denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataKeyPrefix, []byte(denom)...)
+}
+
+// CreatorDenomsKey returns the store key prefix of the denoms created by the
+// given account.
+func CreatorDenomsKey(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomsKeyPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// CreatorDenomKey returns the store key indexing the given denom under its
+// creator.
+func CreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(CreatorDenomsKey(creator), []byte(denom)...)
+}